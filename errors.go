@@ -119,6 +119,27 @@ func ResponseCodeIs(err error, status int) bool {
 	return false
 }
 
+// ErrBulkCreateFailed is the error type returned when a service rejects a
+// bulk creation request with an unexpected response code. Err holds the
+// ErrUnexpectedResponseCode. Whether some of the resources were created
+// depends on the service.
+type ErrBulkCreateFailed struct {
+	BaseError
+	Resource string
+	Count    int
+	Err      error
+}
+
+func (e ErrBulkCreateFailed) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Bulk creation of %d %s was rejected: %s", e.Count, e.Resource, e.Err)
+	return e.choseErrString()
+}
+
+// Unwrap returns the error the request failed with.
+func (e ErrBulkCreateFailed) Unwrap() error {
+	return e.Err
+}
+
 // ErrTimeOut is the error type returned when an operations times out.
 type ErrTimeOut struct {
 	BaseError
@@ -14,6 +14,7 @@ import (
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/manageablesnapshots"
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/manageablevolumes"
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/qos"
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/snapshots"
@@ -855,3 +856,90 @@ func ManageExisting(t *testing.T, client *gophercloud.ServiceClient, volume *vol
 
 	return managed, nil
 }
+
+// UnmanageSnapshot will unmanage a snapshot and wait until it is gone from
+// the Block Storage service. The snapshot stays on the backend.
+func UnmanageSnapshot(t *testing.T, client *gophercloud.ServiceClient, snapshot *snapshots.Snapshot) error {
+	t.Logf("Attempting to unmanage snapshot %s", snapshot.ID)
+
+	err := snapshots.Unmanage(context.TODO(), client, snapshot.ID).ExtractErr()
+	if err != nil {
+		return err
+	}
+
+	err = gophercloud.WaitFor(context.TODO(), func(ctx context.Context) (bool, error) {
+		if _, err := snapshots.Get(ctx, client, snapshot.ID).Extract(); err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	t.Logf("Successfully unmanaged snapshot %s", snapshot.ID)
+
+	return nil
+}
+
+// ManageExistingSnapshot will look up the unmanaged snapshot of the given
+// volume on its backend and manage it again.
+func ManageExistingSnapshot(t *testing.T, client *gophercloud.ServiceClient, volume *volumes.Volume, snapshot *snapshots.Snapshot) (*snapshots.Snapshot, error) {
+	t.Logf("Attempting to manage existing snapshot %s", snapshot.Name)
+
+	allPages, err := manageablesnapshots.ListDetail(client, manageablesnapshots.ListOpts{Host: volume.Host}).AllPages(context.TODO())
+	if err != nil {
+		return nil, err
+	}
+
+	allManageable, err := manageablesnapshots.ExtractManageableSnapshots(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var ref map[string]string
+	for _, v := range allManageable {
+		tools.PrintResource(t, v)
+		if v.SafeToManage && v.SourceReference["source-name"] == fmt.Sprintf("volume-%s", volume.ID) {
+			ref = v.Reference
+		}
+	}
+	if ref == nil {
+		return nil, fmt.Errorf("unable to find manageable snapshot of volume %s", volume.ID)
+	}
+
+	manageOpts := manageablesnapshots.ManageExistingOpts{
+		VolumeID:    volume.ID,
+		Ref:         ref,
+		Name:        snapshot.Name,
+		Description: snapshot.Description,
+	}
+
+	managed, err := manageablesnapshots.ManageExisting(context.TODO(), client, manageOpts).Extract()
+	if err != nil {
+		return managed, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 60*time.Second)
+	defer cancel()
+
+	if err := snapshots.WaitForStatus(ctx, client, managed.ID, "available"); err != nil {
+		return managed, err
+	}
+
+	managed, err = snapshots.Get(context.TODO(), client, managed.ID).Extract()
+	if err != nil {
+		return managed, err
+	}
+
+	tools.PrintResource(t, managed)
+	th.AssertEquals(t, snapshot.Name, managed.Name)
+	th.AssertEquals(t, volume.ID, managed.VolumeID)
+
+	t.Logf("Successfully managed existing snapshot %s", managed.ID)
+
+	return managed, nil
+}
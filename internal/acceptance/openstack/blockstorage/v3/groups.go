@@ -0,0 +1,210 @@
+package v3
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/groupsnapshots"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/grouptypes"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumetypes"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+// CreateGroupType will create a group type with a random name which supports
+// consistent group snapshots. An error will be returned if the group type was
+// unable to be created.
+func CreateGroupType(t *testing.T, client *gophercloud.ServiceClient) (*grouptypes.GroupType, error) {
+	name := tools.RandomString("ACPTTEST", 16)
+	t.Logf("Attempting to create group type: %s", name)
+
+	createOpts := grouptypes.CreateOpts{
+		Name: name,
+		GroupSpecs: map[string]string{
+			"consistent_group_snapshot_enabled": "<is> True",
+		},
+	}
+
+	gt, err := grouptypes.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	tools.PrintResource(t, gt)
+	th.AssertEquals(t, name, gt.Name)
+
+	t.Logf("Successfully created group type: %s", gt.ID)
+
+	return gt, nil
+}
+
+// DeleteGroupType will delete a group type. A fatal error will occur if the
+// group type failed to be deleted. This works best when used as a deferred
+// function.
+func DeleteGroupType(t *testing.T, client *gophercloud.ServiceClient, gt *grouptypes.GroupType) {
+	t.Logf("Attempting to delete group type: %s", gt.ID)
+
+	err := grouptypes.Delete(context.TODO(), client, gt.ID).ExtractErr()
+	if err != nil {
+		t.Fatalf("Unable to delete group type %s: %v", gt.ID, err)
+	}
+
+	t.Logf("Successfully deleted group type: %s", gt.ID)
+}
+
+// CreateGroup will create a group with a random name of the given group type
+// and volume type, and wait for it to become available. An error will be
+// returned if the group was unable to be created.
+func CreateGroup(t *testing.T, client *gophercloud.ServiceClient, gt *grouptypes.GroupType, vt *volumetypes.VolumeType) (*groups.Group, error) {
+	name := tools.RandomString("ACPTTEST", 16)
+	t.Logf("Attempting to create group: %s", name)
+
+	createOpts := groups.CreateOpts{
+		Name:        name,
+		GroupType:   gt.ID,
+		VolumeTypes: []string{vt.ID},
+	}
+
+	group, err := groups.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	err = waitForGroupStatus(client, group.ID, "available")
+	if err != nil {
+		return group, err
+	}
+
+	group, err = groups.Get(context.TODO(), client, group.ID).Extract()
+	if err != nil {
+		return group, err
+	}
+
+	tools.PrintResource(t, group)
+	th.AssertEquals(t, name, group.Name)
+	th.AssertEquals(t, gt.ID, group.GroupType)
+
+	t.Logf("Successfully created group: %s", group.ID)
+
+	return group, nil
+}
+
+// DeleteGroup will delete a group together with its volumes. A fatal error
+// will occur if the group failed to be deleted. This works best when used as
+// a deferred function.
+func DeleteGroup(t *testing.T, client *gophercloud.ServiceClient, group *groups.Group) {
+	t.Logf("Attempting to delete group: %s", group.ID)
+
+	err := groups.Delete(context.TODO(), client, group.ID, groups.DeleteOpts{DeleteVolumes: true}).ExtractErr()
+	if err != nil {
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			t.Logf("Group %s is already deleted", group.ID)
+			return
+		}
+		t.Fatalf("Unable to delete group %s: %v", group.ID, err)
+	}
+
+	// Group types can't be deleted while they are in use, so block until
+	// the group is deleted.
+	err = tools.WaitFor(func(ctx context.Context) (bool, error) {
+		_, err := groups.Get(ctx, client, group.ID).Extract()
+		if err != nil {
+			return true, nil
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		t.Fatalf("Error waiting for group to delete: %v", err)
+	}
+
+	t.Logf("Successfully deleted group: %s", group.ID)
+}
+
+// CreateGroupSnapshot will create a snapshot of the given group and wait for
+// it to become available. An error will be returned if the group snapshot was
+// unable to be created.
+func CreateGroupSnapshot(t *testing.T, client *gophercloud.ServiceClient, group *groups.Group) (*groupsnapshots.GroupSnapshot, error) {
+	name := tools.RandomString("ACPTTEST", 16)
+	t.Logf("Attempting to create group snapshot: %s", name)
+
+	createOpts := groupsnapshots.CreateOpts{
+		GroupID: group.ID,
+		Name:    name,
+	}
+
+	gs, err := groupsnapshots.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	err = tools.WaitFor(func(ctx context.Context) (bool, error) {
+		current, err := groupsnapshots.Get(ctx, client, gs.ID).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		return current.Status == "available", nil
+	})
+	if err != nil {
+		return gs, err
+	}
+
+	gs, err = groupsnapshots.Get(context.TODO(), client, gs.ID).Extract()
+	if err != nil {
+		return gs, err
+	}
+
+	tools.PrintResource(t, gs)
+	th.AssertEquals(t, name, gs.Name)
+	th.AssertEquals(t, group.ID, gs.GroupID)
+
+	t.Logf("Successfully created group snapshot: %s", gs.ID)
+
+	return gs, nil
+}
+
+// DeleteGroupSnapshot will delete a group snapshot. A fatal error will occur
+// if the group snapshot failed to be deleted.
+func DeleteGroupSnapshot(t *testing.T, client *gophercloud.ServiceClient, gs *groupsnapshots.GroupSnapshot) {
+	t.Logf("Attempting to delete group snapshot: %s", gs.ID)
+
+	err := groupsnapshots.Delete(context.TODO(), client, gs.ID).ExtractErr()
+	if err != nil {
+		if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			t.Logf("Group snapshot %s is already deleted", gs.ID)
+			return
+		}
+		t.Fatalf("Unable to delete group snapshot %s: %v", gs.ID, err)
+	}
+
+	// Groups can't be deleted until their snapshots have been, so block
+	// until the group snapshot is deleted.
+	err = tools.WaitFor(func(ctx context.Context) (bool, error) {
+		_, err := groupsnapshots.Get(ctx, client, gs.ID).Extract()
+		if err != nil {
+			return true, nil
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		t.Fatalf("Error waiting for group snapshot to delete: %v", err)
+	}
+
+	t.Logf("Successfully deleted group snapshot: %s", gs.ID)
+}
+
+func waitForGroupStatus(client *gophercloud.ServiceClient, id, status string) error {
+	return tools.WaitFor(func(ctx context.Context) (bool, error) {
+		current, err := groups.Get(ctx, client, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		return current.Status == status, nil
+	})
+}
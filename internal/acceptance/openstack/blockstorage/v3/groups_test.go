@@ -0,0 +1,123 @@
+//go:build acceptance || blockstorage || groups
+
+package v3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/clients"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/groupsnapshots"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/grouptypes"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestGroupTypes(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewBlockStorageV3Client()
+	th.AssertNoErr(t, err)
+	client.Microversion = "3.11"
+
+	gt, err := CreateGroupType(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteGroupType(t, client, gt)
+
+	allPages, err := grouptypes.List(client, nil).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	allGroupTypes, err := grouptypes.ExtractGroupTypes(allPages)
+	th.AssertNoErr(t, err)
+
+	var found bool
+	for _, v := range allGroupTypes {
+		tools.PrintResource(t, v)
+		if v.ID == gt.ID {
+			found = true
+		}
+	}
+	th.AssertEquals(t, true, found)
+
+	specs, err := grouptypes.CreateGroupSpecs(context.TODO(), client, gt.ID, grouptypes.GroupSpecsOpts{
+		"consistent_group_replication_enabled": "<is> False",
+	}).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, specs)
+
+	err = grouptypes.DeleteGroupSpec(context.TODO(), client, gt.ID, "consistent_group_replication_enabled").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	name := gt.Name + "-UPDATED"
+	newGT, err := grouptypes.Update(context.TODO(), client, gt.ID, grouptypes.UpdateOpts{Name: &name}).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, newGT)
+	th.AssertEquals(t, name, newGT.Name)
+}
+
+func TestGroupsAndGroupSnapshots(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewBlockStorageV3Client()
+	th.AssertNoErr(t, err)
+	client.Microversion = "3.25"
+
+	gt, err := CreateGroupType(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteGroupType(t, client, gt)
+
+	vt, err := CreateVolumeType(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteVolumeType(t, client, vt)
+
+	group, err := CreateGroup(t, client, gt, vt)
+	th.AssertNoErr(t, err)
+	defer DeleteGroup(t, client, group)
+
+	volume, err := CreateVolumeWithType(t, client, vt)
+	th.AssertNoErr(t, err)
+
+	err = groups.Update(context.TODO(), client, group.ID, groups.UpdateOpts{
+		AddVolumes: []string{volume.ID},
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	err = waitForGroupStatus(client, group.ID, "available")
+	th.AssertNoErr(t, err)
+
+	allPages, err := groups.ListDetail(client, groups.ListOpts{ListVolume: true}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	allGroups, err := groups.ExtractGroups(allPages)
+	th.AssertNoErr(t, err)
+
+	var found bool
+	for _, v := range allGroups {
+		tools.PrintResource(t, v)
+		if v.ID == group.ID {
+			found = true
+			th.AssertDeepEquals(t, []string{volume.ID}, v.Volumes)
+		}
+	}
+	th.AssertEquals(t, true, found)
+
+	gs, err := CreateGroupSnapshot(t, client, group)
+	th.AssertNoErr(t, err)
+	defer DeleteGroupSnapshot(t, client, gs)
+
+	allSnapshotPages, err := groupsnapshots.ListDetail(client, nil).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	allGroupSnapshots, err := groupsnapshots.ExtractGroupSnapshots(allSnapshotPages)
+	th.AssertNoErr(t, err)
+
+	found = false
+	for _, v := range allGroupSnapshots {
+		if v.ID == gs.ID {
+			found = true
+		}
+	}
+	th.AssertEquals(t, true, found)
+}
@@ -0,0 +1,40 @@
+//go:build acceptance || blockstorage || snapshots
+
+package v3
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/clients"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestManageableSnapshots(t *testing.T) {
+	clients.RequireLong(t)
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewBlockStorageV3Client()
+	th.AssertNoErr(t, err)
+
+	client.Microversion = "3.8"
+
+	volume, err := CreateVolume(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteVolume(t, client, volume)
+
+	snapshot1, err := CreateSnapshot(t, client, volume)
+	th.AssertNoErr(t, err)
+
+	err = UnmanageSnapshot(t, client, snapshot1)
+	if err != nil {
+		DeleteSnapshot(t, client, snapshot1)
+	}
+	th.AssertNoErr(t, err)
+
+	managed1, err := ManageExistingSnapshot(t, client, volume, snapshot1)
+	th.AssertNoErr(t, err)
+	defer DeleteSnapshot(t, client, managed1)
+
+	th.AssertEquals(t, snapshot1.Description, managed1.Description)
+	th.AssertEquals(t, snapshot1.Size, managed1.Size)
+}
@@ -74,6 +74,24 @@ func TestKeyPairsImportPublicKey(t *testing.T) {
 	tools.PrintResource(t, keyPair)
 }
 
+func TestKeyPairsGenerate(t *testing.T) {
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	generateOpts := keypairs.GenerateOpts{
+		Name: tools.RandomString("keypair_", 5),
+	}
+
+	keyPair, err := keypairs.Generate(context.TODO(), client, generateOpts)
+	th.AssertNoErr(t, err)
+	defer DeleteKeyPair(t, client, keyPair)
+
+	tools.PrintResource(t, keyPair)
+
+	_, err = ssh.ParsePrivateKey([]byte(keyPair.PrivateKey))
+	th.AssertNoErr(t, err)
+}
+
 func TestKeyPairsServerCreateWithKey(t *testing.T) {
 	clients.RequireLong(t)
 
@@ -0,0 +1,54 @@
+//go:build acceptance || compute || migrations
+
+package v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/clients"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/migrations"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestMigrationsList(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	client.Microversion = "2.80"
+
+	allPages, err := migrations.List(client, nil).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	allMigrations, err := migrations.ExtractMigrations(allPages)
+	th.AssertNoErr(t, err)
+
+	for _, migration := range allMigrations {
+		tools.PrintResource(t, migration)
+	}
+}
+
+func TestServerMigrationsList(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	server, err := CreateServer(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteServer(t, client, server)
+
+	client.Microversion = "2.80"
+
+	allPages, err := migrations.ListServerMigrations(client, server.ID).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	serverMigrations, err := migrations.ExtractServerMigrations(allPages)
+	th.AssertNoErr(t, err)
+
+	// The server has not been live migrated, so there is nothing in progress.
+	th.AssertEquals(t, 0, len(serverMigrations))
+}
@@ -0,0 +1,31 @@
+//go:build acceptance || compute || topology
+
+package v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/clients"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/topology"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestServerTopology(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	server, err := CreateServer(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteServer(t, client, server)
+
+	client.Microversion = "2.78"
+
+	serverTopology, err := topology.Get(context.TODO(), client, server.ID).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, serverTopology)
+}
@@ -0,0 +1,52 @@
+//go:build acceptance || networking || layer3 || conntrackhelpers
+
+package layer3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/clients"
+	networking "github.com/gophercloud/gophercloud/v2/internal/acceptance/openstack/networking/v2"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/conntrackhelpers"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestLayer3ConntrackHelpersCRUD(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	th.AssertNoErr(t, err)
+
+	networking.RequireNeutronExtension(t, client, "l3-conntrack-helper")
+
+	network, err := networking.CreateNetwork(t, client)
+	th.AssertNoErr(t, err)
+	defer networking.DeleteNetwork(t, client, network.ID)
+
+	router, err := CreateRouter(t, client, network.ID)
+	th.AssertNoErr(t, err)
+	defer DeleteRouter(t, client, router.ID)
+
+	helper, err := CreateConntrackHelper(t, client, router.ID)
+	th.AssertNoErr(t, err)
+	defer DeleteConntrackHelper(t, client, router.ID, helper.ID)
+
+	port := 2121
+	updateOpts := conntrackhelpers.UpdateOpts{
+		Port: &port,
+	}
+
+	newHelper, err := conntrackhelpers.Update(context.TODO(), client, router.ID, helper.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2121, newHelper.Port)
+
+	tools.PrintResource(t, newHelper)
+
+	allPages, err := conntrackhelpers.List(client, router.ID, nil).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	allHelpers, err := conntrackhelpers.ExtractConntrackHelpers(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(allHelpers))
+	th.AssertEquals(t, helper.ID, allHelpers[0].ID)
+}
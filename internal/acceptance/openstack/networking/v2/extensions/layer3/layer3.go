@@ -10,6 +10,7 @@ import (
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/internal/acceptance/clients"
 	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/conntrackhelpers"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/portforwarding"
 
@@ -110,6 +111,44 @@ func DeletePortForwarding(t *testing.T, client *gophercloud.ServiceClient, fipID
 
 }
 
+// CreateConntrackHelper creates an FTP conntrack helper on a router. An error
+// will be returned if the creation failed.
+func CreateConntrackHelper(t *testing.T, client *gophercloud.ServiceClient, routerID string) (*conntrackhelpers.ConntrackHelper, error) {
+	t.Logf("Attempting to create a conntrack helper on router %s", routerID)
+
+	createOpts := conntrackhelpers.CreateOpts{
+		Protocol: "tcp",
+		Port:     21,
+		Helper:   "ftp",
+	}
+
+	helper, err := conntrackhelpers.Create(context.TODO(), client, routerID, createOpts).Extract()
+	if err != nil {
+		return helper, err
+	}
+
+	t.Logf("Created conntrack helper %s on router %s", helper.ID, routerID)
+
+	th.AssertEquals(t, "tcp", helper.Protocol)
+	th.AssertEquals(t, 21, helper.Port)
+	th.AssertEquals(t, "ftp", helper.Helper)
+
+	return helper, nil
+}
+
+// DeleteConntrackHelper deletes a conntrack helper of a router. A fatal error
+// will occur if the deletion fails. Works best as a deferred function.
+func DeleteConntrackHelper(t *testing.T, client *gophercloud.ServiceClient, routerID, helperID string) {
+	t.Logf("Attempting to delete conntrack helper %s of router %s", helperID, routerID)
+
+	err := conntrackhelpers.Delete(context.TODO(), client, routerID, helperID).ExtractErr()
+	if err != nil {
+		t.Fatalf("Failed to delete conntrack helper %s of router %s: %v", helperID, routerID, err)
+	}
+
+	t.Logf("Deleted conntrack helper %s of router %s", helperID, routerID)
+}
+
 // CreateExternalRouter creates a router on the external network. This requires
 // the OS_EXTGW_ID environment variable to be set. An error is returned if the
 // creation failed.
@@ -0,0 +1,52 @@
+package logging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/logging"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+// CreateSecurityGroupLog will create a log of the dropped packets of a
+// security group. An error will be returned if the log could not be created.
+func CreateSecurityGroupLog(t *testing.T, client *gophercloud.ServiceClient, secGroupID string) (*logging.Log, error) {
+	name := tools.RandomString("TESTACC-LOG-", 8)
+	t.Logf("Attempting to create log %s", name)
+
+	opts := logging.CreateOpts{
+		Name:         name,
+		ResourceType: logging.ResourceTypeSecurityGroup,
+		ResourceID:   secGroupID,
+		Event:        logging.EventDrop,
+	}
+
+	log, err := logging.Create(context.TODO(), client, opts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	t.Logf("Successfully created log %s", name)
+
+	th.AssertEquals(t, name, log.Name)
+	th.AssertEquals(t, secGroupID, log.ResourceID)
+	th.AssertEquals(t, logging.EventDrop, log.Event)
+	th.AssertEquals(t, true, log.Enabled)
+
+	return log, nil
+}
+
+// DeleteLog will delete a log with the specified ID. A fatal error will
+// occur if the delete was not successful.
+func DeleteLog(t *testing.T, client *gophercloud.ServiceClient, logID string) {
+	t.Logf("Attempting to delete log %s", logID)
+
+	err := logging.Delete(context.TODO(), client, logID).ExtractErr()
+	if err != nil {
+		t.Fatalf("Failed to delete log %s: %v", logID, err)
+	}
+
+	t.Logf("Deleted log %s", logID)
+}
@@ -0,0 +1,82 @@
+//go:build acceptance || networking || logging
+
+package logging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/clients"
+	networking "github.com/gophercloud/gophercloud/v2/internal/acceptance/openstack/networking/v2"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/openstack/networking/v2/extensions"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/logging"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestLoggableResourcesList(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	th.AssertNoErr(t, err)
+
+	// Skip these tests if we don't have the required extension
+	networking.RequireNeutronExtension(t, client, "logging")
+
+	allPages, err := logging.ListLoggableResources(client).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	allResources, err := logging.ExtractLoggableResources(allPages)
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, allResources)
+}
+
+func TestLogsCRUD(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	th.AssertNoErr(t, err)
+
+	// Skip these tests if we don't have the required extension
+	networking.RequireNeutronExtension(t, client, "logging")
+
+	group, err := extensions.CreateSecurityGroup(t, client)
+	th.AssertNoErr(t, err)
+	defer extensions.DeleteSecurityGroup(t, client, group.ID)
+
+	log, err := CreateSecurityGroupLog(t, client, group.ID)
+	th.AssertNoErr(t, err)
+	defer DeleteLog(t, client, log.ID)
+
+	tools.PrintResource(t, log)
+
+	newName := tools.RandomString("TESTACC-LOG-", 8)
+	enabled := false
+	updateOpts := logging.UpdateOpts{
+		Name:    &newName,
+		Enabled: &enabled,
+	}
+
+	newLog, err := logging.Update(context.TODO(), client, log.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, newLog)
+	th.AssertEquals(t, newName, newLog.Name)
+	th.AssertEquals(t, false, newLog.Enabled)
+
+	listOpts := logging.ListOpts{
+		ResourceID: group.ID,
+	}
+
+	allPages, err := logging.List(client, listOpts).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	allLogs, err := logging.ExtractLogs(allPages)
+	th.AssertNoErr(t, err)
+
+	var found bool
+	for _, l := range allLogs {
+		if l.ID == log.ID {
+			found = true
+		}
+	}
+
+	th.AssertEquals(t, true, found)
+}
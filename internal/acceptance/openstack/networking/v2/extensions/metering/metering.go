@@ -0,0 +1,85 @@
+package metering
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/metering/labels"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/metering/rules"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+// CreateLabel will create a metering label with a random name. An error will
+// be returned if the label could not be created.
+func CreateLabel(t *testing.T, client *gophercloud.ServiceClient) (*labels.Label, error) {
+	name := tools.RandomString("TESTACC-", 8)
+	t.Logf("Attempting to create metering label %s", name)
+
+	opts := labels.CreateOpts{
+		Name:        name,
+		Description: "acceptance test metering label",
+	}
+
+	label, err := labels.Create(context.TODO(), client, opts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	t.Logf("Successfully created metering label %s", name)
+
+	th.AssertEquals(t, name, label.Name)
+
+	return label, nil
+}
+
+// CreateRule will create an egress metering label rule for the given label.
+// An error will be returned if the rule could not be created.
+func CreateRule(t *testing.T, client *gophercloud.ServiceClient, labelID string) (*rules.Rule, error) {
+	t.Logf("Attempting to create metering label rule for label %s", labelID)
+
+	opts := rules.CreateOpts{
+		MeteringLabelID:     labelID,
+		Direction:           rules.DirEgress,
+		DestinationIPPrefix: "0.0.0.0/0",
+	}
+
+	rule, err := rules.Create(context.TODO(), client, opts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	t.Logf("Successfully created metering label rule %s", rule.ID)
+
+	th.AssertEquals(t, labelID, rule.MeteringLabelID)
+	th.AssertEquals(t, rules.DirEgress, rule.Direction)
+
+	return rule, nil
+}
+
+// DeleteLabel will delete a metering label with the specified ID. A fatal
+// error will occur if the delete was not successful.
+func DeleteLabel(t *testing.T, client *gophercloud.ServiceClient, labelID string) {
+	t.Logf("Attempting to delete metering label %s", labelID)
+
+	err := labels.Delete(context.TODO(), client, labelID).ExtractErr()
+	if err != nil {
+		t.Fatalf("Failed to delete metering label %s: %v", labelID, err)
+	}
+
+	t.Logf("Deleted metering label %s", labelID)
+}
+
+// DeleteRule will delete a metering label rule with the specified ID. A
+// fatal error will occur if the delete was not successful.
+func DeleteRule(t *testing.T, client *gophercloud.ServiceClient, ruleID string) {
+	t.Logf("Attempting to delete metering label rule %s", ruleID)
+
+	err := rules.Delete(context.TODO(), client, ruleID).ExtractErr()
+	if err != nil {
+		t.Fatalf("Failed to delete metering label rule %s: %v", ruleID, err)
+	}
+
+	t.Logf("Deleted metering label rule %s", ruleID)
+}
@@ -0,0 +1,49 @@
+//go:build acceptance || networking || metering
+
+package metering
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/clients"
+	networking "github.com/gophercloud/gophercloud/v2/internal/acceptance/openstack/networking/v2"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/metering/labels"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/metering/rules"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestMeteringCRUD(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewNetworkV2Client()
+	th.AssertNoErr(t, err)
+
+	// Skip these tests if we don't have the required extension
+	networking.RequireNeutronExtension(t, client, "metering")
+
+	label, err := CreateLabel(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteLabel(t, client, label.ID)
+
+	rule, err := CreateRule(t, client, label.ID)
+	th.AssertNoErr(t, err)
+	defer DeleteRule(t, client, rule.ID)
+
+	newLabel, err := labels.Get(context.TODO(), client, label.ID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, newLabel)
+
+	newRule, err := rules.Get(context.TODO(), client, rule.ID).Extract()
+	th.AssertNoErr(t, err)
+	tools.PrintResource(t, newRule)
+
+	allPages, err := rules.List(client, rules.ListOpts{MeteringLabelID: label.ID}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	allRules, err := rules.ExtractRules(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(allRules))
+	th.AssertEquals(t, rule.ID, allRules[0].ID)
+}
@@ -152,3 +152,81 @@ func TestMinimumBandwidthRulesCRUD(t *testing.T) {
 
 	th.AssertEquals(t, found, true)
 }
+
+func TestPacketRateLimitRulesCRUD(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	th.AssertNoErr(t, err)
+
+	// Skip these tests if we don't have the required extension
+	v2.RequireNeutronExtension(t, client, "qos-pps")
+
+	// Create a QoS policy
+	policy, err := accpolicies.CreateQoSPolicy(t, client)
+	th.AssertNoErr(t, err)
+	defer policies.Delete(context.TODO(), client, policy.ID)
+
+	// Create a QoS policy rule.
+	rule, err := CreatePacketRateLimitRule(t, client, policy.ID)
+	th.AssertNoErr(t, err)
+	defer rules.DeletePacketRateLimitRule(context.TODO(), client, policy.ID, rule.ID)
+
+	// Update the QoS policy rule.
+	maxKPps := 500
+	updateOpts := rules.UpdatePacketRateLimitRuleOpts{
+		MaxKPps: &maxKPps,
+	}
+	newRule, err := rules.UpdatePacketRateLimitRule(context.TODO(), client, policy.ID, rule.ID, updateOpts).ExtractPacketRateLimitRule()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, newRule)
+	th.AssertEquals(t, newRule.MaxKPps, 500)
+
+	allPages, err := rules.ListPacketRateLimitRules(client, policy.ID, rules.PacketRateLimitRulesListOpts{}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	allRules, err := rules.ExtractPacketRateLimitRules(allPages)
+	th.AssertNoErr(t, err)
+
+	var found bool
+	for _, rule := range allRules {
+		if rule.ID == newRule.ID {
+			found = true
+		}
+	}
+
+	th.AssertEquals(t, found, true)
+}
+
+func TestMinimumPacketRateRulesCRUD(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	th.AssertNoErr(t, err)
+
+	// Skip these tests if we don't have the required extensions
+	v2.RequireNeutronExtension(t, client, "qos-pps-minimum")
+	v2.RequireNeutronExtension(t, client, "qos-pps-minimum-rule-alias")
+
+	// Create a QoS policy
+	policy, err := accpolicies.CreateQoSPolicy(t, client)
+	th.AssertNoErr(t, err)
+	defer policies.Delete(context.TODO(), client, policy.ID)
+
+	// Create a QoS policy rule.
+	rule, err := CreateMinimumPacketRateRule(t, client, policy.ID)
+	th.AssertNoErr(t, err)
+	defer rules.DeleteMinimumPacketRateRule(context.TODO(), client, policy.ID, rule.ID)
+
+	// Update the QoS policy rule through its alias.
+	minKPps := 500
+	updateOpts := rules.UpdateMinimumPacketRateRuleOpts{
+		MinKPps: &minKPps,
+	}
+	newRule, err := rules.UpdateMinimumPacketRateRuleAlias(context.TODO(), client, rule.ID, updateOpts).ExtractMinimumPacketRateRule()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, newRule)
+	th.AssertEquals(t, newRule.MinKPps, 500)
+
+	newRule, err = rules.GetMinimumPacketRateRule(context.TODO(), client, policy.ID, rule.ID).ExtractMinimumPacketRateRule()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, newRule.MinKPps, 500)
+}
@@ -0,0 +1,79 @@
+//go:build acceptance || networking || topology
+
+package topology
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/clients"
+	networking "github.com/gophercloud/gophercloud/v2/internal/acceptance/openstack/networking/v2"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/topology"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestTopologyReconcile(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	th.AssertNoErr(t, err)
+
+	// Skip these tests if we don't have the required extension
+	networking.RequireNeutronExtension(t, client, "standard-attr-tag")
+
+	desired := topology.Topology{
+		Tag: tools.RandomString("TESTACC-", 8),
+		Networks: []topology.Network{
+			{
+				Name: "web",
+				Subnets: []topology.Subnet{
+					{Name: "web-v4", CIDR: "192.168.199.0/24"},
+				},
+			},
+		},
+		SecurityGroups: []topology.SecurityGroup{
+			{
+				Name: "web",
+				Rules: []topology.SecurityGroupRule{
+					{
+						Direction:      rules.DirIngress,
+						Protocol:       rules.ProtocolTCP,
+						PortRangeMin:   443,
+						PortRangeMax:   443,
+						RemoteIPPrefix: "0.0.0.0/0",
+					},
+				},
+			},
+		},
+		Routers: []topology.Router{
+			{
+				Name: "web",
+				Interfaces: []topology.SubnetRef{
+					{Network: "web", Subnet: "web-v4"},
+				},
+			},
+		},
+	}
+
+	plan, err := topology.Reconcile(context.TODO(), client, desired)
+	th.AssertNoErr(t, err)
+	defer func() {
+		plan, err := topology.Reconcile(context.TODO(), client, topology.Topology{Tag: desired.Tag})
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, 4, len(plan.Actions))
+	}()
+
+	for _, action := range plan.Actions {
+		t.Log(action)
+	}
+	th.AssertEquals(t, 6, len(plan.Actions))
+
+	plan, err = topology.Diff(context.TODO(), client, desired)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, plan.Empty())
+
+	desired.Networks[0].Description = "web network"
+	plan, err = topology.Reconcile(context.TODO(), client, desired)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(plan.Actions))
+}
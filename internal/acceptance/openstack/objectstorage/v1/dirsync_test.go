@@ -0,0 +1,81 @@
+//go:build acceptance || objectstorage || dirsync
+
+package v1
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/clients"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/dirsync"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestDirSync(t *testing.T) {
+	client, err := clients.NewObjectStorageV1Client()
+	if err != nil {
+		t.Fatalf("Unable to create client: %v", err)
+	}
+
+	cName := "test-container-" + tools.RandomFunnyStringNoSlash(8)
+	defer func() {
+		for _, name := range []string{cName, cName + "_segments"} {
+			t.Logf("Deleting container %s", name)
+			res := containers.Delete(context.TODO(), client, name)
+			th.AssertNoErr(t, res.Err)
+		}
+	}()
+
+	src := t.TempDir()
+	files := map[string]string{
+		"a.txt":       tools.RandomString("", 16),
+		"sub/b.txt":   tools.RandomString("", 16),
+		"sub/big.bin": tools.RandomString("", 3000),
+	}
+	for path, content := range files {
+		name := filepath.Join(src, filepath.FromSlash(path))
+		th.AssertNoErr(t, os.MkdirAll(filepath.Dir(name), 0o755))
+		th.AssertNoErr(t, os.WriteFile(name, []byte(content), 0o644))
+	}
+
+	syncOpts := dirsync.SyncOpts{
+		Prefix:      "mirror/",
+		Checksum:    true,
+		Delete:      true,
+		Concurrency: 2,
+		SegmentSize: 1024,
+	}
+
+	summary, err := dirsync.Upload(context.TODO(), client, src, cName, syncOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(summary.Failed()))
+	th.AssertEquals(t, 3, len(summary.Transferred()))
+
+	tools.PrintResource(t, summary)
+
+	summary, err = dirsync.Upload(context.TODO(), client, src, cName, syncOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, len(summary.Unchanged()))
+
+	dst := t.TempDir()
+	summary, err = dirsync.Download(context.TODO(), client, cName, dst, syncOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(summary.Failed()))
+	th.AssertEquals(t, 3, len(summary.Transferred()))
+
+	for path, content := range files {
+		downloaded, err := os.ReadFile(filepath.Join(dst, filepath.FromSlash(path)))
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, content, string(downloaded))
+	}
+
+	// Syncing an empty directory deletes every object, with the segments
+	// of the large object.
+	summary, err = dirsync.Upload(context.TODO(), client, t.TempDir(), cName, syncOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, len(summary.Deleted()))
+}
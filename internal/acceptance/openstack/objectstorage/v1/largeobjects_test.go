@@ -0,0 +1,72 @@
+//go:build acceptance || objectstorage || largeobjects
+
+package v1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/clients"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/largeobjects"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/objects"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestLargeObjects(t *testing.T) {
+	client, err := clients.NewObjectStorageV1Client()
+	if err != nil {
+		t.Fatalf("Unable to create client: %v", err)
+	}
+
+	cName := "test-container-" + tools.RandomFunnyStringNoSlash(8)
+	_, err = containers.Create(context.TODO(), client, cName, nil).Extract()
+	th.AssertNoErr(t, err)
+
+	sName := cName + "_segments"
+	defer func() {
+		for _, name := range []string{cName, sName} {
+			t.Logf("Deleting container %s", name)
+			res := containers.Delete(context.TODO(), client, name)
+			th.AssertNoErr(t, res.Err)
+		}
+	}()
+
+	content := strings.Repeat(tools.RandomString("", 1024), 3) + "tail"
+
+	for _, manifestType := range []largeobjects.ManifestType{largeobjects.StaticLargeObject, largeobjects.DynamicLargeObject} {
+		oName := "test-object-" + tools.RandomFunnyString(8)
+
+		uploadOpts := largeobjects.UploadOpts{
+			Content:      strings.NewReader(content),
+			SegmentSize:  1024,
+			ManifestType: manifestType,
+			Concurrency:  2,
+			Retries:      1,
+		}
+		result, err := largeobjects.Upload(context.TODO(), client, cName, oName, uploadOpts)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, 4, len(result.Segments))
+
+		tools.PrintResource(t, result)
+
+		res := objects.Download(context.TODO(), client, cName, oName, nil)
+		downloaded, err := res.ExtractContent()
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, content, string(downloaded))
+
+		// Resuming a complete upload does not send any segment again.
+		uploadOpts.Content = strings.NewReader(content)
+		uploadOpts.Resume = true
+		result, err = largeobjects.Upload(context.TODO(), client, cName, oName, uploadOpts)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, 0, len(result.Uploaded()))
+
+		deleted, err := largeobjects.Delete(context.TODO(), client, cName, oName, largeobjects.DeleteOpts{Concurrency: 2})
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, manifestType, deleted.ManifestType)
+		th.AssertEquals(t, 4, len(deleted.Segments))
+	}
+}
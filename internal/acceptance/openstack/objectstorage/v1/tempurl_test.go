@@ -0,0 +1,97 @@
+//go:build acceptance || objectstorage || objects
+
+package v1
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/clients"
+	"github.com/gophercloud/gophercloud/v2/internal/acceptance/tools"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/objects"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestObjectsPrefixTempURLAndFormPost(t *testing.T) {
+	client, err := clients.NewObjectStorageV1Client()
+	if err != nil {
+		t.Fatalf("Unable to create client: %v", err)
+	}
+
+	cName := "test-container-" + tools.RandomFunnyStringNoSlash(8)
+	createOpts := containers.CreateOpts{
+		TempURLKey2: "super-secret",
+	}
+	_, err = containers.Create(context.TODO(), client, cName, createOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	defer func() {
+		res := containers.Delete(context.TODO(), client, cName)
+		th.AssertNoErr(t, res.Err)
+	}()
+
+	oName := "reports/" + tools.RandomFunnyString(8)
+	oContent := tools.RandomFunnyString(10)
+	res := objects.Create(context.TODO(), client, cName, oName, objects.CreateOpts{Content: strings.NewReader(oContent)})
+	th.AssertNoErr(t, res.Err)
+
+	defer func() {
+		res := objects.Delete(context.TODO(), client, cName, oName, nil)
+		th.AssertNoErr(t, res.Err)
+	}()
+
+	// The key is resolved from X-Container-Meta-Temp-URL-Key-2.
+	tempURL, err := objects.CreateTempURL(context.TODO(), client, cName, oName, objects.CreateTempURLOpts{
+		Method: http.MethodGet,
+		TTL:    180,
+		Prefix: "reports/",
+	})
+	th.AssertNoErr(t, err)
+
+	resp, err := client.HTTPClient.Get(tempURL)
+	th.AssertNoErr(t, err)
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, http.StatusOK, resp.StatusCode)
+	th.AssertEquals(t, oContent, string(body))
+
+	formPost, err := objects.CreateFormPost(context.TODO(), client, cName, objects.CreateFormPostOpts{
+		Prefix:       "uploads/",
+		MaxFileSize:  1024,
+		MaxFileCount: 1,
+		TTL:          180,
+	})
+	th.AssertNoErr(t, err)
+
+	var form bytes.Buffer
+	w := multipart.NewWriter(&form)
+	for name, value := range formPost.Fields() {
+		th.AssertNoErr(t, w.WriteField(name, value))
+	}
+	part, err := w.CreateFormFile("file1", "hello.txt")
+	th.AssertNoErr(t, err)
+	_, err = part.Write([]byte(oContent))
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, w.Close())
+
+	resp, err = client.HTTPClient.Post(formPost.URL, w.FormDataContentType(), &form)
+	th.AssertNoErr(t, err)
+	resp.Body.Close()
+	th.AssertEquals(t, http.StatusCreated, resp.StatusCode)
+
+	defer func() {
+		res := objects.Delete(context.TODO(), client, cName, "uploads/hello.txt", nil)
+		th.AssertNoErr(t, res.Err)
+	}()
+
+	header, err := objects.Get(context.TODO(), client, cName, "uploads/hello.txt", nil).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, int64(len(oContent)), header.ContentLength)
+}
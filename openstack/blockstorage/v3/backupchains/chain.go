@@ -0,0 +1,154 @@
+package backupchains
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+)
+
+// Build arranges backups into chains, grouped by volume, oldest chain first.
+//
+// The Block Storage API does not return the parent of a backup, so it is
+// inferred the way the service chooses it: an incremental backup depends on
+// the backup of the same volume holding the most recent data at the time the
+// incremental backup was created. An incremental backup without any such
+// backup becomes the root of an incomplete chain.
+func Build(all []backups.Backup) []Chain {
+	byVolume := make(map[string][]backups.Backup)
+	for _, b := range all {
+		byVolume[b.VolumeID] = append(byVolume[b.VolumeID], b)
+	}
+
+	var chains []Chain
+	for _, volumeID := range slices.Sorted(maps.Keys(byVolume)) {
+		chains = append(chains, buildVolume(volumeID, byVolume[volumeID])...)
+	}
+	return chains
+}
+
+func buildVolume(volumeID string, volumeBackups []backups.Backup) []Chain {
+	slices.SortStableFunc(volumeBackups, func(a, b backups.Backup) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.ID, b.ID))
+	})
+
+	var chains []Chain
+	var seen []*Node
+	for _, b := range volumeBackups {
+		n := &Node{Backup: b}
+
+		var parent *Node
+		if b.IsIncremental {
+			for _, candidate := range seen {
+				if !dataTime(candidate.Backup).Before(dataTime(b)) {
+					continue
+				}
+				if parent == nil || dataTime(candidate.Backup).After(dataTime(parent.Backup)) {
+					parent = candidate
+				}
+			}
+		}
+
+		if parent == nil {
+			chains = append(chains, Chain{VolumeID: volumeID, Root: n})
+		} else {
+			n.Parent = parent
+			parent.Children = append(parent.Children, n)
+		}
+		seen = append(seen, n)
+	}
+	return chains
+}
+
+// NextIsIncremental reports whether the next backup of a volume, given the
+// chains of its backups, can be an incremental backup. It cannot when the
+// volume has no backup, when its most recent backup is not available or is
+// part of an incomplete chain, or when that backup already is the
+// maxIncrementals-th incremental of its chain. A maxIncrementals of zero does
+// not limit the length of chains.
+func NextIsIncremental(chains []Chain, maxIncrementals int) bool {
+	var latest *Node
+	var latestChain Chain
+	for _, c := range chains {
+		n := c.Latest()
+		if latest == nil || dataTime(n.Backup).After(dataTime(latest.Backup)) {
+			latest, latestChain = n, c
+		}
+	}
+
+	if latest == nil || !latestChain.Complete() || latest.Backup.Status != "available" {
+		return false
+	}
+
+	incrementals := len(latest.Path()) - 1
+	return maxIncrementals == 0 || incrementals < maxIncrementals
+}
+
+// PlanRetention returns the backups to delete to enforce the retention policy
+// on the chains of a volume, in an order in which they can be deleted: every
+// backup before the backup it depends on.
+//
+// Incomplete chains are never pruned, and neither are backups which are
+// busy, i.e. neither available nor in error, or which have a dependent
+// backup which is not deleted.
+func PlanRetention(chains []Chain, policy RetentionPolicy) ([]backups.Backup, error) {
+	if policy.KeepFulls < 1 {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "backupchains.RetentionPolicy.KeepFulls"
+		err.Value = policy.KeepFulls
+		err.Info = "Must keep at least one full backup"
+		return nil, err
+	}
+
+	var complete []Chain
+	for _, c := range chains {
+		if c.Complete() {
+			complete = append(complete, c)
+		}
+	}
+	slices.SortStableFunc(complete, func(a, b Chain) int {
+		return dataTime(b.Root.Backup).Compare(dataTime(a.Root.Backup))
+	})
+
+	var plan []backups.Backup
+	for i := len(complete) - 1; i >= 0; i-- {
+		switch {
+		case i >= policy.KeepFulls:
+			plan = append(plan, prune(complete[i].Root)...)
+		case policy.KeepIncrementals > 0 && i >= policy.KeepIncrementals:
+			for _, child := range complete[i].Root.Children {
+				plan = append(plan, prune(child)...)
+			}
+		}
+	}
+	return plan, nil
+}
+
+// prune returns the backups of the subtree of n which can be deleted,
+// children first. A backup can only be deleted once all the backups
+// depending on it are.
+func prune(n *Node) []backups.Backup {
+	var plan []backups.Backup
+	var walk func(n *Node) bool
+	walk = func(n *Node) bool {
+		all := true
+		for _, child := range n.Children {
+			if !walk(child) {
+				all = false
+			}
+		}
+		if !all || !deletable(n.Backup) {
+			return false
+		}
+		plan = append(plan, n.Backup)
+		return true
+	}
+	walk(n)
+	return plan
+}
+
+func deletable(b backups.Backup) bool {
+	return b.Status == "available" || b.Status == "error"
+}
@@ -0,0 +1,67 @@
+/*
+Package backupchains helps with the chains formed by full and incremental
+volume backups of the OpenStack Block Storage service.
+
+An incremental backup only holds the changes since the backup it depends on,
+so a backup can only be restored, or deleted, together with the backups of
+its chain. This package rebuilds the chains of a volume, decides whether the
+next backup can be incremental, enforces retention policies without breaking
+chains, moves whole chains between clouds and restores backups.
+
+Example to create the next backup of a Volume
+
+	createOpts := backups.CreateOpts{
+		VolumeID: "cf9bc6fa-c5bc-41f6-bc4e-6e76c0bea959",
+		Name:     "nightly",
+		Force:    true,
+	}
+
+	// Start a new chain after six incrementals.
+	backup, err := backupchains.Create(context.TODO(), client, createOpts, 6)
+	if err != nil {
+		panic(err)
+	}
+
+Example to enforce a retention policy
+
+	policy := backupchains.RetentionPolicy{
+		KeepFulls:        4,
+		KeepIncrementals: 1,
+	}
+
+	deleted, err := backupchains.EnforceRetention(context.TODO(), client, volumeID, policy)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, backup := range deleted {
+		fmt.Printf("Deleted backup %s\n", backup.ID)
+	}
+
+Example to move the latest chain of a Volume to another cloud
+
+	chains, err := backupchains.List(context.TODO(), sourceClient, volumeID)
+	if err != nil {
+		panic(err)
+	}
+
+	bundle, err := backupchains.Export(context.TODO(), sourceClient, chains[len(chains)-1])
+	if err != nil {
+		panic(err)
+	}
+
+	imported, err := backupchains.Import(context.TODO(), targetClient, bundle)
+	if err != nil {
+		panic(err)
+	}
+
+	restoreOpts := backups.RestoreOpts{
+		Name: "restored",
+	}
+
+	volume, err := backupchains.Restore(context.TODO(), targetClient, imported[len(imported)-1], restoreOpts)
+	if err != nil {
+		panic(err)
+	}
+*/
+package backupchains
@@ -0,0 +1,49 @@
+package backupchains
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// ErrMissingParent is the error when a bundle holds an incremental backup
+// without the backup it depends on.
+type ErrMissingParent struct {
+	gophercloud.BaseError
+	BackupID string
+	ParentID string
+}
+
+func (e ErrMissingParent) Error() string {
+	return fmt.Sprintf("backup %s depends on backup %s which is not in the bundle", e.BackupID, e.ParentID)
+}
+
+// ErrBackupFailed is the error when a backup ends up in an error state while
+// waiting for it.
+type ErrBackupFailed struct {
+	gophercloud.BaseError
+	BackupID   string
+	Status     string
+	FailReason string
+}
+
+func (e ErrBackupFailed) Error() string {
+	if e.FailReason == "" {
+		return fmt.Sprintf("backup %s is in status %s", e.BackupID, e.Status)
+	}
+	return fmt.Sprintf("backup %s is in status %s: %s", e.BackupID, e.Status, e.FailReason)
+}
+
+// ErrRestoreFailed is the error when a restored volume does not end up
+// available with at least the size of the backup.
+type ErrRestoreFailed struct {
+	gophercloud.BaseError
+	BackupID string
+	VolumeID string
+	Status   string
+	Size     int
+}
+
+func (e ErrRestoreFailed) Error() string {
+	return fmt.Sprintf("restore of backup %s to volume %s failed: volume is %s with size %d", e.BackupID, e.VolumeID, e.Status, e.Size)
+}
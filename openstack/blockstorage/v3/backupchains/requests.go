@@ -0,0 +1,258 @@
+package backupchains
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+)
+
+// List retrieves the backups of a volume and arranges them into chains,
+// oldest chain first.
+func List(ctx context.Context, client *gophercloud.ServiceClient, volumeID string) ([]Chain, error) {
+	allPages, err := backups.ListDetail(client, backups.ListDetailOpts{VolumeID: volumeID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allBackups, err := backups.ExtractBackups(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var volumeBackups []backups.Backup
+	for _, b := range allBackups {
+		if b.VolumeID == volumeID {
+			volumeBackups = append(volumeBackups, b)
+		}
+	}
+
+	return Build(volumeBackups), nil
+}
+
+// Create creates a backup of the volume of opts. The backup is incremental
+// if NextIsIncremental allows it for the current chains of the volume, and a
+// full backup otherwise; opts.Incremental is ignored.
+func Create(ctx context.Context, client *gophercloud.ServiceClient, opts backups.CreateOpts, maxIncrementals int) (*backups.Backup, error) {
+	chains, err := List(ctx, client, opts.VolumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Incremental = NextIsIncremental(chains, maxIncrementals)
+	return backups.Create(ctx, client, opts).Extract()
+}
+
+// EnforceRetention deletes the backups of a volume which the retention policy
+// does not keep, as planned by PlanRetention. Each deletion is awaited before
+// deleting the backup it depended on. The deleted backups are returned, also
+// when an error interrupts the deletions.
+func EnforceRetention(ctx context.Context, client *gophercloud.ServiceClient, volumeID string, policy RetentionPolicy) ([]backups.Backup, error) {
+	chains, err := List(ctx, client, volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := PlanRetention(chains, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []backups.Backup
+	for _, b := range plan {
+		err := backups.Delete(ctx, client, b.ID).ExtractErr()
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return deleted, err
+		}
+
+		if err := waitForDeletion(ctx, client, b.ID); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, b)
+	}
+	return deleted, nil
+}
+
+// Export exports the records of all the backups of a chain into a Bundle.
+// The parent of each backup is taken from its export record rather than
+// inferred. Exporting backups usually requires administrative privileges.
+func Export(ctx context.Context, client *gophercloud.ServiceClient, chain Chain) (*Bundle, error) {
+	bundle := &Bundle{VolumeID: chain.VolumeID}
+	for _, n := range chain.Nodes() {
+		record, err := backups.Export(ctx, client, n.Backup.ID).Extract()
+		if err != nil {
+			return nil, err
+		}
+
+		parentID, err := RecordParentID(*record)
+		if err != nil {
+			return nil, err
+		}
+
+		bundle.Records = append(bundle.Records, Record{
+			BackupRecord: *record,
+			BackupID:     n.Backup.ID,
+			ParentID:     parentID,
+			Name:         n.Backup.Name,
+		})
+	}
+	return bundle, nil
+}
+
+// RecordParentID returns the ID of the backup the exported backup is an
+// incremental of, or an empty string for a full backup.
+func RecordParentID(record backups.BackupRecord) (string, error) {
+	var b backups.ImportBackup
+	if err := json.Unmarshal(record.BackupURL, &b); err != nil {
+		return "", err
+	}
+	if b.ParentID == nil {
+		return "", nil
+	}
+	return *b.ParentID, nil
+}
+
+// Import imports the records of a Bundle, every backup after the backup it
+// depends on, and waits for each imported backup to become available. The
+// IDs of the imported backups are returned, also when an error interrupts
+// the import. The bundle is checked for missing parents before importing
+// anything.
+func Import(ctx context.Context, client *gophercloud.ServiceClient, bundle *Bundle) ([]string, error) {
+	records, err := sortRecords(bundle.Records)
+	if err != nil {
+		return nil, err
+	}
+
+	var imported []string
+	for _, record := range records {
+		backup, err := backups.Import(ctx, client, backups.ImportOpts(record.BackupRecord)).Extract()
+		if err != nil {
+			return imported, err
+		}
+
+		if err := waitForBackup(ctx, client, backup.ID); err != nil {
+			return imported, err
+		}
+		imported = append(imported, backup.ID)
+	}
+	return imported, nil
+}
+
+// sortRecords orders records so that every record comes after the record of
+// its parent.
+func sortRecords(records []Record) ([]Record, error) {
+	byID := make(map[string]Record, len(records))
+	for _, r := range records {
+		byID[r.BackupID] = r
+	}
+
+	sorted := make([]Record, 0, len(records))
+	done := make(map[string]bool, len(records))
+	var visit func(r Record) error
+	visit = func(r Record) error {
+		if done[r.BackupID] {
+			return nil
+		}
+		done[r.BackupID] = true
+		if r.ParentID != "" {
+			parent, ok := byID[r.ParentID]
+			if !ok {
+				return ErrMissingParent{BackupID: r.BackupID, ParentID: r.ParentID}
+			}
+			if err := visit(parent); err != nil {
+				return err
+			}
+		}
+		sorted = append(sorted, r)
+		return nil
+	}
+
+	for _, r := range records {
+		if err := visit(r); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
+
+// Restore restores a backup, together with the backups it depends on, and
+// waits for the restore to complete. The restored volume is returned once it
+// is available and at least as large as the backup.
+func Restore(ctx context.Context, client *gophercloud.ServiceClient, backupID string, opts backups.RestoreOptsBuilder) (*volumes.Volume, error) {
+	backup, err := backups.Get(ctx, client, backupID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	restore, err := backups.RestoreFromBackup(ctx, client, backupID, opts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	var volume *volumes.Volume
+	err = gophercloud.WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		volume, err = volumes.Get(ctx, client, restore.VolumeID).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		switch volume.Status {
+		case "available":
+			return true, nil
+		case "error", "error_restoring":
+			return false, ErrRestoreFailed{BackupID: backupID, VolumeID: volume.ID, Status: volume.Status, Size: volume.Size}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := waitForBackup(ctx, client, backupID); err != nil {
+		return volume, err
+	}
+
+	if volume.Size < backup.Size {
+		return volume, ErrRestoreFailed{BackupID: backupID, VolumeID: volume.ID, Status: volume.Status, Size: volume.Size}
+	}
+	return volume, nil
+}
+
+// waitForBackup waits for a backup to become available.
+func waitForBackup(ctx context.Context, client *gophercloud.ServiceClient, backupID string) error {
+	return gophercloud.WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		backup, err := backups.Get(ctx, client, backupID).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		switch backup.Status {
+		case "available":
+			return true, nil
+		case "error", "error_restoring":
+			return false, ErrBackupFailed{BackupID: backupID, Status: backup.Status, FailReason: backup.FailReason}
+		}
+		return false, nil
+	})
+}
+
+// waitForDeletion waits for a backup to be gone.
+func waitForDeletion(ctx context.Context, client *gophercloud.ServiceClient, backupID string) error {
+	return gophercloud.WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		backup, err := backups.Get(ctx, client, backupID).Extract()
+		if err != nil {
+			if gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				return true, nil
+			}
+			return false, err
+		}
+
+		if backup.Status == "error_deleting" {
+			return false, ErrBackupFailed{BackupID: backupID, Status: backup.Status, FailReason: backup.FailReason}
+		}
+		return false, nil
+	})
+}
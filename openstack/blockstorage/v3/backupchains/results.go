@@ -0,0 +1,143 @@
+package backupchains
+
+import (
+	"slices"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+)
+
+// Node is a backup in a Chain.
+type Node struct {
+	// Backup is the backup itself.
+	Backup backups.Backup
+
+	// Parent is the backup this one is an incremental of. It is nil for the
+	// root of a chain.
+	Parent *Node `json:"-"`
+
+	// Children are the incremental backups of this backup, oldest first.
+	Children []*Node
+}
+
+// Path returns the backups needed to restore the backup of the node: the
+// root of its chain first and the backup itself last.
+func (n *Node) Path() []backups.Backup {
+	var path []backups.Backup
+	for ; n != nil; n = n.Parent {
+		path = append(path, n.Backup)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// Chain is a full backup of a volume together with the incremental backups
+// depending on it, directly or through other incremental backups.
+type Chain struct {
+	// VolumeID is the ID of the backed up volume.
+	VolumeID string
+
+	// Root is the first backup of the chain. It is a full backup unless
+	// the chain is incomplete.
+	Root *Node
+}
+
+// Complete reports whether the chain starts with a full backup. A chain is
+// incomplete when the backup an incremental depends on was not found.
+func (c Chain) Complete() bool {
+	return !c.Root.Backup.IsIncremental
+}
+
+// Nodes returns the nodes of the chain, every node after its parent.
+func (c Chain) Nodes() []*Node {
+	var nodes []*Node
+	var walk func(n *Node)
+	walk = func(n *Node) {
+		nodes = append(nodes, n)
+		for _, child := range n.Children {
+			walk(child)
+		}
+	}
+	walk(c.Root)
+	return nodes
+}
+
+// Backups returns the backups of the chain, every backup after the backup it
+// depends on.
+func (c Chain) Backups() []backups.Backup {
+	nodes := c.Nodes()
+	all := make([]backups.Backup, len(nodes))
+	for i, n := range nodes {
+		all[i] = n.Backup
+	}
+	return all
+}
+
+// Find returns the node of the backup with the given ID, or nil if the
+// backup is not part of the chain.
+func (c Chain) Find(backupID string) *Node {
+	for _, n := range c.Nodes() {
+		if n.Backup.ID == backupID {
+			return n
+		}
+	}
+	return nil
+}
+
+// Latest returns the node holding the most recent data of the chain.
+func (c Chain) Latest() *Node {
+	var latest *Node
+	for _, n := range c.Nodes() {
+		if latest == nil || dataTime(n.Backup).After(dataTime(latest.Backup)) {
+			latest = n
+		}
+	}
+	return latest
+}
+
+// Bundle holds the export records of a chain. It can be marshalled to JSON,
+// stored, and imported into another cloud sharing the backup storage.
+type Bundle struct {
+	// VolumeID is the ID of the backed up volume.
+	VolumeID string `json:"volume_id"`
+
+	// Records are the export records of the backups of the chain, every
+	// backup after the backup it depends on.
+	Records []Record `json:"records"`
+}
+
+// Record is the export record of a backup in a Bundle.
+type Record struct {
+	backups.BackupRecord
+
+	// BackupID is the ID of the backup. Imported backups keep their ID.
+	BackupID string `json:"backup_id"`
+
+	// ParentID is the ID of the backup this one is an incremental of.
+	ParentID string `json:"parent_id,omitempty"`
+
+	// Name is the name of the backup.
+	Name string `json:"name,omitempty"`
+}
+
+// RetentionPolicy describes which backups of a volume to keep.
+type RetentionPolicy struct {
+	// KeepFulls is the number of most recent complete chains to keep. The
+	// older chains are deleted. Must be at least 1.
+	KeepFulls int
+
+	// KeepIncrementals is the number of most recent chains whose
+	// incremental backups are kept. The incremental backups of older kept
+	// chains are deleted, leaving only their full backup. Zero keeps the
+	// incremental backups of all kept chains.
+	KeepIncrementals int
+}
+
+// dataTime returns the point in time of the data held by a backup. It is
+// the creation time of the snapshot for backups of snapshots.
+func dataTime(b backups.Backup) time.Time {
+	if b.DataTimestamp.IsZero() {
+		return b.CreatedAt
+	}
+	return b.DataTimestamp
+}
@@ -0,0 +1,2 @@
+// backupchains unit tests
+package testing
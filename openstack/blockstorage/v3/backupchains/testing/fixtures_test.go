@@ -0,0 +1,181 @@
+package testing
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+const volumeID = "cf9bc6fa-c5bc-41f6-bc4e-6e76c0bea959"
+
+func at(hour int) time.Time {
+	return time.Date(2024, 3, 11, hour, 0, 0, 0, time.UTC)
+}
+
+func backup(id string, hour int, incremental bool) backups.Backup {
+	return backups.Backup{
+		ID:            id,
+		VolumeID:      volumeID,
+		Status:        "available",
+		Size:          1,
+		IsIncremental: incremental,
+		CreatedAt:     at(hour),
+		DataTimestamp: at(hour),
+	}
+}
+
+// ListDetailBody holds two chains of the volume: full-1 with two
+// incrementals, and full-2 with one incremental.
+const ListDetailBody = `
+{
+    "backups": [
+        {"id": "inc-3", "volume_id": "cf9bc6fa-c5bc-41f6-bc4e-6e76c0bea959", "status": "available", "size": 1, "is_incremental": true, "created_at": "2024-03-11T05:00:00.000000", "data_timestamp": "2024-03-11T05:00:00.000000"},
+        {"id": "full-2", "volume_id": "cf9bc6fa-c5bc-41f6-bc4e-6e76c0bea959", "status": "available", "size": 1, "is_incremental": false, "created_at": "2024-03-11T04:00:00.000000", "data_timestamp": "2024-03-11T04:00:00.000000"},
+        {"id": "inc-2", "volume_id": "cf9bc6fa-c5bc-41f6-bc4e-6e76c0bea959", "status": "available", "size": 1, "is_incremental": true, "created_at": "2024-03-11T03:00:00.000000", "data_timestamp": "2024-03-11T03:00:00.000000"},
+        {"id": "inc-1", "volume_id": "cf9bc6fa-c5bc-41f6-bc4e-6e76c0bea959", "status": "available", "size": 1, "is_incremental": true, "created_at": "2024-03-11T02:00:00.000000", "data_timestamp": "2024-03-11T02:00:00.000000"},
+        {"id": "full-1", "volume_id": "cf9bc6fa-c5bc-41f6-bc4e-6e76c0bea959", "status": "available", "size": 1, "is_incremental": false, "created_at": "2024-03-11T01:00:00.000000", "data_timestamp": "2024-03-11T01:00:00.000000"}
+    ]
+}
+`
+
+func HandleListDetailSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/backups/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"volume_id": volumeID})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListDetailBody)
+	})
+}
+
+// HandleDeleteSuccessfully handles the deletion of the given backups. A
+// deleted backup is not found afterwards, and deleting a backup before its
+// dependent backups fails.
+func HandleDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer, dependents map[string]string, deleted *[]string) {
+	gone := make(map[string]bool)
+	for _, id := range []string{"full-1", "inc-1", "inc-2", "full-2", "inc-3"} {
+		fakeServer.Mux.HandleFunc("/backups/"+id, func(w http.ResponseWriter, r *http.Request) {
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			switch r.Method {
+			case "DELETE":
+				if child, ok := dependents[id]; ok && !gone[child] {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				gone[id] = true
+				*deleted = append(*deleted, id)
+				w.WriteHeader(http.StatusAccepted)
+			case "GET":
+				if gone[id] {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Header().Add("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				fmt.Fprintf(w, `{"backup": {"id": "%s", "status": "available"}}`, id)
+			default:
+				t.Fatalf("unexpected method %s", r.Method)
+			}
+		})
+	}
+}
+
+// exportRecord returns an export record of a backup with the given parent,
+// the way the Block Storage service encodes it.
+func exportRecord(t *testing.T, id string, parentID *string) backups.BackupRecord {
+	service := "cinder.backup.drivers.swift.SwiftBackupDriver"
+	b, err := json.Marshal(backups.ImportBackup{
+		ID:       id,
+		VolumeID: volumeID,
+		Service:  &service,
+		ParentID: parentID,
+	})
+	th.AssertNoErr(t, err)
+
+	return backups.BackupRecord{
+		BackupService: service,
+		BackupURL:     b,
+	}
+}
+
+func HandleExportSuccessfully(t *testing.T, fakeServer th.FakeServer, records map[string]backups.BackupRecord) {
+	for id, record := range records {
+		fakeServer.Mux.HandleFunc("/backups/"+id+"/export_record", func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			th.AssertNoErr(t, json.NewEncoder(w).Encode(map[string]any{"backup-record": record}))
+		})
+	}
+}
+
+func HandleImportSuccessfully(t *testing.T, fakeServer th.FakeServer, imported *[]string) {
+	fakeServer.Mux.HandleFunc("/backups/import_record", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		var body struct {
+			Record backups.BackupRecord `json:"backup-record"`
+		}
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+
+		var b backups.ImportBackup
+		th.AssertNoErr(t, json.Unmarshal(body.Record.BackupURL, &b))
+		*imported = append(*imported, b.ID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"backup": {"id": "%s", "name": null}}`, b.ID)
+	})
+
+	for _, id := range []string{"full-1", "inc-1", "inc-2"} {
+		fakeServer.Mux.HandleFunc("/backups/"+id, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, `{"backup": {"id": "%s", "status": "available"}}`, id)
+		})
+	}
+}
+
+func HandleRestoreSuccessfully(t *testing.T, fakeServer th.FakeServer, volumeStatus string, volumeSize int) {
+	fakeServer.Mux.HandleFunc("/backups/inc-2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"backup": {"id": "inc-2", "status": "available", "size": 2}}`)
+	})
+
+	fakeServer.Mux.HandleFunc("/backups/inc-2/restore", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"restore": {"name": "restored"}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"restore": {"backup_id": "inc-2", "volume_id": "4b0a6d8e-6f8c-4d38-a2b6-4a5bd1e0e0c7", "volume_name": "restored"}}`)
+	})
+
+	fakeServer.Mux.HandleFunc("/volumes/4b0a6d8e-6f8c-4d38-a2b6-4a5bd1e0e0c7", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"volume": {"id": "4b0a6d8e-6f8c-4d38-a2b6-4a5bd1e0e0c7", "status": "%s", "size": %d}}`, volumeStatus, volumeSize)
+	})
+}
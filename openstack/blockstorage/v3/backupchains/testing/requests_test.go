@@ -0,0 +1,228 @@
+package testing
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backupchains"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func ids(all []backups.Backup) []string {
+	var s []string
+	for _, b := range all {
+		s = append(s, b.ID)
+	}
+	return s
+}
+
+func TestBuild(t *testing.T) {
+	snapshotBackup := backup("inc-snap", 6, true)
+	snapshotBackup.DataTimestamp = at(3).Add(30 * time.Minute)
+
+	other := backup("orphan", 2, true)
+	other.VolumeID = "0b2c8a3e-1f7d-4e4b-9a55-3d2f1c7e6b90"
+
+	chains := backupchains.Build([]backups.Backup{
+		backup("inc-3", 5, true),
+		backup("full-2", 4, false),
+		snapshotBackup,
+		backup("inc-2", 3, true),
+		backup("inc-1", 2, true),
+		backup("full-1", 1, false),
+		other,
+	})
+
+	th.AssertEquals(t, 3, len(chains))
+
+	th.AssertEquals(t, "0b2c8a3e-1f7d-4e4b-9a55-3d2f1c7e6b90", chains[0].VolumeID)
+	th.AssertEquals(t, false, chains[0].Complete())
+	th.AssertDeepEquals(t, []string{"orphan"}, ids(chains[0].Backups()))
+
+	// The backup of a snapshot taken between inc-2 and full-2 depends on
+	// inc-2, even though it was created after full-2.
+	th.AssertEquals(t, true, chains[1].Complete())
+	th.AssertDeepEquals(t, []string{"full-1", "inc-1", "inc-2", "inc-snap"}, ids(chains[1].Backups()))
+	th.AssertEquals(t, "inc-snap", chains[1].Latest().Backup.ID)
+	th.AssertDeepEquals(t, []string{"full-1", "inc-1", "inc-2", "inc-snap"}, ids(chains[1].Find("inc-snap").Path()))
+
+	th.AssertDeepEquals(t, []string{"full-2", "inc-3"}, ids(chains[2].Backups()))
+	th.AssertEquals(t, "full-2", chains[2].Find("inc-3").Parent.Backup.ID)
+	th.AssertEquals(t, (*backupchains.Node)(nil), chains[2].Find("inc-1"))
+}
+
+func TestNextIsIncremental(t *testing.T) {
+	th.AssertEquals(t, false, backupchains.NextIsIncremental(nil, 0))
+
+	chains := backupchains.Build([]backups.Backup{
+		backup("full-1", 1, false),
+		backup("inc-1", 2, true),
+		backup("inc-2", 3, true),
+	})
+	th.AssertEquals(t, true, backupchains.NextIsIncremental(chains, 0))
+	th.AssertEquals(t, true, backupchains.NextIsIncremental(chains, 3))
+	th.AssertEquals(t, false, backupchains.NextIsIncremental(chains, 2))
+
+	failed := backup("inc-3", 4, true)
+	failed.Status = "error"
+	chains = backupchains.Build([]backups.Backup{
+		backup("full-1", 1, false),
+		failed,
+	})
+	th.AssertEquals(t, false, backupchains.NextIsIncremental(chains, 0))
+
+	chains = backupchains.Build([]backups.Backup{
+		backup("orphan", 1, true),
+	})
+	th.AssertEquals(t, false, backupchains.NextIsIncremental(chains, 0))
+}
+
+func TestPlanRetention(t *testing.T) {
+	all := []backups.Backup{
+		backup("full-1", 1, false),
+		backup("inc-1", 2, true),
+		backup("inc-2", 3, true),
+		backup("full-2", 4, false),
+		backup("inc-3", 5, true),
+		backup("full-3", 6, false),
+		backup("inc-4", 7, true),
+	}
+	chains := backupchains.Build(all)
+
+	plan, err := backupchains.PlanRetention(chains, backupchains.RetentionPolicy{KeepFulls: 2})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"inc-2", "inc-1", "full-1"}, ids(plan))
+
+	plan, err = backupchains.PlanRetention(chains, backupchains.RetentionPolicy{KeepFulls: 2, KeepIncrementals: 1})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"inc-2", "inc-1", "full-1", "inc-3"}, ids(plan))
+
+	plan, err = backupchains.PlanRetention(chains, backupchains.RetentionPolicy{KeepFulls: 3})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(plan))
+
+	// A busy incremental protects the backups it depends on.
+	all[2].Status = "restoring"
+	plan, err = backupchains.PlanRetention(backupchains.Build(all), backupchains.RetentionPolicy{KeepFulls: 1})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"inc-3", "full-2"}, ids(plan))
+}
+
+func TestPlanRetentionInvalidPolicy(t *testing.T) {
+	_, err := backupchains.PlanRetention(nil, backupchains.RetentionPolicy{})
+	th.CheckErr(t, err, &gophercloud.ErrInvalidInput{})
+}
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListDetailSuccessfully(t, fakeServer)
+
+	chains, err := backupchains.List(context.TODO(), client.ServiceClient(fakeServer), volumeID)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(chains))
+	th.AssertDeepEquals(t, []string{"full-1", "inc-1", "inc-2"}, ids(chains[0].Backups()))
+	th.AssertDeepEquals(t, []string{"full-2", "inc-3"}, ids(chains[1].Backups()))
+}
+
+func TestEnforceRetention(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListDetailSuccessfully(t, fakeServer)
+
+	var deletedIDs []string
+	dependents := map[string]string{"full-1": "inc-1", "inc-1": "inc-2"}
+	HandleDeleteSuccessfully(t, fakeServer, dependents, &deletedIDs)
+
+	deleted, err := backupchains.EnforceRetention(context.TODO(), client.ServiceClient(fakeServer), volumeID, backupchains.RetentionPolicy{KeepFulls: 1})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"inc-2", "inc-1", "full-1"}, ids(deleted))
+	th.AssertDeepEquals(t, []string{"inc-2", "inc-1", "full-1"}, deletedIDs)
+}
+
+func TestExportImport(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	full1, inc1 := "full-1", "inc-1"
+	HandleExportSuccessfully(t, fakeServer, map[string]backups.BackupRecord{
+		"full-1": exportRecord(t, "full-1", nil),
+		"inc-1":  exportRecord(t, "inc-1", &full1),
+		"inc-2":  exportRecord(t, "inc-2", &inc1),
+	})
+
+	chains := backupchains.Build([]backups.Backup{
+		backup("full-1", 1, false),
+		backup("inc-1", 2, true),
+		backup("inc-2", 3, true),
+	})
+
+	bundle, err := backupchains.Export(context.TODO(), client.ServiceClient(fakeServer), chains[0])
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, volumeID, bundle.VolumeID)
+	th.AssertEquals(t, 3, len(bundle.Records))
+	th.AssertEquals(t, "", bundle.Records[0].ParentID)
+	th.AssertEquals(t, "full-1", bundle.Records[1].ParentID)
+	th.AssertEquals(t, "inc-1", bundle.Records[2].ParentID)
+
+	// The bundle survives a round trip through JSON, in any order.
+	b, err := json.Marshal(bundle)
+	th.AssertNoErr(t, err)
+	var portable backupchains.Bundle
+	th.AssertNoErr(t, json.Unmarshal(b, &portable))
+	portable.Records[0], portable.Records[2] = portable.Records[2], portable.Records[0]
+
+	var importedIDs []string
+	HandleImportSuccessfully(t, fakeServer, &importedIDs)
+
+	imported, err := backupchains.Import(context.TODO(), client.ServiceClient(fakeServer), &portable)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"full-1", "inc-1", "inc-2"}, imported)
+	th.AssertDeepEquals(t, []string{"full-1", "inc-1", "inc-2"}, importedIDs)
+}
+
+func TestImportMissingParent(t *testing.T) {
+	bundle := &backupchains.Bundle{
+		VolumeID: volumeID,
+		Records: []backupchains.Record{
+			{BackupID: "inc-2", ParentID: "inc-1"},
+		},
+	}
+
+	_, err := backupchains.Import(context.TODO(), nil, bundle)
+	th.CheckErr(t, err, &backupchains.ErrMissingParent{})
+}
+
+func TestRestore(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleRestoreSuccessfully(t, fakeServer, "available", 2)
+
+	volume, err := backupchains.Restore(context.TODO(), client.ServiceClient(fakeServer), "inc-2", backups.RestoreOpts{Name: "restored"})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "4b0a6d8e-6f8c-4d38-a2b6-4a5bd1e0e0c7", volume.ID)
+}
+
+func TestRestoreFailed(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleRestoreSuccessfully(t, fakeServer, "error_restoring", 2)
+
+	_, err := backupchains.Restore(context.TODO(), client.ServiceClient(fakeServer), "inc-2", backups.RestoreOpts{Name: "restored"})
+	th.CheckErr(t, err, &backupchains.ErrRestoreFailed{})
+}
+
+func TestRestoreTooSmall(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleRestoreSuccessfully(t, fakeServer, "available", 1)
+
+	_, err := backupchains.Restore(context.TODO(), client.ServiceClient(fakeServer), "inc-2", backups.RestoreOpts{Name: "restored"})
+	th.CheckErr(t, err, &backupchains.ErrRestoreFailed{})
+}
@@ -0,0 +1,41 @@
+/*
+Package clusters provides information and interaction with the clusters of
+the OpenStack Block Storage service. A cluster groups the cinder-volume
+services which manage the same storage backend in an active-active
+deployment.
+
+NOTE: Requires at least microversion 3.7.
+
+Example to list Clusters
+
+	client.Microversion = "3.7"
+
+	allPages, err := clusters.ListDetail(client, clusters.ListOpts{}).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allClusters, err := clusters.ExtractClusters(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, cluster := range allClusters {
+		fmt.Printf("%+v\n", cluster)
+	}
+
+Example to disable a Cluster
+
+	client.Microversion = "3.7"
+
+	disableOpts := clusters.DisableOpts{
+		Name:           "cluster1@lvmdriver-1",
+		DisabledReason: "maintenance",
+	}
+
+	cluster, err := clusters.Disable(context.TODO(), client, disableOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package clusters
@@ -0,0 +1,192 @@
+package clusters
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToClusterListQuery() (string, error)
+}
+
+// ListOpts holds options for listing Clusters. It is passed to the
+// clusters.List and clusters.ListDetail functions.
+type ListOpts struct {
+	// Filter the cluster list by name.
+	Name string `q:"name"`
+
+	// Filter the cluster list by binary name of the service.
+	Binary string `q:"binary"`
+
+	// Filter the cluster list by whether the cluster is up.
+	IsUp *bool `q:"is_up"`
+
+	// Filter the cluster list by whether the cluster is disabled.
+	Disabled *bool `q:"disabled"`
+
+	// Filter the cluster list by the number of hosts.
+	NumHosts *int `q:"num_hosts"`
+
+	// Filter the cluster list by the number of hosts which are down.
+	NumDownHosts *int `q:"num_down_hosts"`
+
+	// Filter the cluster list by replication status. Requires microversion
+	// 3.26 or later.
+	ReplicationStatus string `q:"replication_status"`
+
+	// Filter the cluster list by whether the cluster is frozen. Requires
+	// microversion 3.26 or later.
+	Frozen *bool `q:"frozen"`
+
+	// Filter the cluster list by active backend ID. Requires microversion
+	// 3.26 or later.
+	ActiveBackendID string `q:"active_backend_id"`
+}
+
+// ToClusterListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToClusterListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns Clusters optionally limited by the conditions provided in
+// ListOpts. Only the summary fields of each cluster are returned; use
+// ListDetail for the full objects.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listURL(client), opts)
+}
+
+// ListDetail returns detailed Clusters optionally limited by the conditions
+// provided in ListOpts.
+func ListDetail(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listDetailURL(client), opts)
+}
+
+func list(client *gophercloud.ServiceClient, url string, opts ListOptsBuilder) pagination.Pager {
+	if opts != nil {
+		query, err := opts.ToClusterListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ClusterPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// GetOptsBuilder allows extensions to add additional parameters to the Get
+// request.
+type GetOptsBuilder interface {
+	ToClusterGetQuery() (string, error)
+}
+
+// GetOpts holds options for retrieving a Cluster.
+type GetOpts struct {
+	// The binary name of the service of the cluster. The Block Storage
+	// service defaults to cinder-volume.
+	Binary string `q:"binary"`
+}
+
+// ToClusterGetQuery formats a GetOpts into a query string.
+func (opts GetOpts) ToClusterGetQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// Get retrieves the Cluster with the provided name. To extract the Cluster
+// object from the response, call the Extract method on the GetResult.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, name string, opts GetOptsBuilder) (r GetResult) {
+	url := getURL(client, name)
+	if opts != nil {
+		query, err := opts.ToClusterGetQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	resp, err := client.Get(ctx, url, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// EnableOptsBuilder allows extensions to add additional parameters to the
+// Enable request.
+type EnableOptsBuilder interface {
+	ToClusterEnableMap() (map[string]any, error)
+}
+
+// EnableOpts contains options for enabling a Cluster.
+type EnableOpts struct {
+	// The name of the cluster.
+	Name string `json:"name" required:"true"`
+	// The binary name of the service of the cluster. The Block Storage
+	// service defaults to cinder-volume.
+	Binary string `json:"binary,omitempty"`
+}
+
+// ToClusterEnableMap assembles a request body based on the contents of an
+// EnableOpts.
+func (opts EnableOpts) ToClusterEnableMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Enable enables the scheduling of new resources on a Cluster. To extract the
+// updated Cluster from the response, call the Extract method on the
+// EnableResult.
+func Enable(ctx context.Context, client *gophercloud.ServiceClient, opts EnableOptsBuilder) (r EnableResult) {
+	b, err := opts.ToClusterEnableMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, enableURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DisableOptsBuilder allows extensions to add additional parameters to the
+// Disable request.
+type DisableOptsBuilder interface {
+	ToClusterDisableMap() (map[string]any, error)
+}
+
+// DisableOpts contains options for disabling a Cluster.
+type DisableOpts struct {
+	// The name of the cluster.
+	Name string `json:"name" required:"true"`
+	// The binary name of the service of the cluster. The Block Storage
+	// service defaults to cinder-volume.
+	Binary string `json:"binary,omitempty"`
+	// The reason for disabling the cluster.
+	DisabledReason string `json:"disabled_reason,omitempty"`
+}
+
+// ToClusterDisableMap assembles a request body based on the contents of a
+// DisableOpts.
+func (opts DisableOpts) ToClusterDisableMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Disable stops the scheduling of new resources on a Cluster. Existing
+// resources are not affected. To extract the updated Cluster from the
+// response, call the Extract method on the DisableResult.
+func Disable(ctx context.Context, client *gophercloud.ServiceClient, opts DisableOptsBuilder) (r DisableResult) {
+	b, err := opts.ToClusterDisableMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, disableURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
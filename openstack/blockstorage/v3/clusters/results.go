@@ -0,0 +1,131 @@
+package clusters
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Cluster represents a cluster of Block Storage services sharing the same
+// backend.
+type Cluster struct {
+	// The name of the cluster.
+	Name string `json:"name"`
+
+	// The binary name of the services in the cluster.
+	Binary string `json:"binary"`
+
+	// The state of the cluster. One of up or down.
+	State string `json:"state"`
+
+	// The status of the cluster. One of enabled or disabled.
+	Status string `json:"status"`
+
+	// The replication status of the cluster. Requires microversion 3.26 or
+	// later.
+	ReplicationStatus string `json:"replication_status"`
+
+	// The following fields are only returned by ListDetail and Get.
+
+	// The number of hosts in the cluster.
+	NumHosts int `json:"num_hosts"`
+
+	// The number of hosts in the cluster which are down.
+	NumDownHosts int `json:"num_down_hosts"`
+
+	// The reason for disabling the cluster.
+	DisabledReason string `json:"disabled_reason"`
+
+	// Whether the cluster is frozen. Requires microversion 3.26 or later.
+	Frozen bool `json:"frozen"`
+
+	// The ID of the active storage backend. Requires microversion 3.26 or
+	// later.
+	ActiveBackendID string `json:"active_backend_id"`
+
+	// The last heartbeat received from any service of the cluster.
+	LastHeartbeat time.Time `json:"-"`
+
+	// The date when the cluster was created.
+	CreatedAt time.Time `json:"-"`
+
+	// The date when the cluster was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// UnmarshalJSON converts our JSON API response into our cluster struct
+func (r *Cluster) UnmarshalJSON(b []byte) error {
+	type tmp Cluster
+	var s struct {
+		tmp
+		LastHeartbeat gophercloud.JSONRFC3339MilliNoZ `json:"last_heartbeat"`
+		CreatedAt     gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt     gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Cluster(s.tmp)
+
+	r.LastHeartbeat = time.Time(s.LastHeartbeat)
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+// ClusterPage represents a single page of all Clusters from a List request.
+type ClusterPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a page of Clusters contains any results.
+func (page ClusterPage) IsEmpty() (bool, error) {
+	if page.StatusCode == 204 {
+		return true, nil
+	}
+
+	clusters, err := ExtractClusters(page)
+	return len(clusters) == 0, err
+}
+
+// ExtractClusters extracts and returns Clusters. It is used while iterating
+// over a clusters.List or clusters.ListDetail call.
+func ExtractClusters(r pagination.Page) ([]Cluster, error) {
+	var s struct {
+		Clusters []Cluster `json:"clusters"`
+	}
+	err := (r.(ClusterPage)).ExtractInto(&s)
+	return s.Clusters, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the Cluster object out of the commonResult object.
+func (r commonResult) Extract() (*Cluster, error) {
+	var s struct {
+		Cluster *Cluster `json:"cluster"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Cluster, err
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// EnableResult contains the response body and error from an Enable request.
+type EnableResult struct {
+	commonResult
+}
+
+// DisableResult contains the response body and error from a Disable request.
+type DisableResult struct {
+	commonResult
+}
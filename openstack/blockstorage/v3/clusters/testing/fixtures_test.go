@@ -0,0 +1,157 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+const clusterName = "cluster1@lvmdriver-1"
+
+// ClusterListDetailBody is a sample response to a ListDetail call.
+const ClusterListDetailBody = `
+{
+    "clusters": [
+        {
+            "name": "cluster1@lvmdriver-1",
+            "binary": "cinder-volume",
+            "state": "up",
+            "status": "enabled",
+            "replication_status": "error",
+            "num_hosts": 3,
+            "num_down_hosts": 0,
+            "disabled_reason": null,
+            "frozen": false,
+            "active_backend_id": null,
+            "last_heartbeat": "2016-06-01T02:46:28.000000",
+            "created_at": "2016-06-01T02:46:28.000000",
+            "updated_at": null
+        },
+        {
+            "name": "cluster2@lvmdriver-2",
+            "binary": "cinder-volume",
+            "state": "down",
+            "status": "disabled",
+            "replication_status": "error",
+            "num_hosts": 2,
+            "num_down_hosts": 2,
+            "disabled_reason": "for testing",
+            "frozen": true,
+            "active_backend_id": "replication1",
+            "last_heartbeat": "2016-06-01T01:46:28.000000",
+            "created_at": "2016-06-01T01:46:28.000000",
+            "updated_at": "2016-06-01T02:46:28.000000"
+        }
+    ]
+}
+`
+
+// ClusterGetBody is a sample response to a Get call.
+const ClusterGetBody = `
+{
+    "cluster": {
+        "name": "cluster1@lvmdriver-1",
+        "binary": "cinder-volume",
+        "state": "up",
+        "status": "enabled",
+        "replication_status": "error",
+        "num_hosts": 3,
+        "num_down_hosts": 0,
+        "disabled_reason": null,
+        "frozen": false,
+        "active_backend_id": null,
+        "last_heartbeat": "2016-06-01T02:46:28.000000",
+        "created_at": "2016-06-01T02:46:28.000000",
+        "updated_at": null
+    }
+}
+`
+
+// ClusterDisableBody is a sample response to a Disable call.
+const ClusterDisableBody = `
+{
+    "cluster": {
+        "name": "cluster1@lvmdriver-1",
+        "binary": "cinder-volume",
+        "state": "up",
+        "status": "disabled",
+        "replication_status": "error",
+        "disabled_reason": "maintenance"
+    }
+}
+`
+
+// ClusterEnableBody is a sample response to an Enable call.
+const ClusterEnableBody = `
+{
+    "cluster": {
+        "name": "cluster1@lvmdriver-1",
+        "binary": "cinder-volume",
+        "state": "up",
+        "status": "enabled",
+        "replication_status": "error"
+    }
+}
+`
+
+func HandleListDetailSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/clusters/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"binary": "cinder-volume",
+			"is_up":  "true",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ClusterListDetailBody)
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/clusters/"+clusterName, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"binary": "cinder-volume",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ClusterGetBody)
+	})
+}
+
+func HandleDisableSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/clusters/disable", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "name": "cluster1@lvmdriver-1",
+    "binary": "cinder-volume",
+    "disabled_reason": "maintenance"
+}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ClusterDisableBody)
+	})
+}
+
+func HandleEnableSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/clusters/enable", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"name": "cluster1@lvmdriver-1"}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ClusterEnableBody)
+	})
+}
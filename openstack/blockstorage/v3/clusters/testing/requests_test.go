@@ -0,0 +1,108 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/clusters"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+var firstCluster = clusters.Cluster{
+	Name:              clusterName,
+	Binary:            "cinder-volume",
+	State:             "up",
+	Status:            "enabled",
+	ReplicationStatus: "error",
+	NumHosts:          3,
+	LastHeartbeat:     time.Date(2016, 6, 1, 2, 46, 28, 0, time.UTC),
+	CreatedAt:         time.Date(2016, 6, 1, 2, 46, 28, 0, time.UTC),
+}
+
+var secondCluster = clusters.Cluster{
+	Name:              "cluster2@lvmdriver-2",
+	Binary:            "cinder-volume",
+	State:             "down",
+	Status:            "disabled",
+	ReplicationStatus: "error",
+	NumHosts:          2,
+	NumDownHosts:      2,
+	DisabledReason:    "for testing",
+	Frozen:            true,
+	ActiveBackendID:   "replication1",
+	LastHeartbeat:     time.Date(2016, 6, 1, 1, 46, 28, 0, time.UTC),
+	CreatedAt:         time.Date(2016, 6, 1, 1, 46, 28, 0, time.UTC),
+	UpdatedAt:         time.Date(2016, 6, 1, 2, 46, 28, 0, time.UTC),
+}
+
+func TestListDetail(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListDetailSuccessfully(t, fakeServer)
+
+	isUp := true
+	listOpts := clusters.ListOpts{
+		Binary: "cinder-volume",
+		IsUp:   &isUp,
+	}
+
+	pages := 0
+	err := clusters.ListDetail(client.ServiceClient(fakeServer), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := clusters.ExtractClusters(page)
+		if err != nil {
+			return false, err
+		}
+
+		th.CheckDeepEquals(t, []clusters.Cluster{firstCluster, secondCluster}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, pages)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := clusters.Get(context.TODO(), client.ServiceClient(fakeServer), clusterName, clusters.GetOpts{Binary: "cinder-volume"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, firstCluster, *actual)
+}
+
+func TestDisable(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleDisableSuccessfully(t, fakeServer)
+
+	disableOpts := clusters.DisableOpts{
+		Name:           clusterName,
+		Binary:         "cinder-volume",
+		DisabledReason: "maintenance",
+	}
+	actual, err := clusters.Disable(context.TODO(), client.ServiceClient(fakeServer), disableOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "disabled", actual.Status)
+	th.AssertEquals(t, "maintenance", actual.DisabledReason)
+}
+
+func TestEnable(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleEnableSuccessfully(t, fakeServer)
+
+	actual, err := clusters.Enable(context.TODO(), client.ServiceClient(fakeServer), clusters.EnableOpts{Name: clusterName}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "enabled", actual.Status)
+}
+
+func TestEnableMissingName(t *testing.T) {
+	_, err := clusters.EnableOpts{}.ToClusterEnableMap()
+	th.AssertErr(t, err)
+}
@@ -0,0 +1,51 @@
+/*
+Package defaulttypes manages the per-project default volume types of the
+OpenStack Block Storage service. The default volume type of a project is used
+for new volumes of the project which do not request a volume type, and takes
+precedence over the default volume type of the deployment.
+
+NOTE: Requires at least microversion 3.62.
+
+Example to set the default volume type of a Project
+
+	client.Microversion = "3.62"
+
+	setOpts := defaulttypes.SetOpts{
+		VolumeType: "ssd",
+	}
+
+	projectID := "ac5c5b3b1b334d7d9ab06e8e5ab7e9a0"
+	defaultType, err := defaulttypes.Set(context.TODO(), client, projectID, setOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to list the default volume types of all Projects
+
+	client.Microversion = "3.62"
+
+	allPages, err := defaulttypes.List(client).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allDefaultTypes, err := defaulttypes.ExtractDefaultTypes(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, defaultType := range allDefaultTypes {
+		fmt.Printf("%+v\n", defaultType)
+	}
+
+Example to unset the default volume type of a Project
+
+	client.Microversion = "3.62"
+
+	projectID := "ac5c5b3b1b334d7d9ab06e8e5ab7e9a0"
+	err := defaulttypes.Unset(context.TODO(), client, projectID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package defaulttypes
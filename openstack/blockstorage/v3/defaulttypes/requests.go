@@ -0,0 +1,67 @@
+package defaulttypes
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// SetOptsBuilder allows extensions to add additional parameters to the Set
+// request.
+type SetOptsBuilder interface {
+	ToDefaultTypeSetMap() (map[string]any, error)
+}
+
+// SetOpts contains options for setting the default volume type of a project.
+type SetOpts struct {
+	// The name or ID of the volume type.
+	VolumeType string `json:"volume_type" required:"true"`
+}
+
+// ToDefaultTypeSetMap assembles a request body based on the contents of a
+// SetOpts.
+func (opts SetOpts) ToDefaultTypeSetMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "default_type")
+}
+
+// Set will set the default volume type of the given project, replacing an
+// existing one. To extract the DefaultType object from the response, call the
+// Extract method on the SetResult.
+func Set(ctx context.Context, client *gophercloud.ServiceClient, projectID string, opts SetOptsBuilder) (r SetResult) {
+	b, err := opts.ToDefaultTypeSetMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, resourceURL(client, projectID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves the default volume type of the given project. To extract the
+// DefaultType object from the response, call the Extract method on the
+// GetResult.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, projectID string) (r GetResult) {
+	resp, err := client.Get(ctx, resourceURL(client, projectID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// List returns the default volume types of all projects which have one set.
+func List(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, listURL(client), func(r pagination.PageResult) pagination.Page {
+		return DefaultTypePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Unset will remove the default volume type of the given project. Volumes of
+// the project are then created with the default volume type of the
+// deployment.
+func Unset(ctx context.Context, client *gophercloud.ServiceClient, projectID string) (r UnsetResult) {
+	resp, err := client.Delete(ctx, resourceURL(client, projectID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,71 @@
+package defaulttypes
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// DefaultType is the default volume type of a project.
+type DefaultType struct {
+	// The ID of the project.
+	ProjectID string `json:"project_id"`
+
+	// The ID of the volume type used for new volumes of the project when no
+	// volume type is requested.
+	VolumeTypeID string `json:"volume_type_id"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the DefaultType object out of the commonResult object.
+func (r commonResult) Extract() (*DefaultType, error) {
+	var s struct {
+		DefaultType *DefaultType `json:"default_type"`
+	}
+	err := r.ExtractInto(&s)
+	return s.DefaultType, err
+}
+
+// SetResult contains the response body and error from a Set request.
+type SetResult struct {
+	commonResult
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// UnsetResult contains the response error from an Unset request.
+type UnsetResult struct {
+	gophercloud.ErrResult
+}
+
+// DefaultTypePage represents a single page of all DefaultTypes from a List
+// request.
+type DefaultTypePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a page of DefaultTypes contains any
+// results.
+func (page DefaultTypePage) IsEmpty() (bool, error) {
+	if page.StatusCode == 204 {
+		return true, nil
+	}
+
+	defaultTypes, err := ExtractDefaultTypes(page)
+	return len(defaultTypes) == 0, err
+}
+
+// ExtractDefaultTypes extracts and returns DefaultTypes. It is used while
+// iterating over a defaulttypes.List call.
+func ExtractDefaultTypes(r pagination.Page) ([]DefaultType, error) {
+	var s struct {
+		DefaultTypes []DefaultType `json:"default_types"`
+	}
+	err := (r.(DefaultTypePage)).ExtractInto(&s)
+	return s.DefaultTypes, err
+}
@@ -0,0 +1,2 @@
+// defaulttypes unit tests
+package testing
@@ -0,0 +1,81 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+const projectID = "ac5c5b3b1b334d7d9ab06e8e5ab7e9a0"
+
+// DefaultTypeBody is a sample response to a Set or Get call.
+const DefaultTypeBody = `
+{
+    "default_type": {
+        "project_id": "ac5c5b3b1b334d7d9ab06e8e5ab7e9a0",
+        "volume_type_id": "7be7b6a9-1fb2-4e4f-b7e0-52a4e0a6f5f3"
+    }
+}
+`
+
+// DefaultTypeListBody is a sample response to a List call.
+const DefaultTypeListBody = `
+{
+    "default_types": [
+        {
+            "project_id": "ac5c5b3b1b334d7d9ab06e8e5ab7e9a0",
+            "volume_type_id": "7be7b6a9-1fb2-4e4f-b7e0-52a4e0a6f5f3"
+        },
+        {
+            "project_id": "3d4e4f09c2e14e6d8dbc0c4a7bfc7d6a",
+            "volume_type_id": "2c8b1bd6-1a5e-4e43-9ab1-3c3a2ab2fd41"
+        }
+    ]
+}
+`
+
+func HandleSetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/default-types/"+projectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"default_type": {"volume_type": "ssd"}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, DefaultTypeBody)
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/default-types/"+projectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, DefaultTypeBody)
+	})
+}
+
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/default-types", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, DefaultTypeListBody)
+	})
+}
+
+func HandleUnsetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/default-types/"+projectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,79 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/defaulttypes"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+var expectedDefaultType = defaulttypes.DefaultType{
+	ProjectID:    projectID,
+	VolumeTypeID: "7be7b6a9-1fb2-4e4f-b7e0-52a4e0a6f5f3",
+}
+
+func TestSet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleSetSuccessfully(t, fakeServer)
+
+	actual, err := defaulttypes.Set(context.TODO(), client.ServiceClient(fakeServer), projectID, defaulttypes.SetOpts{VolumeType: "ssd"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expectedDefaultType, *actual)
+}
+
+func TestSetMissingVolumeType(t *testing.T) {
+	_, err := defaulttypes.SetOpts{}.ToDefaultTypeSetMap()
+	th.AssertErr(t, err)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGetSuccessfully(t, fakeServer)
+
+	actual, err := defaulttypes.Get(context.TODO(), client.ServiceClient(fakeServer), projectID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expectedDefaultType, *actual)
+}
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleListSuccessfully(t, fakeServer)
+
+	pages := 0
+	err := defaulttypes.List(client.ServiceClient(fakeServer)).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := defaulttypes.ExtractDefaultTypes(page)
+		if err != nil {
+			return false, err
+		}
+
+		expected := []defaulttypes.DefaultType{
+			expectedDefaultType,
+			{
+				ProjectID:    "3d4e4f09c2e14e6d8dbc0c4a7bfc7d6a",
+				VolumeTypeID: "2c8b1bd6-1a5e-4e43-9ab1-3c3a2ab2fd41",
+			},
+		}
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, pages)
+}
+
+func TestUnset(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleUnsetSuccessfully(t, fakeServer)
+
+	err := defaulttypes.Unset(context.TODO(), client.ServiceClient(fakeServer), projectID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,11 @@
+package defaulttypes
+
+import "github.com/gophercloud/gophercloud/v2"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("default-types")
+}
+
+func resourceURL(c *gophercloud.ServiceClient, projectID string) string {
+	return c.ServiceURL("default-types", projectID)
+}
@@ -0,0 +1,109 @@
+/*
+Package groups provides information and interaction with generic volume
+groups in the OpenStack Block Storage service. Volumes in a group can be
+snapshotted together with the groupsnapshots package, which gives
+crash-consistent snapshots across several volumes.
+
+NOTE: Requires at least microversion 3.13. Creating a group from a source
+requires 3.14, ResetStatus requires 3.20 and the replication actions require
+3.38.
+
+Example to create a Group
+
+	client.Microversion = "3.13"
+
+	createOpts := groups.CreateOpts{
+		Name:        "database",
+		GroupType:   "29514915-5208-46ab-9ece-1cc4688ad0c1",
+		VolumeTypes: []string{"4e9e6d23-eed0-426d-b90a-28f87a94b6fe"},
+	}
+
+	group, err := groups.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to add volumes to a Group
+
+	client.Microversion = "3.13"
+
+	updateOpts := groups.UpdateOpts{
+		AddVolumes: []string{
+			"6a5a2be4-c2a0-4d89-8ddd-5d08bf5b1a53",
+			"d8e8f9c5-9cd6-4e1c-a4a3-7c6e4f7d3a8e",
+		},
+	}
+
+	err := groups.Update(context.TODO(), client, groupID, updateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to create a Group from a group snapshot
+
+	client.Microversion = "3.14"
+
+	createOpts := groups.CreateFromSrcOpts{
+		Name:            "database-restore",
+		GroupSnapshotID: "6f519a48-3183-46cf-a32f-41815f816666",
+	}
+
+	group, err := groups.CreateFromSrc(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to list Groups with their volumes
+
+	client.Microversion = "3.25"
+
+	listOpts := groups.ListOpts{
+		ListVolume: true,
+	}
+
+	allPages, err := groups.ListDetail(client, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allGroups, err := groups.ExtractGroups(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, group := range allGroups {
+		fmt.Println(group.Name, group.Volumes)
+	}
+
+Example to fail over a replicated Group
+
+	client.Microversion = "3.38"
+
+	targets, err := groups.ListReplicationTargets(context.TODO(), client, groupID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	failoverOpts := groups.FailoverReplicationOpts{
+		SecondaryBackendID: targets[0].BackendID(),
+	}
+
+	err = groups.FailoverReplication(context.TODO(), client, groupID, failoverOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to delete a Group and its volumes
+
+	client.Microversion = "3.13"
+
+	deleteOpts := groups.DeleteOpts{
+		DeleteVolumes: true,
+	}
+
+	err := groups.Delete(context.TODO(), client, groupID, deleteOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package groups
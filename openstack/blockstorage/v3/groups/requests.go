@@ -0,0 +1,374 @@
+package groups
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToGroupCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains options for creating a Group. This object is passed to
+// the groups.Create function. For more information about these parameters,
+// see the Group object.
+type CreateOpts struct {
+	// The group type ID or name.
+	GroupType string `json:"group_type" required:"true"`
+	// The list of volume type IDs or names. A group may contain volumes of
+	// several volume types.
+	VolumeTypes []string `json:"volume_types" required:"true"`
+	// The name of the group.
+	Name string `json:"name,omitempty"`
+	// The description of the group.
+	Description string `json:"description,omitempty"`
+	// The availability zone of the group.
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+}
+
+// ToGroupCreateMap assembles a request body based on the contents of a
+// CreateOpts.
+func (opts CreateOpts) ToGroupCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "group")
+}
+
+// Create will create a new Group based on the values in CreateOpts. To extract
+// the Group object from the response, call the Extract method on the
+// CreateResult. The response only contains the ID and the name of the group;
+// call Get to retrieve the full Group.
+func Create(ctx context.Context, client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateFromSrcOptsBuilder allows extensions to add additional parameters to
+// the CreateFromSrc request.
+type CreateFromSrcOptsBuilder interface {
+	ToGroupCreateFromSrcMap() (map[string]any, error)
+}
+
+// CreateFromSrcOpts contains options for creating a Group from a group
+// snapshot or from another group. Exactly one of GroupSnapshotID and
+// SourceGroupID must be set.
+type CreateFromSrcOpts struct {
+	// The ID of the group snapshot to create the group from.
+	GroupSnapshotID string `json:"group_snapshot_id,omitempty" xor:"SourceGroupID"`
+	// The ID of the group to clone.
+	SourceGroupID string `json:"source_group_id,omitempty" xor:"GroupSnapshotID"`
+	// The name of the group.
+	Name string `json:"name,omitempty"`
+	// The description of the group.
+	Description string `json:"description,omitempty"`
+}
+
+// ToGroupCreateFromSrcMap assembles a request body based on the contents of a
+// CreateFromSrcOpts.
+func (opts CreateFromSrcOpts) ToGroupCreateFromSrcMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "create-from-src")
+}
+
+// CreateFromSrc will create a new Group from an existing group snapshot or
+// group. The volumes of the source are copied into the new group.
+//
+// Requires microversion 3.14 or later.
+func CreateFromSrc(ctx context.Context, client *gophercloud.ServiceClient, opts CreateFromSrcOptsBuilder) (r CreateResult) {
+	b, err := opts.ToGroupCreateFromSrcMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, createFromSrcURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToGroupListQuery() (string, error)
+}
+
+// ListOpts holds options for listing Groups. It is passed to the groups.List
+// and groups.ListDetail functions.
+type ListOpts struct {
+	// AllTenants will retrieve groups of all tenants/projects. Admin only.
+	AllTenants bool `q:"all_tenants"`
+
+	// ListVolume will include the IDs of the volumes of each group in the
+	// response. Requires microversion 3.25 or later.
+	ListVolume bool `q:"list_volume"`
+
+	// Comma-separated list of sort keys and optional sort directions in the
+	// form of <key>[:<direction>].
+	Sort string `q:"sort"`
+
+	// Requests a page size of items.
+	Limit int `q:"limit"`
+
+	// Used in conjunction with limit to return a slice of items.
+	Offset int `q:"offset"`
+
+	// The ID of the last-seen item.
+	Marker string `q:"marker"`
+}
+
+// ToGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToGroupListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns Groups optionally limited by the conditions provided in
+// ListOpts. Only the ID and the name of each group are returned; use
+// ListDetail for the full objects.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listURL(client), opts)
+}
+
+// ListDetail returns detailed Groups optionally limited by the conditions
+// provided in ListOpts.
+func ListDetail(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listDetailURL(client), opts)
+}
+
+func list(client *gophercloud.ServiceClient, url string, opts ListOptsBuilder) pagination.Pager {
+	if opts != nil {
+		query, err := opts.ToGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return GroupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves the Group with the provided ID. To extract the Group object
+// from the response, call the Extract method on the GetResult.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(ctx, getURL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToGroupUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contain options for updating an existing Group. This object is
+// passed to the groups.Update function.
+type UpdateOpts struct {
+	// The new name of the group.
+	Name *string `json:"name,omitempty"`
+	// The new description of the group.
+	Description *string `json:"description,omitempty"`
+	// IDs of existing volumes to add to the group.
+	AddVolumes []string `json:"-"`
+	// IDs of volumes to remove from the group.
+	RemoveVolumes []string `json:"-"`
+}
+
+// ToGroupUpdateMap assembles a request body based on the contents of an
+// UpdateOpts.
+func (opts UpdateOpts) ToGroupUpdateMap() (map[string]any, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "group")
+	if err != nil {
+		return nil, err
+	}
+
+	group := b["group"].(map[string]any)
+	if len(opts.AddVolumes) > 0 {
+		group["add_volumes"] = strings.Join(opts.AddVolumes, ",")
+	}
+	if len(opts.RemoveVolumes) > 0 {
+		group["remove_volumes"] = strings.Join(opts.RemoveVolumes, ",")
+	}
+
+	return b, nil
+}
+
+// Update will update the Group with provided information and add or remove
+// volumes. The request is processed asynchronously and UpdateResult contains
+// only the error. To extract it, call the ExtractErr method on the
+// UpdateResult.
+func Update(ctx context.Context, client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, updateURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteOptsBuilder allows extensions to add additional parameters to the
+// Delete request.
+type DeleteOptsBuilder interface {
+	ToGroupDeleteMap() (map[string]any, error)
+}
+
+// DeleteOpts contains options for deleting a Group. This object is passed to
+// the groups.Delete function.
+type DeleteOpts struct {
+	// DeleteVolumes deletes the volumes of the group together with the
+	// group. The group can not be deleted while it contains volumes unless
+	// this is set.
+	DeleteVolumes bool `json:"delete-volumes"`
+}
+
+// ToGroupDeleteMap assembles a request body based on the contents of a
+// DeleteOpts.
+func (opts DeleteOpts) ToGroupDeleteMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "delete")
+}
+
+// Delete will delete the existing Group with the provided ID.
+func Delete(ctx context.Context, client *gophercloud.ServiceClient, id string, opts DeleteOptsBuilder) (r DeleteResult) {
+	b, err := opts.ToGroupDeleteMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ResetStatusOptsBuilder allows extensions to add additional parameters to the
+// ResetStatus request.
+type ResetStatusOptsBuilder interface {
+	ToGroupResetStatusMap() (map[string]any, error)
+}
+
+// ResetStatusOpts contains options for resetting a Group status.
+type ResetStatusOpts struct {
+	// Status is a group status to reset to.
+	Status string `json:"status" required:"true"`
+}
+
+// ToGroupResetStatusMap assembles a request body based on the contents of a
+// ResetStatusOpts.
+func (opts ResetStatusOpts) ToGroupResetStatusMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "reset_status")
+}
+
+// ResetStatus will reset the existing group status. ResetStatusResult contains
+// only the error. To extract it, call the ExtractErr method on the
+// ResetStatusResult.
+//
+// Requires microversion 3.20 or later.
+func ResetStatus(ctx context.Context, client *gophercloud.ServiceClient, id string, opts ResetStatusOptsBuilder) (r ResetStatusResult) {
+	b, err := opts.ToGroupResetStatusMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// EnableReplication enables replication for the Group with the provided ID.
+// The group type must have the consistent_group_replication_enabled group
+// spec.
+//
+// Requires microversion 3.38 or later.
+func EnableReplication(ctx context.Context, client *gophercloud.ServiceClient, id string) (r EnableReplicationResult) {
+	b := map[string]any{"enable_replication": map[string]any{}}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DisableReplication disables replication for the Group with the provided
+// ID.
+//
+// Requires microversion 3.38 or later.
+func DisableReplication(ctx context.Context, client *gophercloud.ServiceClient, id string) (r DisableReplicationResult) {
+	b := map[string]any{"disable_replication": map[string]any{}}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// FailoverReplicationOptsBuilder allows extensions to add additional
+// parameters to the FailoverReplication request.
+type FailoverReplicationOptsBuilder interface {
+	ToGroupFailoverReplicationMap() (map[string]any, error)
+}
+
+// FailoverReplicationOpts contains options for failing over a replicated
+// Group.
+type FailoverReplicationOpts struct {
+	// AllowAttachedVolume allows the failover of attached volumes.
+	AllowAttachedVolume bool `json:"allow_attached_volume,omitempty"`
+	// SecondaryBackendID is the ID of the replication target to fail over
+	// to. See ListReplicationTargets.
+	SecondaryBackendID string `json:"secondary_backend_id,omitempty"`
+}
+
+// ToGroupFailoverReplicationMap assembles a request body based on the
+// contents of a FailoverReplicationOpts.
+func (opts FailoverReplicationOpts) ToGroupFailoverReplicationMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "failover_replication")
+}
+
+// FailoverReplication fails over the replicated Group with the provided ID to
+// a secondary backend.
+//
+// Requires microversion 3.38 or later.
+func FailoverReplication(ctx context.Context, client *gophercloud.ServiceClient, id string, opts FailoverReplicationOptsBuilder) (r FailoverReplicationResult) {
+	b, err := opts.ToGroupFailoverReplicationMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListReplicationTargets retrieves the replication targets of the Group with
+// the provided ID. To extract the targets from the response, call the Extract
+// method on the ListReplicationTargetsResult.
+//
+// Requires microversion 3.38 or later.
+func ListReplicationTargets(ctx context.Context, client *gophercloud.ServiceClient, id string) (r ListReplicationTargetsResult) {
+	b := map[string]any{"list_replication_targets": map[string]any{}}
+	resp, err := client.Post(ctx, actionURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
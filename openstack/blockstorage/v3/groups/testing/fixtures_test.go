@@ -0,0 +1,205 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+const groupID = "6f519a48-3183-46cf-a32f-41815f816666"
+
+func MockCreateResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "group": {
+        "name": "database",
+        "group_type": "29514915-5208-46ab-9ece-1cc4688ad0c1",
+        "volume_types": [
+            "4e9e6d23-eed0-426d-b90a-28f87a94b6fe"
+        ],
+        "availability_zone": "nova"
+    }
+}
+      `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `
+{
+    "group": {
+        "id": "6f519a48-3183-46cf-a32f-41815f816666",
+        "name": "database"
+    }
+}
+    `)
+	})
+}
+
+func MockCreateFromSrcResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/groups/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "create-from-src": {
+        "name": "database-restore",
+        "group_snapshot_id": "9a1e4c2b-3d6f-4f7e-8a9b-0c1d2e3f4a5b"
+    }
+}
+      `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `
+{
+    "group": {
+        "id": "a2f3e4d5-c6b7-4a89-9b0c-1d2e3f4a5b6c",
+        "name": "database-restore"
+    }
+}
+    `)
+	})
+}
+
+func MockListDetailResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/groups/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		th.AssertEquals(t, "true", r.Form.Get("list_volume"))
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprintf(w, `
+{
+    "groups": [
+        {
+            "id": "6f519a48-3183-46cf-a32f-41815f816666",
+            "status": "available",
+            "availability_zone": "nova",
+            "created_at": "2017-05-03T17:06:04.000000",
+            "name": "database",
+            "description": null,
+            "group_type": "29514915-5208-46ab-9ece-1cc4688ad0c1",
+            "volume_types": [
+                "4e9e6d23-eed0-426d-b90a-28f87a94b6fe"
+            ],
+            "volumes": [
+                "6a5a2be4-c2a0-4d89-8ddd-5d08bf5b1a53"
+            ],
+            "group_snapshot_id": null,
+            "source_group_id": null,
+            "replication_status": "disabled"
+        }
+    ],
+    "groups_links": [
+        {
+            "href": "%s/groups/detail?list_volume=true&marker=1",
+            "rel": "next"
+        }
+    ]
+}
+  `, fakeServer.Server.URL)
+		case "1":
+			fmt.Fprint(w, `{"groups": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+func MockGetResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/groups/"+groupID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+    "group": {
+        "id": "6f519a48-3183-46cf-a32f-41815f816666",
+        "status": "available",
+        "availability_zone": "nova",
+        "created_at": "2017-05-03T17:06:04.000000",
+        "name": "database",
+        "description": "crash-consistent database volumes",
+        "group_type": "29514915-5208-46ab-9ece-1cc4688ad0c1",
+        "volume_types": [
+            "4e9e6d23-eed0-426d-b90a-28f87a94b6fe"
+        ],
+        "group_snapshot_id": null,
+        "source_group_id": null,
+        "project_id": "7ccf4863071f44aeb8f141f65780c51b",
+        "replication_status": "enabled"
+    }
+}
+      `)
+	})
+}
+
+func MockUpdateResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/groups/"+groupID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "group": {
+        "name": "database-v2",
+        "add_volumes": "6a5a2be4-c2a0-4d89-8ddd-5d08bf5b1a53,d8e8f9c5-9cd6-4e1c-a4a3-7c6e4f7d3a8e",
+        "remove_volumes": "0b1c2d3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e"
+    }
+}
+      `)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// MockActionResponse handles a POST on the action URL of the group and checks
+// the request body against the given one.
+func MockActionResponse(t *testing.T, fakeServer th.FakeServer, body string) {
+	fakeServer.Mux.HandleFunc("/groups/"+groupID+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestJSONRequest(t, r, body)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+func MockListReplicationTargetsResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/groups/"+groupID+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"list_replication_targets": {}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+    "replication_targets": [
+        {
+            "backend_id": "vendor-id-1",
+            "unique_key": "value1"
+        }
+    ]
+}
+      `)
+	})
+}
@@ -0,0 +1,214 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/groups"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockCreateResponse(t, fakeServer)
+
+	options := groups.CreateOpts{
+		Name:             "database",
+		GroupType:        "29514915-5208-46ab-9ece-1cc4688ad0c1",
+		VolumeTypes:      []string{"4e9e6d23-eed0-426d-b90a-28f87a94b6fe"},
+		AvailabilityZone: "nova",
+	}
+	n, err := groups.Create(context.TODO(), client.ServiceClient(fakeServer), options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, groupID, n.ID)
+	th.AssertEquals(t, "database", n.Name)
+}
+
+func TestCreateMissingGroupType(t *testing.T) {
+	options := groups.CreateOpts{
+		Name:        "database",
+		VolumeTypes: []string{"4e9e6d23-eed0-426d-b90a-28f87a94b6fe"},
+	}
+	_, err := options.ToGroupCreateMap()
+	th.AssertErr(t, err)
+}
+
+func TestCreateFromSrc(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockCreateFromSrcResponse(t, fakeServer)
+
+	options := groups.CreateFromSrcOpts{
+		Name:            "database-restore",
+		GroupSnapshotID: "9a1e4c2b-3d6f-4f7e-8a9b-0c1d2e3f4a5b",
+	}
+	n, err := groups.CreateFromSrc(context.TODO(), client.ServiceClient(fakeServer), options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "a2f3e4d5-c6b7-4a89-9b0c-1d2e3f4a5b6c", n.ID)
+	th.AssertEquals(t, "database-restore", n.Name)
+}
+
+func TestCreateFromSrcBothSources(t *testing.T) {
+	options := groups.CreateFromSrcOpts{
+		GroupSnapshotID: "9a1e4c2b-3d6f-4f7e-8a9b-0c1d2e3f4a5b",
+		SourceGroupID:   groupID,
+	}
+	_, err := options.ToGroupCreateFromSrcMap()
+	th.AssertErr(t, err)
+
+	_, err = groups.CreateFromSrcOpts{}.ToGroupCreateFromSrcMap()
+	th.AssertErr(t, err)
+}
+
+func TestListDetail(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockListDetailResponse(t, fakeServer)
+
+	pages := 0
+	err := groups.ListDetail(client.ServiceClient(fakeServer), groups.ListOpts{ListVolume: true}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+		actual, err := groups.ExtractGroups(page)
+		if err != nil {
+			return false, err
+		}
+
+		expected := []groups.Group{
+			{
+				ID:                groupID,
+				Status:            "available",
+				AvailabilityZone:  "nova",
+				CreatedAt:         time.Date(2017, 5, 3, 17, 6, 4, 0, time.UTC),
+				Name:              "database",
+				GroupType:         "29514915-5208-46ab-9ece-1cc4688ad0c1",
+				VolumeTypes:       []string{"4e9e6d23-eed0-426d-b90a-28f87a94b6fe"},
+				Volumes:           []string{"6a5a2be4-c2a0-4d89-8ddd-5d08bf5b1a53"},
+				ReplicationStatus: "disabled",
+			},
+		}
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, pages)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockGetResponse(t, fakeServer)
+
+	v, err := groups.Get(context.TODO(), client.ServiceClient(fakeServer), groupID).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "database", v.Name)
+	th.AssertEquals(t, "crash-consistent database volumes", v.Description)
+	th.AssertEquals(t, "7ccf4863071f44aeb8f141f65780c51b", v.ProjectID)
+	th.AssertEquals(t, "enabled", v.ReplicationStatus)
+	th.AssertEquals(t, time.Date(2017, 5, 3, 17, 6, 4, 0, time.UTC), v.CreatedAt)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockUpdateResponse(t, fakeServer)
+
+	name := "database-v2"
+	options := groups.UpdateOpts{
+		Name: &name,
+		AddVolumes: []string{
+			"6a5a2be4-c2a0-4d89-8ddd-5d08bf5b1a53",
+			"d8e8f9c5-9cd6-4e1c-a4a3-7c6e4f7d3a8e",
+		},
+		RemoveVolumes: []string{"0b1c2d3e-4f5a-4b6c-8d7e-9f0a1b2c3d4e"},
+	}
+	err := groups.Update(context.TODO(), client.ServiceClient(fakeServer), groupID, options).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockActionResponse(t, fakeServer, `{"delete": {"delete-volumes": true}}`)
+
+	err := groups.Delete(context.TODO(), client.ServiceClient(fakeServer), groupID, groups.DeleteOpts{DeleteVolumes: true}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestResetStatus(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockActionResponse(t, fakeServer, `{"reset_status": {"status": "available"}}`)
+
+	err := groups.ResetStatus(context.TODO(), client.ServiceClient(fakeServer), groupID, groups.ResetStatusOpts{Status: "available"}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestEnableReplication(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockActionResponse(t, fakeServer, `{"enable_replication": {}}`)
+
+	err := groups.EnableReplication(context.TODO(), client.ServiceClient(fakeServer), groupID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDisableReplication(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockActionResponse(t, fakeServer, `{"disable_replication": {}}`)
+
+	err := groups.DisableReplication(context.TODO(), client.ServiceClient(fakeServer), groupID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestFailoverReplication(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockActionResponse(t, fakeServer, `
+{
+    "failover_replication": {
+        "allow_attached_volume": true,
+        "secondary_backend_id": "vendor-id-1"
+    }
+}
+	`)
+
+	options := groups.FailoverReplicationOpts{
+		AllowAttachedVolume: true,
+		SecondaryBackendID:  "vendor-id-1",
+	}
+	err := groups.FailoverReplication(context.TODO(), client.ServiceClient(fakeServer), groupID, options).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListReplicationTargets(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockListReplicationTargetsResponse(t, fakeServer)
+
+	targets, err := groups.ListReplicationTargets(context.TODO(), client.ServiceClient(fakeServer), groupID).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(targets))
+	th.AssertEquals(t, "vendor-id-1", targets[0].BackendID())
+	th.AssertEquals(t, "value1", targets[0]["unique_key"])
+}
@@ -0,0 +1,31 @@
+package groups
+
+import "github.com/gophercloud/gophercloud/v2"
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("groups")
+}
+
+func createFromSrcURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("groups", "action")
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("groups")
+}
+
+func listDetailURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("groups", "detail")
+}
+
+func getURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("groups", id)
+}
+
+func updateURL(c *gophercloud.ServiceClient, id string) string {
+	return getURL(c, id)
+}
+
+func actionURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("groups", id, "action")
+}
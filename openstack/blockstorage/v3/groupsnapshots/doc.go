@@ -0,0 +1,51 @@
+/*
+Package groupsnapshots provides information and interaction with group
+snapshots in the OpenStack Block Storage service. A group snapshot takes a
+snapshot of every volume of a generic volume group at the same point in time,
+which makes it crash-consistent across the volumes.
+
+NOTE: Requires at least microversion 3.14. ResetStatus requires 3.19.
+
+Example to create a Group Snapshot
+
+	client.Microversion = "3.14"
+
+	createOpts := groupsnapshots.CreateOpts{
+		GroupID: "6f519a48-3183-46cf-a32f-41815f816666",
+		Name:    "database-nightly",
+	}
+
+	groupSnapshot, err := groupsnapshots.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to list Group Snapshots
+
+	client.Microversion = "3.14"
+
+	allPages, err := groupsnapshots.ListDetail(client, nil).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allGroupSnapshots, err := groupsnapshots.ExtractGroupSnapshots(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, groupSnapshot := range allGroupSnapshots {
+		fmt.Println(groupSnapshot)
+	}
+
+Example to delete a Group Snapshot
+
+	client.Microversion = "3.14"
+
+	groupSnapshotID := "9a1e4c2b-3d6f-4f7e-8a9b-0c1d2e3f4a5b"
+	err := groupsnapshots.Delete(context.TODO(), client, groupSnapshotID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package groupsnapshots
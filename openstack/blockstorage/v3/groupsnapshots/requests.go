@@ -0,0 +1,161 @@
+package groupsnapshots
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToGroupSnapshotCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains options for creating a Group Snapshot. This object is
+// passed to the groupsnapshots.Create function.
+type CreateOpts struct {
+	// The ID of the group to snapshot.
+	GroupID string `json:"group_id" required:"true"`
+	// The name of the group snapshot.
+	Name string `json:"name,omitempty"`
+	// The description of the group snapshot.
+	Description string `json:"description,omitempty"`
+}
+
+// ToGroupSnapshotCreateMap assembles a request body based on the contents of
+// a CreateOpts.
+func (opts CreateOpts) ToGroupSnapshotCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "group_snapshot")
+}
+
+// Create will create a new Group Snapshot based on the values in CreateOpts.
+// A snapshot is taken of every volume in the group at the same point in time.
+// To extract the Group Snapshot object from the response, call the Extract
+// method on the CreateResult.
+func Create(ctx context.Context, client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToGroupSnapshotCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will delete the existing Group Snapshot with the provided ID.
+func Delete(ctx context.Context, client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := client.Delete(ctx, deleteURL(client, id), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Get retrieves the Group Snapshot with the provided ID. To extract the Group
+// Snapshot object from the response, call the Extract method on the
+// GetResult.
+func Get(ctx context.Context, client *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(ctx, getURL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToGroupSnapshotListQuery() (string, error)
+}
+
+// ListOpts holds options for listing Group Snapshots. It is passed to the
+// groupsnapshots.List and groupsnapshots.ListDetail functions.
+type ListOpts struct {
+	// AllTenants will retrieve group snapshots of all tenants/projects.
+	// Admin only.
+	AllTenants bool `q:"all_tenants"`
+
+	// Comma-separated list of sort keys and optional sort directions in the
+	// form of <key>[:<direction>].
+	Sort string `q:"sort"`
+
+	// Requests a page size of items.
+	Limit int `q:"limit"`
+
+	// Used in conjunction with limit to return a slice of items.
+	Offset int `q:"offset"`
+
+	// The ID of the last-seen item.
+	Marker string `q:"marker"`
+}
+
+// ToGroupSnapshotListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToGroupSnapshotListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns Group Snapshots optionally limited by the conditions provided
+// in ListOpts. Only the ID and the name of each group snapshot are returned;
+// use ListDetail for the full objects.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listURL(client), opts)
+}
+
+// ListDetail returns detailed Group Snapshots optionally limited by the
+// conditions provided in ListOpts.
+func ListDetail(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listDetailURL(client), opts)
+}
+
+func list(client *gophercloud.ServiceClient, url string, opts ListOptsBuilder) pagination.Pager {
+	if opts != nil {
+		query, err := opts.ToGroupSnapshotListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return GroupSnapshotPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ResetStatusOptsBuilder allows extensions to add additional parameters to the
+// ResetStatus request.
+type ResetStatusOptsBuilder interface {
+	ToGroupSnapshotResetStatusMap() (map[string]any, error)
+}
+
+// ResetStatusOpts contains options for resetting a Group Snapshot status.
+type ResetStatusOpts struct {
+	// Status is a group snapshot status to reset to.
+	Status string `json:"status" required:"true"`
+}
+
+// ToGroupSnapshotResetStatusMap assembles a request body based on the
+// contents of a ResetStatusOpts.
+func (opts ResetStatusOpts) ToGroupSnapshotResetStatusMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "reset_status")
+}
+
+// ResetStatus will reset the existing group snapshot status.
+// ResetStatusResult contains only the error. To extract it, call the
+// ExtractErr method on the ResetStatusResult.
+//
+// Requires microversion 3.19 or later.
+func ResetStatus(ctx context.Context, client *gophercloud.ServiceClient, id string, opts ResetStatusOptsBuilder) (r ResetStatusResult) {
+	b, err := opts.ToGroupSnapshotResetStatusMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,136 @@
+package groupsnapshots
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// GroupSnapshot contains all the information associated with an OpenStack
+// group snapshot.
+type GroupSnapshot struct {
+	// Unique identifier for the group snapshot.
+	ID string `json:"id"`
+
+	// Current status of the group snapshot.
+	Status string `json:"status"`
+
+	// The date when this group snapshot was created.
+	CreatedAt time.Time `json:"-"`
+
+	// The ID of the group the snapshot was taken of.
+	GroupID string `json:"group_id"`
+
+	// Human-readable display name for the group snapshot.
+	Name string `json:"name"`
+
+	// Human-readable description for the group snapshot.
+	Description string `json:"description"`
+
+	// The ID of the group type of the source group.
+	GroupTypeID string `json:"group_type_id"`
+
+	// The ID of the project owning the group snapshot. Requires microversion
+	// 3.58 or later.
+	ProjectID string `json:"project_id"`
+}
+
+// UnmarshalJSON converts our JSON API response into our group snapshot struct
+func (r *GroupSnapshot) UnmarshalJSON(b []byte) error {
+	type tmp GroupSnapshot
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = GroupSnapshot(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+
+	return err
+}
+
+// GroupSnapshotPage is a pagination.Pager that is returned from a call to the
+// List and ListDetail functions.
+type GroupSnapshotPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a GroupSnapshotPage contains no Group Snapshots.
+func (r GroupSnapshotPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	snapshots, err := ExtractGroupSnapshots(r)
+	return len(snapshots) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r GroupSnapshotPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"group_snapshots_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// ExtractGroupSnapshots extracts and returns Group Snapshots. It is used
+// while iterating over a groupsnapshots.List or groupsnapshots.ListDetail
+// call.
+func ExtractGroupSnapshots(r pagination.Page) ([]GroupSnapshot, error) {
+	var s []GroupSnapshot
+	err := ExtractGroupSnapshotsInto(r, &s)
+	return s, err
+}
+
+// ExtractGroupSnapshotsInto similar to ExtractInto but operates on a `list`
+// of group snapshots
+func ExtractGroupSnapshotsInto(r pagination.Page, v any) error {
+	return r.(GroupSnapshotPage).ExtractIntoSlicePtr(v, "group_snapshots")
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the Group Snapshot object out of the commonResult object.
+func (r commonResult) Extract() (*GroupSnapshot, error) {
+	var s GroupSnapshot
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtractInto converts our response data into a group snapshot struct
+func (r commonResult) ExtractInto(v any) error {
+	return r.ExtractIntoStructPtr(v, "group_snapshot")
+}
+
+// CreateResult contains the response body and error from a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult contains the response error from a Delete request.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ResetStatusResult contains the response error from a ResetStatus request.
+type ResetStatusResult struct {
+	gophercloud.ErrResult
+}
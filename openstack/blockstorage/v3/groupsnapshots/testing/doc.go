@@ -0,0 +1,2 @@
+// groupsnapshots unit tests
+package testing
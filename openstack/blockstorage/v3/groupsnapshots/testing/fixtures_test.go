@@ -0,0 +1,127 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+const groupSnapshotID = "9a1e4c2b-3d6f-4f7e-8a9b-0c1d2e3f4a5b"
+
+func MockCreateResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_snapshots", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "group_snapshot": {
+        "group_id": "6f519a48-3183-46cf-a32f-41815f816666",
+        "name": "database-nightly"
+    }
+}
+      `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `
+{
+    "group_snapshot": {
+        "id": "9a1e4c2b-3d6f-4f7e-8a9b-0c1d2e3f4a5b",
+        "name": "database-nightly",
+        "group_type_id": "29514915-5208-46ab-9ece-1cc4688ad0c1"
+    }
+}
+    `)
+	})
+}
+
+func MockListDetailResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_snapshots/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprintf(w, `
+{
+    "group_snapshots": [
+        {
+            "id": "9a1e4c2b-3d6f-4f7e-8a9b-0c1d2e3f4a5b",
+            "status": "available",
+            "created_at": "2017-05-03T17:07:52.000000",
+            "group_id": "6f519a48-3183-46cf-a32f-41815f816666",
+            "name": "database-nightly",
+            "description": null,
+            "group_type_id": "29514915-5208-46ab-9ece-1cc4688ad0c1"
+        }
+    ],
+    "group_snapshots_links": [
+        {
+            "href": "%s/group_snapshots/detail?marker=1",
+            "rel": "next"
+        }
+    ]
+}
+  `, fakeServer.Server.URL)
+		case "1":
+			fmt.Fprint(w, `{"group_snapshots": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+func MockGetResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_snapshots/"+groupSnapshotID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+    "group_snapshot": {
+        "id": "9a1e4c2b-3d6f-4f7e-8a9b-0c1d2e3f4a5b",
+        "status": "creating",
+        "created_at": "2017-05-03T17:07:52.000000",
+        "group_id": "6f519a48-3183-46cf-a32f-41815f816666",
+        "name": "database-nightly",
+        "description": "nightly backup point",
+        "group_type_id": "29514915-5208-46ab-9ece-1cc4688ad0c1",
+        "project_id": "7ccf4863071f44aeb8f141f65780c51b"
+    }
+}
+      `)
+	})
+}
+
+func MockDeleteResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_snapshots/"+groupSnapshotID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+func MockResetStatusResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_snapshots/"+groupSnapshotID+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestJSONRequest(t, r, `{"reset_status": {"status": "error"}}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -0,0 +1,101 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/groupsnapshots"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockCreateResponse(t, fakeServer)
+
+	options := groupsnapshots.CreateOpts{
+		GroupID: "6f519a48-3183-46cf-a32f-41815f816666",
+		Name:    "database-nightly",
+	}
+	n, err := groupsnapshots.Create(context.TODO(), client.ServiceClient(fakeServer), options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, groupSnapshotID, n.ID)
+	th.AssertEquals(t, "database-nightly", n.Name)
+	th.AssertEquals(t, "29514915-5208-46ab-9ece-1cc4688ad0c1", n.GroupTypeID)
+}
+
+func TestCreateMissingGroupID(t *testing.T) {
+	_, err := groupsnapshots.CreateOpts{Name: "database-nightly"}.ToGroupSnapshotCreateMap()
+	th.AssertErr(t, err)
+}
+
+func TestListDetail(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockListDetailResponse(t, fakeServer)
+
+	pages := 0
+	err := groupsnapshots.ListDetail(client.ServiceClient(fakeServer), nil).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+		actual, err := groupsnapshots.ExtractGroupSnapshots(page)
+		if err != nil {
+			return false, err
+		}
+
+		expected := []groupsnapshots.GroupSnapshot{
+			{
+				ID:          groupSnapshotID,
+				Status:      "available",
+				CreatedAt:   time.Date(2017, 5, 3, 17, 7, 52, 0, time.UTC),
+				GroupID:     "6f519a48-3183-46cf-a32f-41815f816666",
+				Name:        "database-nightly",
+				GroupTypeID: "29514915-5208-46ab-9ece-1cc4688ad0c1",
+			},
+		}
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, pages)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockGetResponse(t, fakeServer)
+
+	v, err := groupsnapshots.Get(context.TODO(), client.ServiceClient(fakeServer), groupSnapshotID).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "creating", v.Status)
+	th.AssertEquals(t, "nightly backup point", v.Description)
+	th.AssertEquals(t, "7ccf4863071f44aeb8f141f65780c51b", v.ProjectID)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockDeleteResponse(t, fakeServer)
+
+	res := groupsnapshots.Delete(context.TODO(), client.ServiceClient(fakeServer), groupSnapshotID)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestResetStatus(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockResetStatusResponse(t, fakeServer)
+
+	err := groupsnapshots.ResetStatus(context.TODO(), client.ServiceClient(fakeServer), groupSnapshotID, groupsnapshots.ResetStatusOpts{Status: "error"}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,92 @@
+/*
+Package grouptypes provides information and interaction with group types in
+the OpenStack Block Storage service. A group type is a collection of specs
+used to define the capabilities of generic volume groups.
+
+NOTE: Requires at least microversion 3.11
+
+Example to List Group Types
+
+	client.Microversion = "3.11"
+
+	allPages, err := grouptypes.List(client, grouptypes.ListOpts{}).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	groupTypes, err := grouptypes.ExtractGroupTypes(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, gt := range groupTypes {
+		fmt.Println(gt)
+	}
+
+Example to Create a Group Type
+
+	client.Microversion = "3.11"
+
+	createOpts := grouptypes.CreateOpts{
+		Name:        "consistent-snapshots",
+		Description: "groups supporting consistent snapshots",
+		GroupSpecs: map[string]string{
+			"consistent_group_snapshot_enabled": "<is> True",
+		},
+	}
+
+	groupType, err := grouptypes.Create(context.TODO(), client, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Group Type
+
+	client.Microversion = "3.11"
+
+	name := "consistent-snapshots-v2"
+	updateOpts := grouptypes.UpdateOpts{
+		Name: &name,
+	}
+
+	groupTypeID := "7270c56e-6354-4528-8e8b-f54dee2232c8"
+	groupType, err := grouptypes.Update(context.TODO(), client, groupTypeID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Group Type
+
+	client.Microversion = "3.11"
+
+	groupTypeID := "7270c56e-6354-4528-8e8b-f54dee2232c8"
+	err := grouptypes.Delete(context.TODO(), client, groupTypeID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create or Update Group Specs
+
+	client.Microversion = "3.11"
+
+	groupTypeID := "7270c56e-6354-4528-8e8b-f54dee2232c8"
+	groupSpecs := grouptypes.GroupSpecsOpts{
+		"consistent_group_replication_enabled": "<is> True",
+	}
+
+	specs, err := grouptypes.CreateGroupSpecs(context.TODO(), client, groupTypeID, groupSpecs).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Group Spec
+
+	client.Microversion = "3.11"
+
+	groupTypeID := "7270c56e-6354-4528-8e8b-f54dee2232c8"
+	err := grouptypes.DeleteGroupSpec(context.TODO(), client, groupTypeID, "consistent_group_replication_enabled").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package grouptypes
@@ -0,0 +1,159 @@
+package grouptypes
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// GroupType contains all the information associated with an OpenStack Group
+// Type.
+type GroupType struct {
+	// Unique identifier for the group type.
+	ID string `json:"id"`
+	// Human-readable display name for the group type.
+	Name string `json:"name"`
+	// Human-readable description for the group type.
+	Description string `json:"description"`
+	// Arbitrary key-value pairs defined by the user.
+	GroupSpecs map[string]string `json:"group_specs"`
+	// Whether the group type is publicly visible.
+	IsPublic bool `json:"is_public"`
+}
+
+// GroupTypePage is a pagination.pager that is returned from a call to the
+// List function.
+type GroupTypePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a ListResult contains no Group Types.
+func (r GroupTypePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	grouptypes, err := ExtractGroupTypes(r)
+	return len(grouptypes) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r GroupTypePage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"group_type_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// ExtractGroupTypes extracts and returns Group Types. It is used while
+// iterating over a grouptypes.List call.
+func ExtractGroupTypes(r pagination.Page) ([]GroupType, error) {
+	var s []GroupType
+	err := ExtractGroupTypesInto(r, &s)
+	return s, err
+}
+
+// ExtractGroupTypesInto similar to ExtractInto but operates on a `list` of
+// group types
+func ExtractGroupTypesInto(r pagination.Page, v any) error {
+	return r.(GroupTypePage).ExtractIntoSlicePtr(v, "group_types")
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the Group Type object out of the commonResult object.
+func (r commonResult) Extract() (*GroupType, error) {
+	var s GroupType
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtractInto converts our response data into a group type struct
+func (r commonResult) ExtractInto(v any) error {
+	return r.ExtractIntoStructPtr(v, "group_type")
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult contains the response body and error from a Create request.
+type CreateResult struct {
+	commonResult
+}
+
+// DeleteResult contains the response body and error from a Delete request.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// UpdateResult contains the response body and error from an Update request.
+type UpdateResult struct {
+	commonResult
+}
+
+// groupSpecsResult contains the result of a call for (potentially) multiple
+// key-value pairs. Call its Extract method to interpret it as a
+// map[string]string.
+type groupSpecsResult struct {
+	gophercloud.Result
+}
+
+// ListGroupSpecsResult contains the result of a Get operation. Call its
+// Extract method to interpret it as a map[string]string.
+type ListGroupSpecsResult struct {
+	groupSpecsResult
+}
+
+// CreateGroupSpecsResult contains the result of a Create operation. Call its
+// Extract method to interpret it as a map[string]string.
+type CreateGroupSpecsResult struct {
+	groupSpecsResult
+}
+
+// Extract interprets any groupSpecsResult as GroupSpecs, if possible.
+func (r groupSpecsResult) Extract() (map[string]string, error) {
+	var s struct {
+		GroupSpecs map[string]string `json:"group_specs"`
+	}
+	err := r.ExtractInto(&s)
+	return s.GroupSpecs, err
+}
+
+// groupSpecResult contains the result of a call for an individual key-value
+// pair.
+type groupSpecResult struct {
+	gophercloud.Result
+}
+
+// GetGroupSpecResult contains the result of a Get operation. Call its Extract
+// method to interpret it as a map[string]string.
+type GetGroupSpecResult struct {
+	groupSpecResult
+}
+
+// UpdateGroupSpecResult contains the result of an Update operation. Call its
+// Extract method to interpret it as a map[string]string.
+type UpdateGroupSpecResult struct {
+	groupSpecResult
+}
+
+// DeleteGroupSpecResult contains the result of a Delete operation. Call its
+// ExtractErr method to determine if the call succeeded or failed.
+type DeleteGroupSpecResult struct {
+	gophercloud.ErrResult
+}
+
+// Extract interprets any groupSpecResult as a GroupSpec, if possible.
+func (r groupSpecResult) Extract() (map[string]string, error) {
+	var s map[string]string
+	err := r.ExtractInto(&s)
+	return s, err
+}
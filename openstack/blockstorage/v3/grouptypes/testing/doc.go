@@ -0,0 +1,2 @@
+// grouptypes unit tests
+package testing
@@ -0,0 +1,286 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+const groupTypeID = "7270c56e-6354-4528-8e8b-f54dee2232c8"
+
+func MockListResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprintf(w, `
+{
+    "group_types": [
+        {
+            "id": "7270c56e-6354-4528-8e8b-f54dee2232c8",
+            "name": "consistent-snapshots",
+            "description": "groups supporting consistent snapshots",
+            "is_public": true,
+            "group_specs": {
+                "consistent_group_snapshot_enabled": "<is> True"
+            }
+        },
+        {
+            "id": "6685584b-1eac-4da6-b5c3-555430cf68ff",
+            "name": "default",
+            "description": null,
+            "is_public": true,
+            "group_specs": {}
+        }
+    ],
+    "group_type_links": [
+        {
+            "href": "%s/group_types?marker=1",
+            "rel": "next"
+        }
+    ]
+}
+  `, fakeServer.Server.URL)
+		case "1":
+			fmt.Fprint(w, `{"group_types": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+func MockGetResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/"+groupTypeID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+    "group_type": {
+        "id": "7270c56e-6354-4528-8e8b-f54dee2232c8",
+        "name": "consistent-snapshots",
+        "description": "groups supporting consistent snapshots",
+        "is_public": true,
+        "group_specs": {
+            "consistent_group_snapshot_enabled": "<is> True"
+        }
+    }
+}
+      `)
+	})
+}
+
+func MockGetDefaultResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/default", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+    "group_type": {
+        "id": "6685584b-1eac-4da6-b5c3-555430cf68ff",
+        "name": "default",
+        "description": null,
+        "is_public": true,
+        "group_specs": {}
+    }
+}
+      `)
+	})
+}
+
+func MockCreateResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "group_type": {
+        "name": "consistent-snapshots",
+        "description": "groups supporting consistent snapshots",
+        "is_public": true,
+        "group_specs": {
+            "consistent_group_snapshot_enabled": "<is> True"
+        }
+    }
+}
+      `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+    "group_type": {
+        "id": "7270c56e-6354-4528-8e8b-f54dee2232c8",
+        "name": "consistent-snapshots",
+        "description": "groups supporting consistent snapshots",
+        "is_public": true,
+        "group_specs": {
+            "consistent_group_snapshot_enabled": "<is> True"
+        }
+    }
+}
+      `)
+	})
+}
+
+func MockDeleteResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/"+groupTypeID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+func MockUpdateResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/"+groupTypeID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "group_type": {
+        "name": "consistent-snapshots-v2",
+        "is_public": false
+    }
+}
+      `)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+    "group_type": {
+        "id": "7270c56e-6354-4528-8e8b-f54dee2232c8",
+        "name": "consistent-snapshots-v2",
+        "description": "groups supporting consistent snapshots",
+        "is_public": false,
+        "group_specs": {
+            "consistent_group_snapshot_enabled": "<is> True"
+        }
+    }
+}
+      `)
+	})
+}
+
+// GroupSpecsGetBody provides a GET result of the group_specs for a group type
+const GroupSpecsGetBody = `
+{
+    "group_specs" : {
+        "key1" : "value1",
+        "key2" : "value2"
+    }
+}
+`
+
+// GroupSpecGetBody provides a GET result of a particular group spec
+const GroupSpecGetBody = `
+{
+    "key1" : "value1"
+}
+`
+
+// GroupSpecsCreateBody provides the expected body for a group specs create
+const GroupSpecsCreateBody = `
+{
+    "group_specs" : {
+        "key1" : "value1",
+        "key2" : "value2"
+    }
+}
+`
+
+// GroupSpecUpdateBody provides the expected body for a group spec update
+const GroupSpecUpdateBody = `
+{
+    "key1" : "value1"
+}
+`
+
+// GroupSpecs is the expected group specs returned from a GET request
+var GroupSpecs = map[string]string{
+	"key1": "value1",
+	"key2": "value2",
+}
+
+// GroupSpec is the expected group spec returned from a GET request
+var GroupSpec = map[string]string{
+	"key1": "value1",
+}
+
+func HandleGroupSpecsListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/"+groupTypeID+"/group_specs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GroupSpecsGetBody)
+	})
+}
+
+func HandleGroupSpecGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/"+groupTypeID+"/group_specs/key1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GroupSpecGetBody)
+	})
+}
+
+func HandleGroupSpecsCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/"+groupTypeID+"/group_specs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, GroupSpecsCreateBody)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GroupSpecsGetBody)
+	})
+}
+
+func HandleGroupSpecUpdateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/"+groupTypeID+"/group_specs/key1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, GroupSpecUpdateBody)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GroupSpecGetBody)
+	})
+}
+
+func HandleGroupSpecDeleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/group_types/"+groupTypeID+"/group_specs/key1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
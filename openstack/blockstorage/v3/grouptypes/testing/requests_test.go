@@ -0,0 +1,185 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/grouptypes"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+var consistentSnapshots = grouptypes.GroupType{
+	ID:          groupTypeID,
+	Name:        "consistent-snapshots",
+	Description: "groups supporting consistent snapshots",
+	IsPublic:    true,
+	GroupSpecs: map[string]string{
+		"consistent_group_snapshot_enabled": "<is> True",
+	},
+}
+
+func TestListAll(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockListResponse(t, fakeServer)
+
+	pages := 0
+	err := grouptypes.List(client.ServiceClient(fakeServer), nil).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+		actual, err := grouptypes.ExtractGroupTypes(page)
+		if err != nil {
+			return false, err
+		}
+
+		expected := []grouptypes.GroupType{
+			consistentSnapshots,
+			{
+				ID:         "6685584b-1eac-4da6-b5c3-555430cf68ff",
+				Name:       "default",
+				IsPublic:   true,
+				GroupSpecs: map[string]string{},
+			},
+		}
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, pages)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockGetResponse(t, fakeServer)
+
+	v, err := grouptypes.Get(context.TODO(), client.ServiceClient(fakeServer), groupTypeID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, consistentSnapshots, *v)
+}
+
+func TestGetDefault(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockGetDefaultResponse(t, fakeServer)
+
+	v, err := grouptypes.GetDefault(context.TODO(), client.ServiceClient(fakeServer)).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "default", v.Name)
+	th.AssertEquals(t, "6685584b-1eac-4da6-b5c3-555430cf68ff", v.ID)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockCreateResponse(t, fakeServer)
+
+	isPublic := true
+	options := grouptypes.CreateOpts{
+		Name:        "consistent-snapshots",
+		Description: "groups supporting consistent snapshots",
+		IsPublic:    &isPublic,
+		GroupSpecs: map[string]string{
+			"consistent_group_snapshot_enabled": "<is> True",
+		},
+	}
+	n, err := grouptypes.Create(context.TODO(), client.ServiceClient(fakeServer), options).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, consistentSnapshots, *n)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockDeleteResponse(t, fakeServer)
+
+	res := grouptypes.Delete(context.TODO(), client.ServiceClient(fakeServer), groupTypeID)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockUpdateResponse(t, fakeServer)
+
+	name := "consistent-snapshots-v2"
+	isPublic := false
+	options := grouptypes.UpdateOpts{Name: &name, IsPublic: &isPublic}
+	v, err := grouptypes.Update(context.TODO(), client.ServiceClient(fakeServer), groupTypeID, options).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "consistent-snapshots-v2", v.Name)
+	th.CheckEquals(t, false, v.IsPublic)
+}
+
+func TestGroupSpecsList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGroupSpecsListSuccessfully(t, fakeServer)
+
+	actual, err := grouptypes.ListGroupSpecs(context.TODO(), client.ServiceClient(fakeServer), groupTypeID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, GroupSpecs, actual)
+}
+
+func TestGroupSpecGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGroupSpecGetSuccessfully(t, fakeServer)
+
+	actual, err := grouptypes.GetGroupSpec(context.TODO(), client.ServiceClient(fakeServer), groupTypeID, "key1").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, GroupSpec, actual)
+}
+
+func TestGroupSpecsCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGroupSpecsCreateSuccessfully(t, fakeServer)
+
+	createOpts := grouptypes.GroupSpecsOpts{
+		"key1": "value1",
+		"key2": "value2",
+	}
+	actual, err := grouptypes.CreateGroupSpecs(context.TODO(), client.ServiceClient(fakeServer), groupTypeID, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, GroupSpecs, actual)
+}
+
+func TestGroupSpecUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGroupSpecUpdateSuccessfully(t, fakeServer)
+
+	updateOpts := grouptypes.GroupSpecsOpts{
+		"key1": "value1",
+	}
+	actual, err := grouptypes.UpdateGroupSpec(context.TODO(), client.ServiceClient(fakeServer), groupTypeID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, GroupSpec, actual)
+}
+
+func TestGroupSpecUpdateInvalid(t *testing.T) {
+	updateOpts := grouptypes.GroupSpecsOpts{
+		"key1": "value1",
+		"key2": "value2",
+	}
+	_, _, err := updateOpts.ToGroupTypeGroupSpecUpdateMap()
+	th.AssertErr(t, err)
+}
+
+func TestGroupSpecDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleGroupSpecDeleteSuccessfully(t, fakeServer)
+
+	res := grouptypes.DeleteGroupSpec(context.TODO(), client.ServiceClient(fakeServer), groupTypeID, "key1")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,47 @@
+package grouptypes
+
+import "github.com/gophercloud/gophercloud/v2"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("group_types")
+}
+
+func getURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("group_types", id)
+}
+
+func getDefaultURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("group_types", "default")
+}
+
+func createURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("group_types")
+}
+
+func deleteURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("group_types", id)
+}
+
+func updateURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("group_types", id)
+}
+
+func groupSpecsListURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("group_types", id, "group_specs")
+}
+
+func groupSpecsGetURL(c *gophercloud.ServiceClient, id, key string) string {
+	return c.ServiceURL("group_types", id, "group_specs", key)
+}
+
+func groupSpecsCreateURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("group_types", id, "group_specs")
+}
+
+func groupSpecUpdateURL(c *gophercloud.ServiceClient, id, key string) string {
+	return c.ServiceURL("group_types", id, "group_specs", key)
+}
+
+func groupSpecDeleteURL(c *gophercloud.ServiceClient, id, key string) string {
+	return c.ServiceURL("group_types", id, "group_specs", key)
+}
@@ -0,0 +1,47 @@
+/*
+Package manageablesnapshots information and interaction with manageable
+snapshots for the OpenStack Block Storage service.
+
+NOTE: Requires at least microversion 3.8
+
+Example to list the manageable snapshots of a host
+
+	listOpts := manageablesnapshots.ListOpts{
+		Host: "host@lvm#LVM",
+	}
+
+	allPages, err := manageablesnapshots.ListDetail(client, listOpts).AllPages(context.TODO())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	allSnapshots, err := manageablesnapshots.ExtractManageableSnapshots(allPages)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, snapshot := range allSnapshots {
+		if snapshot.SafeToManage {
+			fmt.Printf("%+v\n", snapshot.Reference)
+		}
+	}
+
+Example to manage an existing snapshot
+
+	manageOpts := manageablesnapshots.ManageExistingOpts{
+		VolumeID: "23cf872b-c781-4cd4-847d-5f2ec8cbd91c",
+		Ref: map[string]string{
+			"source-name": "snapshot-7e42ba41-9ae1-4e95-8c73-2b6a3e8e6c1f",
+		},
+		Name:        "New Snapshot",
+		Description: "Snapshot imported from existingLV",
+	}
+
+	managedSnapshot, err := manageablesnapshots.ManageExisting(context.TODO(), client, manageOpts).Extract()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("Managed snapshot: %+v\n", managedSnapshot)
+*/
+package manageablesnapshots
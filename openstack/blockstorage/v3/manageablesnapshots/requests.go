@@ -0,0 +1,120 @@
+package manageablesnapshots
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ManageExistingOptsBuilder allows extentions to add additional parameters to the ManageExisting request.
+type ManageExistingOptsBuilder interface {
+	ToManageExistingMap() (map[string]any, error)
+}
+
+// ManageExistingOpts contains options for managing a existing snapshot.
+// This object is passed to the manageablesnapshots.ManageExisting function.
+// For more information about the parameters, see the Snapshot object and OpenStack BlockStorage API Guide.
+type ManageExistingOpts struct {
+	// The ID of the managed volume the snapshot belongs to.
+	VolumeID string `json:"volume_id" required:"true"`
+	// A reference to the existing snapshot.
+	// The internal structure of this reference depends on the volume driver implementation.
+	// For details about the required elements in the structure, see the documentation for the volume driver.
+	Ref map[string]string `json:"ref" required:"true"`
+	// Human-readable display name for the snapshot.
+	Name string `json:"name,omitempty"`
+	// Human-readable description for the snapshot.
+	Description string `json:"description,omitempty"`
+	// One or more metadata key and value pairs to associate with the snapshot.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// ToManageExistingMap assembles a request body based on the contents of a ManageExistingOpts.
+func (opts ManageExistingOpts) ToManageExistingMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "snapshot")
+}
+
+// ManageExisting will manage an existing snapshot based on the values in ManageExistingOpts.
+// To extract the Snapshot object from response, call the Extract method on the ManageExistingResult.
+func ManageExisting(ctx context.Context, client *gophercloud.ServiceClient, opts ManageExistingOptsBuilder) (r ManageExistingResult) {
+	b, err := opts.ToManageExistingMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Post(ctx, createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToManageableSnapshotListQuery() (string, error)
+}
+
+// ListOpts holds options for listing the snapshots of a backend which are not
+// managed by the Block Storage service. One of Host and Cluster is required.
+type ListOpts struct {
+	// The OpenStack Block Storage host to list the snapshots of.
+	Host string `q:"host"`
+
+	// The OpenStack Block Storage cluster to list the snapshots of.
+	// Requires microversion 3.17 or later.
+	Cluster string `q:"cluster"`
+
+	// Comma-separated list of sort keys and optional sort directions in the
+	// form of <key>[:<direction>].
+	Sort string `q:"sort"`
+
+	// Requests a page size of items.
+	Limit int `q:"limit"`
+
+	// Used in conjunction with limit to return a slice of items.
+	Offset int `q:"offset"`
+
+	// The ID of the last-seen item.
+	Marker string `q:"marker"`
+}
+
+// ToManageableSnapshotListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToManageableSnapshotListQuery() (string, error) {
+	if opts.Host == "" && opts.Cluster == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "manageablesnapshots.ListOpts.Host/manageablesnapshots.ListOpts.Cluster"
+		return "", err
+	}
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns the snapshots of a backend which can be managed. Only the
+// summary fields of each snapshot are returned; use ListDetail for the full
+// objects.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listURL(client), opts)
+}
+
+// ListDetail returns the snapshots of a backend which can be managed,
+// including the ones which are already managed and the reason they are not
+// safe to manage.
+func ListDetail(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listDetailURL(client), opts)
+}
+
+func list(client *gophercloud.ServiceClient, url string, opts ListOptsBuilder) pagination.Pager {
+	if opts != nil {
+		query, err := opts.ToManageableSnapshotListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ManageableSnapshotPage{pagination.SinglePageBase(r)}
+	})
+}
@@ -0,0 +1,80 @@
+package manageablesnapshots
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/snapshots"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+type ManageExistingResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the Snapshot object out of the ManageExistingResult object.
+func (r ManageExistingResult) Extract() (*snapshots.Snapshot, error) {
+	var s snapshots.Snapshot
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtractInto converts our response data into a snapshot struct
+func (r ManageExistingResult) ExtractInto(v any) error {
+	return r.ExtractIntoStructPtr(v, "snapshot")
+}
+
+// ManageableSnapshot is a snapshot on a backend which is, or can be, managed
+// by the Block Storage service.
+type ManageableSnapshot struct {
+	// A reference to the snapshot on the backend, to be used as
+	// ManageExistingOpts.Ref.
+	Reference map[string]string `json:"reference"`
+
+	// A reference to the volume on the backend the snapshot was taken of.
+	SourceReference map[string]string `json:"source_reference"`
+
+	// The size of the snapshot in GiB.
+	Size int `json:"size"`
+
+	// Whether the snapshot can be managed.
+	SafeToManage bool `json:"safe_to_manage"`
+
+	// The following fields are only returned by ListDetail.
+
+	// The reason the snapshot can not be managed.
+	ReasonNotSafe string `json:"reason_not_safe"`
+
+	// The ID of the snapshot if it is already managed.
+	CinderID string `json:"cinder_id"`
+
+	// Additional information about the snapshot, as reported by the volume
+	// driver.
+	ExtraInfo string `json:"extra_info"`
+}
+
+// ManageableSnapshotPage represents a single page of ManageableSnapshots from
+// a List or ListDetail request.
+type ManageableSnapshotPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a page of ManageableSnapshots contains
+// any results.
+func (page ManageableSnapshotPage) IsEmpty() (bool, error) {
+	if page.StatusCode == 204 {
+		return true, nil
+	}
+
+	snapshots, err := ExtractManageableSnapshots(page)
+	return len(snapshots) == 0, err
+}
+
+// ExtractManageableSnapshots extracts and returns ManageableSnapshots. It is
+// used while iterating over a manageablesnapshots.List or
+// manageablesnapshots.ListDetail call.
+func ExtractManageableSnapshots(r pagination.Page) ([]ManageableSnapshot, error) {
+	var s struct {
+		ManageableSnapshots []ManageableSnapshot `json:"manageable-snapshots"`
+	}
+	err := (r.(ManageableSnapshotPage)).ExtractInto(&s)
+	return s.ManageableSnapshots, err
+}
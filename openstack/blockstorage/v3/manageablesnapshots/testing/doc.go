@@ -0,0 +1,2 @@
+// manageablesnapshots unit tests
+package testing
@@ -0,0 +1,131 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	fake "github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func MockManageExistingResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/manageable_snapshots", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "snapshot": {
+        "volume_id": "23cf872b-c781-4cd4-847d-5f2ec8cbd91c",
+        "ref": {
+            "source-name": "snapshot-7e42ba41-9ae1-4e95-8c73-2b6a3e8e6c1f"
+        },
+        "name": "New Snapshot",
+        "description": "Snapshot imported from existingLV",
+        "metadata": {
+            "key1": "value1"
+        }
+    }
+}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+
+		fmt.Fprint(w, `
+{
+    "snapshot": {
+        "id": "b5ac4c8c-9a2b-4b53-9a8f-54cb5f5b6f1a",
+        "status": "creating",
+        "size": 1,
+        "created_at": "2025-03-20T11:58:05.000000",
+        "updated_at": null,
+        "name": "New Snapshot",
+        "description": "Snapshot imported from existingLV",
+        "volume_id": "23cf872b-c781-4cd4-847d-5f2ec8cbd91c",
+        "metadata": {
+            "key1": "value1"
+        }
+    }
+}
+		`)
+	})
+}
+
+func MockListResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/manageable_snapshots", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"host": "host@lvm#LVM",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `
+{
+    "manageable-snapshots": [
+        {
+            "source_reference": {
+                "source-name": "volume-7c064b34-1e4b-40bd-93ca-4ac5a973661b"
+            },
+            "safe_to_manage": true,
+            "reference": {
+                "source-name": "snapshot-7e42ba41-9ae1-4e95-8c73-2b6a3e8e6c1f"
+            },
+            "size": 1
+        }
+    ]
+}
+		`)
+	})
+}
+
+func MockListDetailResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/manageable_snapshots/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"cluster": "cluster1@lvm",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `
+{
+    "manageable-snapshots": [
+        {
+            "cinder_id": null,
+            "reason_not_safe": null,
+            "reference": {
+                "source-name": "snapshot-7e42ba41-9ae1-4e95-8c73-2b6a3e8e6c1f"
+            },
+            "source_reference": {
+                "source-name": "volume-7c064b34-1e4b-40bd-93ca-4ac5a973661b"
+            },
+            "extra_info": null,
+            "safe_to_manage": true,
+            "size": 1
+        },
+        {
+            "cinder_id": "d0c84570-a01f-4579-9789-5e9f266587cd",
+            "reason_not_safe": "already managed",
+            "reference": {
+                "source-name": "_snapshot-d0c84570-a01f-4579-9789-5e9f266587cd"
+            },
+            "source_reference": {
+                "source-name": "volume-10f51ee6-8ec5-43d4-a0a4-8f59dbb2b3fc"
+            },
+            "extra_info": null,
+            "safe_to_manage": false,
+            "size": 2
+        }
+    ]
+}
+		`)
+	})
+}
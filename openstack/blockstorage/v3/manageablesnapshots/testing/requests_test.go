@@ -0,0 +1,98 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/manageablesnapshots"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestManageExisting(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockManageExistingResponse(t, fakeServer)
+
+	options := manageablesnapshots.ManageExistingOpts{
+		VolumeID: "23cf872b-c781-4cd4-847d-5f2ec8cbd91c",
+		Ref: map[string]string{
+			"source-name": "snapshot-7e42ba41-9ae1-4e95-8c73-2b6a3e8e6c1f",
+		},
+		Name:        "New Snapshot",
+		Description: "Snapshot imported from existingLV",
+		Metadata: map[string]string{
+			"key1": "value1",
+		},
+	}
+
+	n, err := manageablesnapshots.ManageExisting(context.TODO(), client.ServiceClient(fakeServer), options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "b5ac4c8c-9a2b-4b53-9a8f-54cb5f5b6f1a", n.ID)
+	th.AssertEquals(t, "New Snapshot", n.Name)
+	th.AssertEquals(t, "23cf872b-c781-4cd4-847d-5f2ec8cbd91c", n.VolumeID)
+	th.AssertEquals(t, "creating", n.Status)
+}
+
+func TestManageExistingMissingRef(t *testing.T) {
+	options := manageablesnapshots.ManageExistingOpts{
+		VolumeID: "23cf872b-c781-4cd4-847d-5f2ec8cbd91c",
+	}
+	_, err := options.ToManageExistingMap()
+	th.AssertErr(t, err)
+}
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockListResponse(t, fakeServer)
+
+	allPages, err := manageablesnapshots.List(client.ServiceClient(fakeServer), manageablesnapshots.ListOpts{Host: "host@lvm#LVM"}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := manageablesnapshots.ExtractManageableSnapshots(allPages)
+	th.AssertNoErr(t, err)
+
+	expected := []manageablesnapshots.ManageableSnapshot{
+		{
+			Reference:       map[string]string{"source-name": "snapshot-7e42ba41-9ae1-4e95-8c73-2b6a3e8e6c1f"},
+			SourceReference: map[string]string{"source-name": "volume-7c064b34-1e4b-40bd-93ca-4ac5a973661b"},
+			Size:            1,
+			SafeToManage:    true,
+		},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestListDetail(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockListDetailResponse(t, fakeServer)
+
+	allPages, err := manageablesnapshots.ListDetail(client.ServiceClient(fakeServer), manageablesnapshots.ListOpts{Cluster: "cluster1@lvm"}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := manageablesnapshots.ExtractManageableSnapshots(allPages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(actual))
+	th.AssertEquals(t, true, actual[0].SafeToManage)
+	th.AssertEquals(t, "", actual[0].CinderID)
+	th.AssertEquals(t, false, actual[1].SafeToManage)
+	th.AssertEquals(t, "already managed", actual[1].ReasonNotSafe)
+	th.AssertEquals(t, "d0c84570-a01f-4579-9789-5e9f266587cd", actual[1].CinderID)
+	th.AssertEquals(t, 2, actual[1].Size)
+}
+
+func TestListMissingHostAndCluster(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	_, err := manageablesnapshots.List(client.ServiceClient(fakeServer), manageablesnapshots.ListOpts{}).AllPages(context.TODO())
+	th.CheckErr(t, err, &gophercloud.ErrMissingInput{})
+}
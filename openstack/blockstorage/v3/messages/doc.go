@@ -0,0 +1,42 @@
+/*
+Package messages provides access to the user messages of the OpenStack Block
+Storage service. Messages explain why an asynchronous operation failed, for
+example why a volume ended up in the error state.
+
+NOTE: Requires at least microversion 3.3. Filtering requires 3.5.
+
+Example to list the error messages of a Volume
+
+	client.Microversion = "3.5"
+
+	listOpts := messages.ListOpts{
+		ResourceType: "VOLUME",
+		ResourceUUID: "5aa119a8-d25b-45a7-8d1b-88e127885635",
+		MessageLevel: "ERROR",
+	}
+
+	allPages, err := messages.List(client, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allMessages, err := messages.ExtractMessages(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, message := range allMessages {
+		fmt.Println(message.UserMessage)
+	}
+
+Example to delete a Message
+
+	client.Microversion = "3.3"
+
+	messageID := "c506cd4b-9048-43bc-97ef-0d7dec369b42"
+	err := messages.Delete(context.TODO(), client, messageID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package messages
@@ -0,0 +1,127 @@
+package messages
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Message is a user message generated by the Block Storage service when an
+// asynchronous operation fails, e.g. when no valid host was found for a new
+// volume.
+type Message struct {
+	// Unique identifier for the message.
+	ID string `json:"id"`
+
+	// The type of the resource the message is about, e.g. VOLUME.
+	ResourceType string `json:"resource_type"`
+
+	// The ID of the resource the message is about.
+	ResourceUUID string `json:"resource_uuid"`
+
+	// The ID of the request which caused the message.
+	RequestID string `json:"request_id"`
+
+	// The ID of the event which caused the message.
+	EventID string `json:"event_id"`
+
+	// The level of the message, e.g. ERROR.
+	MessageLevel string `json:"message_level"`
+
+	// The translated, human readable message.
+	UserMessage string `json:"user_message"`
+
+	// The date when the message was created.
+	CreatedAt time.Time `json:"-"`
+
+	// The date until which the message is kept. The message may be deleted
+	// at any time afterwards.
+	GuaranteedUntil time.Time `json:"-"`
+}
+
+// UnmarshalJSON converts our JSON API response into our message struct
+func (r *Message) UnmarshalJSON(b []byte) error {
+	type tmp Message
+	var s struct {
+		tmp
+		CreatedAt       gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		GuaranteedUntil gophercloud.JSONRFC3339MilliNoZ `json:"guaranteed_until"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Message(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.GuaranteedUntil = time.Time(s.GuaranteedUntil)
+
+	return err
+}
+
+// MessagePage is a pagination.Pager that is returned from a call to the List
+// function.
+type MessagePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a MessagePage contains no Messages.
+func (r MessagePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	messages, err := ExtractMessages(r)
+	return len(messages) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (r MessagePage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"messages_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// ExtractMessages extracts and returns Messages. It is used while iterating
+// over a messages.List call.
+func ExtractMessages(r pagination.Page) ([]Message, error) {
+	var s []Message
+	err := ExtractMessagesInto(r, &s)
+	return s, err
+}
+
+// ExtractMessagesInto similar to ExtractInto but operates on a `list` of
+// messages
+func ExtractMessagesInto(r pagination.Page, v any) error {
+	return r.(MessagePage).ExtractIntoSlicePtr(v, "messages")
+}
+
+// GetResult contains the response body and error from a Get request.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract will get the Message object out of the GetResult object.
+func (r GetResult) Extract() (*Message, error) {
+	var s Message
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtractInto converts our response data into a message struct
+func (r GetResult) ExtractInto(v any) error {
+	return r.ExtractIntoStructPtr(v, "message")
+}
+
+// DeleteResult contains the response error from a Delete request.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,92 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+const messageID = "c506cd4b-9048-43bc-97ef-0d7dec369b42"
+
+func MockListResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/messages", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		th.AssertEquals(t, "VOLUME", r.Form.Get("resource_type"))
+		th.AssertEquals(t, "ERROR", r.Form.Get("message_level"))
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			fmt.Fprintf(w, `
+{
+    "messages": [
+        {
+            "id": "c506cd4b-9048-43bc-97ef-0d7dec369b42",
+            "resource_type": "VOLUME",
+            "resource_uuid": "5aa119a8-d25b-45a7-8d1b-88e127885635",
+            "request_id": "req-c1216709-afba-4703-a1a3-22eda88f2f5a",
+            "event_id": "VOLUME_000002",
+            "message_level": "ERROR",
+            "user_message": "schedule allocate volume: Could not find any available weighted backend.",
+            "created_at": "2016-12-14T18:35:52.000000",
+            "guaranteed_until": "2017-01-13T18:35:52.000000"
+        }
+    ],
+    "messages_links": [
+        {
+            "href": "%s/messages?marker=1&message_level=ERROR&resource_type=VOLUME",
+            "rel": "next"
+        }
+    ]
+}
+  `, fakeServer.Server.URL)
+		case "1":
+			fmt.Fprint(w, `{"messages": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+func MockGetResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/messages/"+messageID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `
+{
+    "message": {
+        "id": "c506cd4b-9048-43bc-97ef-0d7dec369b42",
+        "resource_type": "VOLUME",
+        "resource_uuid": "5aa119a8-d25b-45a7-8d1b-88e127885635",
+        "request_id": "req-c1216709-afba-4703-a1a3-22eda88f2f5a",
+        "event_id": "VOLUME_000002",
+        "message_level": "ERROR",
+        "user_message": "schedule allocate volume: Could not find any available weighted backend.",
+        "created_at": "2016-12-14T18:35:52.000000",
+        "guaranteed_until": "2017-01-13T18:35:52.000000"
+    }
+}
+      `)
+	})
+}
+
+func MockDeleteResponse(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/messages/"+messageID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,72 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/messages"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+var expectedMessage = messages.Message{
+	ID:              messageID,
+	ResourceType:    "VOLUME",
+	ResourceUUID:    "5aa119a8-d25b-45a7-8d1b-88e127885635",
+	RequestID:       "req-c1216709-afba-4703-a1a3-22eda88f2f5a",
+	EventID:         "VOLUME_000002",
+	MessageLevel:    "ERROR",
+	UserMessage:     "schedule allocate volume: Could not find any available weighted backend.",
+	CreatedAt:       time.Date(2016, 12, 14, 18, 35, 52, 0, time.UTC),
+	GuaranteedUntil: time.Date(2017, 1, 13, 18, 35, 52, 0, time.UTC),
+}
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockListResponse(t, fakeServer)
+
+	listOpts := messages.ListOpts{
+		ResourceType: "VOLUME",
+		MessageLevel: "ERROR",
+	}
+
+	pages := 0
+	err := messages.List(client.ServiceClient(fakeServer), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		pages++
+		actual, err := messages.ExtractMessages(page)
+		if err != nil {
+			return false, err
+		}
+
+		th.CheckDeepEquals(t, []messages.Message{expectedMessage}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, pages)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockGetResponse(t, fakeServer)
+
+	v, err := messages.Get(context.TODO(), client.ServiceClient(fakeServer), messageID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expectedMessage, *v)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	MockDeleteResponse(t, fakeServer)
+
+	res := messages.Delete(context.TODO(), client.ServiceClient(fakeServer), messageID)
+	th.AssertNoErr(t, res.Err)
+}
@@ -293,3 +293,18 @@ func ForceDelete(ctx context.Context, client *gophercloud.ServiceClient, id stri
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// Unmanage removes a snapshot from Block Storage management without removing
+// the back-end storage object that is associated with it. UnmanageResult
+// contains only the error. To extract it, call the ExtractErr method on the
+// UnmanageResult.
+func Unmanage(ctx context.Context, client *gophercloud.ServiceClient, id string) (r UnmanageResult) {
+	b := map[string]any{
+		"os-unmanage": struct{}{},
+	}
+	resp, err := client.Post(ctx, unmanageURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -45,3 +45,7 @@ func updateStatusURL(c *gophercloud.ServiceClient, id string) string {
 func forceDeleteURL(c *gophercloud.ServiceClient, id string) string {
 	return c.ServiceURL("snapshots", id, "action")
 }
+
+func unmanageURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("snapshots", id, "action")
+}
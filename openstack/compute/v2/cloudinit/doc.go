@@ -0,0 +1,75 @@
+/*
+Package cloudinit builds cloud-init user data for servers.
+
+A payload is made of an optional cloud-config document, given as a Go value
+that is marshalled to YAML, and of any number of additional parts such as
+shell scripts. A single part is sent as is, while several parts are combined
+into a multipart MIME archive that cloud-init processes in order. The payload
+can be gzip-compressed, which cloud-init detects automatically.
+
+Nova limits user data to 65535 bytes once base64-encoded. The size is checked
+when the payload is built so that an oversized payload is reported before any
+request is made.
+
+The CreateOptsExt and RebuildOptsExt types plug the user data into
+servers.Create and servers.Rebuild. The generated user data can also be
+assigned to servers.CreateOpts.UserData directly.
+
+Example to Create a Server with a Cloud-Config and a Shell Script
+
+	userData := cloudinit.UserDataOpts{
+		CloudConfig: map[string]any{
+			"package_update": true,
+			"packages":       []string{"nginx"},
+			"users": []map[string]any{
+				{
+					"name":                "deploy",
+					"ssh_authorized_keys": []string{"ssh-ed25519 AAAA..."},
+				},
+			},
+		},
+		Parts: []cloudinit.Part{
+			{
+				Type:     cloudinit.ShellScript,
+				Filename: "bootstrap.sh",
+				Content:  []byte("#!/bin/sh\nsystemctl enable --now nginx\n"),
+			},
+		},
+		Gzip: true,
+	}
+
+	configDrive := true
+	createOpts := cloudinit.CreateOptsExt{
+		CreateOptsBuilder: servers.CreateOpts{
+			Name:        "web-01",
+			ImageRef:    "f90f6034-2570-4974-8351-6b49732ef2eb",
+			FlavorRef:   "1",
+			ConfigDrive: &configDrive,
+		},
+		UserData: userData,
+	}
+
+	server, err := servers.Create(context.TODO(), computeClient, createOpts, nil).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Rebuild a Server with New User Data
+
+	computeClient.Microversion = "2.57"
+
+	rebuildOpts := cloudinit.RebuildOptsExt{
+		RebuildOptsBuilder: servers.RebuildOpts{
+			ImageRef: "f90f6034-2570-4974-8351-6b49732ef2eb",
+		},
+		UserData: cloudinit.UserDataOpts{
+			CloudConfig: map[string]any{"hostname": "web-01"},
+		},
+	}
+
+	server, err := servers.Rebuild(context.TODO(), computeClient, serverID, rebuildOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package cloudinit
@@ -0,0 +1,18 @@
+package cloudinit
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// ErrUserDataTooLarge is the error when the base64-encoded user data exceeds
+// the size accepted by Nova.
+type ErrUserDataTooLarge struct {
+	gophercloud.BaseError
+	Size int
+}
+
+func (e ErrUserDataTooLarge) Error() string {
+	return fmt.Sprintf("user data is %d bytes once base64-encoded, which exceeds the limit of %d bytes", e.Size, MaxUserDataSize)
+}
@@ -0,0 +1,310 @@
+package cloudinit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"unicode/utf8"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	"gopkg.in/yaml.v2"
+)
+
+// MaxUserDataSize is the maximum size of base64-encoded user data accepted by
+// Nova.
+const MaxUserDataSize = 65535
+
+// DefaultMergeType is the merge type applied to cloud-config parts when a
+// payload holds more than one of them. It appends lists and merges
+// dictionaries recursively instead of letting the last part win.
+const DefaultMergeType = "list(append)+dict(no_replace,recurse_list)+str()"
+
+// PartType is the MIME type of a part of a cloud-init payload.
+type PartType string
+
+const (
+	// CloudConfig is a cloud-config YAML document.
+	CloudConfig PartType = "text/cloud-config"
+
+	// ShellScript is a script run at the end of the first boot.
+	ShellScript PartType = "text/x-shellscript"
+
+	// CloudBoothook is a script run early at every boot.
+	CloudBoothook PartType = "text/cloud-boothook"
+
+	// IncludeURL is a list of URLs to fetch and process as user data.
+	IncludeURL PartType = "text/x-include-url"
+
+	// JinjaTemplate is a Jinja template rendered with the instance data.
+	JinjaTemplate PartType = "text/jinja2"
+)
+
+// Part is a part of a cloud-init payload.
+type Part struct {
+	// Type is the MIME type of the part.
+	Type PartType
+
+	// Filename is reported to cloud-init in the Content-Disposition header.
+	// It defaults to "part-N".
+	Filename string
+
+	// Content is the content of the part.
+	Content []byte
+
+	// MergeType sets the Merge-Type header of a cloud-config part. It
+	// defaults to the MergeType of the UserDataOpts.
+	MergeType string
+}
+
+// UserDataOptsBuilder allows extensions to build user data differently.
+type UserDataOptsBuilder interface {
+	ToUserData() ([]byte, error)
+}
+
+// UserDataOpts describes a cloud-init payload.
+type UserDataOpts struct {
+	// CloudConfig, if set, is marshalled to YAML and becomes the first part
+	// of the payload, with a "#cloud-config" header.
+	CloudConfig any
+
+	// Parts are added to the payload after CloudConfig, in order.
+	Parts []Part
+
+	// MergeType is the Merge-Type of cloud-config parts when the payload
+	// holds more than one part. It defaults to DefaultMergeType.
+	MergeType string
+
+	// Gzip compresses the payload.
+	Gzip bool
+
+	// Boundary, if set, is the boundary of the multipart MIME archive. It is
+	// randomly generated by default.
+	Boundary string
+}
+
+// ToUserData builds the payload and returns it base64-encoded, ready to be
+// assigned to servers.CreateOpts.UserData.
+func (opts UserDataOpts) ToUserData() ([]byte, error) {
+	parts := make([]Part, 0, len(opts.Parts)+1)
+
+	if opts.CloudConfig != nil {
+		b, err := yaml.Marshal(opts.CloudConfig)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, Part{
+			Type:     CloudConfig,
+			Filename: "cloud-config.yaml",
+			Content:  append([]byte("#cloud-config\n"), b...),
+		})
+	}
+	parts = append(parts, opts.Parts...)
+
+	if len(parts) == 0 {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "cloudinit.UserDataOpts.CloudConfig"
+		err.Info = "Either CloudConfig or Parts must be provided"
+		return nil, err
+	}
+
+	var payload []byte
+	if len(parts) == 1 {
+		payload = parts[0].Content
+	} else {
+		var err error
+		payload, err = opts.multipart(parts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Gzip {
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		if _, err := w.Write(payload); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		payload = buf.Bytes()
+	}
+
+	if size := base64.StdEncoding.EncodedLen(len(payload)); size > MaxUserDataSize {
+		return nil, ErrUserDataTooLarge{Size: size}
+	}
+
+	encoded := make([]byte, base64.StdEncoding.EncodedLen(len(payload)))
+	base64.StdEncoding.Encode(encoded, payload)
+	return encoded, nil
+}
+
+// multipart combines parts into a multipart MIME archive.
+func (opts UserDataOpts) multipart(parts []Part) ([]byte, error) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	if opts.Boundary != "" {
+		if err := w.SetBoundary(opts.Boundary); err != nil {
+			return nil, err
+		}
+	}
+
+	mergeType := opts.MergeType
+	if mergeType == "" {
+		mergeType = DefaultMergeType
+	}
+
+	for i, part := range parts {
+		if part.Type == "" {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = fmt.Sprintf("cloudinit.UserDataOpts.Parts[%d].Type", i)
+			return nil, err
+		}
+
+		filename := part.Filename
+		if filename == "" {
+			filename = fmt.Sprintf("part-%d", i+1)
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", fmt.Sprintf("%s; charset=\"utf-8\"", part.Type))
+		header.Set("MIME-Version", "1.0")
+		encoding := transferEncoding(part.Content)
+		header.Set("Content-Transfer-Encoding", encoding)
+		header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+
+		if part.Type == CloudConfig {
+			if part.MergeType != "" {
+				header.Set("Merge-Type", part.MergeType)
+			} else {
+				header.Set("Merge-Type", mergeType)
+			}
+		}
+
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if encoding == "base64" {
+			err = writeBase64(pw, part.Content)
+		} else {
+			_, err = pw.Write(part.Content)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	var archive bytes.Buffer
+	fmt.Fprintf(&archive, "Content-Type: multipart/mixed; boundary=%q\r\n", w.Boundary())
+	archive.WriteString("MIME-Version: 1.0\r\n\r\n")
+	archive.Write(body.Bytes())
+	return archive.Bytes(), nil
+}
+
+// maxLineLength is the maximum length of a line, without its line break,
+// in a part with the 7bit or 8bit transfer encoding.
+const maxLineLength = 998
+
+// transferEncoding returns the Content-Transfer-Encoding of a part: 7bit
+// for ASCII text, 8bit for other UTF-8 text and base64 for anything else,
+// such as binary content or text with overlong lines.
+func transferEncoding(content []byte) string {
+	if !utf8.Valid(content) || bytes.IndexByte(content, 0) >= 0 {
+		return "base64"
+	}
+
+	for line := range bytes.Lines(content) {
+		if len(bytes.TrimRight(line, "\r\n")) > maxLineLength {
+			return "base64"
+		}
+	}
+
+	for _, c := range content {
+		if c >= utf8.RuneSelf {
+			return "8bit"
+		}
+	}
+
+	return "7bit"
+}
+
+// writeBase64 writes content encoded in base64, in lines of 76 characters.
+func writeBase64(w io.Writer, content []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(content)
+	for len(encoded) > 0 {
+		n := min(len(encoded), 76)
+		if _, err := io.WriteString(w, encoded[:n]+"\r\n"); err != nil {
+			return err
+		}
+		encoded = encoded[n:]
+	}
+	return nil
+}
+
+// CreateOptsExt adds cloud-init user data to the options of servers.Create.
+type CreateOptsExt struct {
+	servers.CreateOptsBuilder
+
+	// UserData is the cloud-init payload of the server.
+	UserData UserDataOptsBuilder
+}
+
+// ToServerCreateMap adds the user data to the base create options.
+func (opts CreateOptsExt) ToServerCreateMap() (map[string]any, error) {
+	base, err := opts.CreateOptsBuilder.ToServerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	server := base["server"].(map[string]any)
+
+	if opts.UserData != nil {
+		userData, err := opts.UserData.ToUserData()
+		if err != nil {
+			return nil, err
+		}
+		server["user_data"] = string(userData)
+	}
+
+	return base, nil
+}
+
+// RebuildOptsExt adds cloud-init user data to the options of
+// servers.Rebuild.
+// This requires microversion 2.57 or later.
+type RebuildOptsExt struct {
+	servers.RebuildOptsBuilder
+
+	// UserData is the new cloud-init payload of the server.
+	UserData UserDataOptsBuilder
+}
+
+// ToServerRebuildMap adds the user data to the base rebuild options.
+func (opts RebuildOptsExt) ToServerRebuildMap() (map[string]any, error) {
+	base, err := opts.RebuildOptsBuilder.ToServerRebuildMap()
+	if err != nil {
+		return nil, err
+	}
+
+	rebuild := base["rebuild"].(map[string]any)
+
+	if opts.UserData != nil {
+		userData, err := opts.UserData.ToUserData()
+		if err != nil {
+			return nil, err
+		}
+		rebuild["user_data"] = string(userData)
+	}
+
+	return base, nil
+}
@@ -0,0 +1,215 @@
+package testing
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/cloudinit"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const expectedMultipart = "Content-Type: multipart/mixed; boundary=\"BOUNDARY\"\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"\r\n" +
+	"--BOUNDARY\r\n" +
+	"Content-Disposition: attachment; filename=\"cloud-config.yaml\"\r\n" +
+	"Content-Transfer-Encoding: 7bit\r\n" +
+	"Content-Type: text/cloud-config; charset=\"utf-8\"\r\n" +
+	"Merge-Type: list(append)+dict(no_replace,recurse_list)+str()\r\n" +
+	"Mime-Version: 1.0\r\n" +
+	"\r\n" +
+	"#cloud-config\n" +
+	"packages:\n" +
+	"- nginx\n" +
+	"\r\n" +
+	"--BOUNDARY\r\n" +
+	"Content-Disposition: attachment; filename=\"part-2\"\r\n" +
+	"Content-Transfer-Encoding: 7bit\r\n" +
+	"Content-Type: text/x-shellscript; charset=\"utf-8\"\r\n" +
+	"Mime-Version: 1.0\r\n" +
+	"\r\n" +
+	"#!/bin/sh\n" +
+	"echo hello\n" +
+	"\r\n" +
+	"--BOUNDARY--\r\n"
+
+func decode(t *testing.T, userData []byte) string {
+	t.Helper()
+
+	b, err := base64.StdEncoding.DecodeString(string(userData))
+	th.AssertNoErr(t, err)
+	return string(b)
+}
+
+func TestCloudConfigOnly(t *testing.T) {
+	userData, err := cloudinit.UserDataOpts{
+		CloudConfig: map[string]any{
+			"packages": []string{"nginx"},
+		},
+	}.ToUserData()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "#cloud-config\npackages:\n- nginx\n", decode(t, userData))
+}
+
+func TestSinglePart(t *testing.T) {
+	userData, err := cloudinit.UserDataOpts{
+		Parts: []cloudinit.Part{
+			{Type: cloudinit.ShellScript, Content: []byte("#!/bin/sh\necho hello\n")},
+		},
+	}.ToUserData()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "#!/bin/sh\necho hello\n", decode(t, userData))
+}
+
+func TestMultipart(t *testing.T) {
+	userData, err := cloudinit.UserDataOpts{
+		CloudConfig: map[string]any{
+			"packages": []string{"nginx"},
+		},
+		Parts: []cloudinit.Part{
+			{Type: cloudinit.ShellScript, Content: []byte("#!/bin/sh\necho hello\n")},
+		},
+		Boundary: "BOUNDARY",
+	}.ToUserData()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, expectedMultipart, decode(t, userData))
+}
+
+func TestMultipartTransferEncoding(t *testing.T) {
+	userData, err := cloudinit.UserDataOpts{
+		Parts: []cloudinit.Part{
+			{Type: cloudinit.ShellScript, Content: []byte("#!/bin/sh\necho héllo\n")},
+			{Type: "application/octet-stream", Filename: "blob", Content: []byte{0x1f, 0x8b, 0x00, 0xff}},
+		},
+		Boundary: "BOUNDARY",
+	}.ToUserData()
+	th.AssertNoErr(t, err)
+
+	expected := "Content-Type: multipart/mixed; boundary=\"BOUNDARY\"\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"\r\n" +
+		"--BOUNDARY\r\n" +
+		"Content-Disposition: attachment; filename=\"part-1\"\r\n" +
+		"Content-Transfer-Encoding: 8bit\r\n" +
+		"Content-Type: text/x-shellscript; charset=\"utf-8\"\r\n" +
+		"Mime-Version: 1.0\r\n" +
+		"\r\n" +
+		"#!/bin/sh\n" +
+		"echo héllo\n" +
+		"\r\n" +
+		"--BOUNDARY\r\n" +
+		"Content-Disposition: attachment; filename=\"blob\"\r\n" +
+		"Content-Transfer-Encoding: base64\r\n" +
+		"Content-Type: application/octet-stream; charset=\"utf-8\"\r\n" +
+		"Mime-Version: 1.0\r\n" +
+		"\r\n" +
+		"H4sA/w==\r\n" +
+		"\r\n" +
+		"--BOUNDARY--\r\n"
+	th.AssertEquals(t, expected, decode(t, userData))
+}
+
+func TestGzip(t *testing.T) {
+	userData, err := cloudinit.UserDataOpts{
+		CloudConfig: map[string]any{
+			"packages": []string{"nginx"},
+		},
+		Parts: []cloudinit.Part{
+			{Type: cloudinit.ShellScript, Content: []byte("#!/bin/sh\necho hello\n")},
+		},
+		Boundary: "BOUNDARY",
+		Gzip:     true,
+	}.ToUserData()
+	th.AssertNoErr(t, err)
+
+	r, err := gzip.NewReader(strings.NewReader(decode(t, userData)))
+	th.AssertNoErr(t, err)
+	b, err := io.ReadAll(r)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, expectedMultipart, string(b))
+}
+
+func TestTooLarge(t *testing.T) {
+	_, err := cloudinit.UserDataOpts{
+		Parts: []cloudinit.Part{
+			{Type: cloudinit.ShellScript, Content: bytes.Repeat([]byte("a"), 50000)},
+		},
+	}.ToUserData()
+
+	var tooLarge cloudinit.ErrUserDataTooLarge
+	th.AssertEquals(t, true, errors.As(err, &tooLarge))
+	th.AssertEquals(t, 66668, tooLarge.Size)
+
+	// The same content compresses well below the limit.
+	_, err = cloudinit.UserDataOpts{
+		Parts: []cloudinit.Part{
+			{Type: cloudinit.ShellScript, Content: bytes.Repeat([]byte("a"), 50000)},
+		},
+		Gzip: true,
+	}.ToUserData()
+	th.AssertNoErr(t, err)
+}
+
+func TestEmpty(t *testing.T) {
+	_, err := cloudinit.UserDataOpts{}.ToUserData()
+	th.AssertErr(t, err)
+}
+
+func TestCreateOptsExt(t *testing.T) {
+	configDrive := true
+	createOpts := cloudinit.CreateOptsExt{
+		CreateOptsBuilder: servers.CreateOpts{
+			Name:        "web-01",
+			ImageRef:    "f90f6034-2570-4974-8351-6b49732ef2eb",
+			FlavorRef:   "1",
+			ConfigDrive: &configDrive,
+		},
+		UserData: cloudinit.UserDataOpts{
+			CloudConfig: map[string]any{"hostname": "web-01"},
+		},
+	}
+
+	expected := `
+	{
+		"server": {
+			"name": "web-01",
+			"imageRef": "f90f6034-2570-4974-8351-6b49732ef2eb",
+			"flavorRef": "1",
+			"user_data": "I2Nsb3VkLWNvbmZpZwpob3N0bmFtZTogd2ViLTAxCg==",
+			"config_drive": true
+		}
+	}`
+
+	actual, err := createOpts.ToServerCreateMap()
+	th.AssertNoErr(t, err)
+	th.CheckJSONEquals(t, expected, actual)
+}
+
+func TestRebuildOptsExt(t *testing.T) {
+	rebuildOpts := cloudinit.RebuildOptsExt{
+		RebuildOptsBuilder: servers.RebuildOpts{
+			ImageRef: "f90f6034-2570-4974-8351-6b49732ef2eb",
+		},
+		UserData: cloudinit.UserDataOpts{
+			CloudConfig: map[string]any{"hostname": "web-01"},
+		},
+	}
+
+	expected := `
+	{
+		"rebuild": {
+			"imageRef": "f90f6034-2570-4974-8351-6b49732ef2eb",
+			"user_data": "I2Nsb3VkLWNvbmZpZwpob3N0bmFtZTogd2ViLTAxCg=="
+		}
+	}`
+
+	actual, err := rebuildOpts.ToServerRebuildMap()
+	th.AssertNoErr(t, err)
+	th.CheckJSONEquals(t, expected, actual)
+}
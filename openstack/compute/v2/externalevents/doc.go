@@ -0,0 +1,39 @@
+/*
+Package externalevents provides the ability to send external events to
+servers through the os-server-external-events API.
+
+This API is meant to be used by other services, such as Neutron and Cinder,
+to notify Nova of changes to the resources attached to servers. It is
+restricted to administrators by default.
+
+Example to Send External Events
+
+	computeClient.Microversion = "2.76"
+
+	createOpts := externalevents.CreateOpts{
+		Events: []externalevents.Event{
+			{
+				Name:       externalevents.NetworkVIFPlugged,
+				ServerUUID: "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+				Tag:        "0e8d6b4f-1a7c-4b5e-9a5d-2f6c3b1e4d7a",
+			},
+			{
+				Name:       externalevents.PowerUpdate,
+				ServerUUID: "7c6a2b9f-5b1a-4b2e-9a3f-2f8c7d6e5a40",
+				Tag:        "POWER_OFF",
+			},
+		},
+	}
+
+	events, err := externalevents.Create(context.TODO(), computeClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, event := range events {
+		if event.Code != 200 {
+			fmt.Printf("event %s for server %s failed with code %d\n", event.Name, event.ServerUUID, event.Code)
+		}
+	}
+*/
+package externalevents
@@ -0,0 +1,102 @@
+package externalevents
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// EventName is the name of an external event.
+type EventName string
+
+const (
+	// NetworkChanged notifies that the network of a port changed.
+	NetworkChanged EventName = "network-changed"
+
+	// NetworkVIFPlugged notifies that a port was plugged.
+	NetworkVIFPlugged EventName = "network-vif-plugged"
+
+	// NetworkVIFUnplugged notifies that a port was unplugged.
+	NetworkVIFUnplugged EventName = "network-vif-unplugged"
+
+	// NetworkVIFDeleted notifies that a port was deleted.
+	NetworkVIFDeleted EventName = "network-vif-deleted"
+
+	// VolumeExtended notifies that an attached volume was extended. The tag
+	// of the event is the ID of the volume.
+	// This requires microversion 2.51 or later.
+	VolumeExtended EventName = "volume-extended"
+
+	// PowerUpdate notifies that the power state of a server changed outside
+	// of Nova. The tag of the event is either "POWER_ON" or "POWER_OFF".
+	// This requires microversion 2.76 or later.
+	PowerUpdate EventName = "power-update"
+
+	// AcceleratorRequestBound notifies that an accelerator request was
+	// bound. The tag of the event is the UUID of the accelerator request.
+	// This requires microversion 2.82 or later.
+	AcceleratorRequestBound EventName = "accelerator-request-bound"
+
+	// VolumeReimaged notifies that a volume was reimaged. The tag of the
+	// event is the ID of the volume.
+	// This requires microversion 2.93 or later.
+	VolumeReimaged EventName = "volume-reimaged"
+)
+
+// EventStatus is the status of an external event.
+type EventStatus string
+
+const (
+	EventCompleted  EventStatus = "completed"
+	EventFailed     EventStatus = "failed"
+	EventInProgress EventStatus = "in-progress"
+)
+
+// Event is an external event sent to a server.
+type Event struct {
+	// Name is the name of the event.
+	Name EventName `json:"name" required:"true"`
+
+	// ServerUUID is the UUID of the server the event relates to.
+	ServerUUID string `json:"server_uuid" required:"true"`
+
+	// Status is the status of the event. Nova defaults to "completed".
+	Status EventStatus `json:"status,omitempty"`
+
+	// Tag identifies the resource the event relates to, such as the ID of a
+	// port or of a volume.
+	Tag string `json:"tag,omitempty"`
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToExternalEventsCreateMap() (map[string]any, error)
+}
+
+// CreateOpts specifies the events to send.
+type CreateOpts struct {
+	// Events is the list of events to send.
+	Events []Event `json:"events" required:"true"`
+}
+
+// ToExternalEventsCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToExternalEventsCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create sends external events to servers. Nova answers with a 207 status
+// code when some of the events could not be processed; the code of each
+// event is available in the result.
+func Create(ctx context.Context, client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToExternalEventsCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 207},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,40 @@
+package externalevents
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// EventResult is the outcome of an external event.
+type EventResult struct {
+	// Code is the HTTP status code of the event: 200 when the event was
+	// processed, 400 when it was invalid, 404 when the server was not found
+	// and 422 when the server is not assigned to a host.
+	Code int `json:"code"`
+
+	// Name is the name of the event.
+	Name EventName `json:"name"`
+
+	// ServerUUID is the UUID of the server the event relates to.
+	ServerUUID string `json:"server_uuid"`
+
+	// Status is the status of the event.
+	Status EventStatus `json:"status"`
+
+	// Tag identifies the resource the event relates to.
+	Tag string `json:"tag"`
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a slice of EventResult.
+type CreateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a CreateResult as a slice of EventResult.
+func (r CreateResult) Extract() ([]EventResult, error) {
+	var s struct {
+		Events []EventResult `json:"events"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Events, err
+}
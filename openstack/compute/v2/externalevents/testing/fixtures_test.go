@@ -0,0 +1,103 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/externalevents"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// CreateRequest is the expected body of a Create request.
+const CreateRequest = `
+{
+    "events": [
+        {
+            "name": "network-vif-plugged",
+            "server_uuid": "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+            "tag": "0e8d6b4f-1a7c-4b5e-9a5d-2f6c3b1e4d7a"
+        },
+        {
+            "name": "volume-extended",
+            "server_uuid": "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+            "status": "completed",
+            "tag": "8c1a3b5d-7e9f-4a2b-8c6d-0e1f2a3b4c5d"
+        },
+        {
+            "name": "power-update",
+            "server_uuid": "7c6a2b9f-5b1a-4b2e-9a3f-2f8c7d6e5a40",
+            "tag": "POWER_OFF"
+        }
+    ]
+}
+`
+
+// CreateResponse is a sample partial success response to a Create request.
+const CreateResponse = `
+{
+    "events": [
+        {
+            "code": 200,
+            "name": "network-vif-plugged",
+            "server_uuid": "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+            "status": "completed",
+            "tag": "0e8d6b4f-1a7c-4b5e-9a5d-2f6c3b1e4d7a"
+        },
+        {
+            "code": 200,
+            "name": "volume-extended",
+            "server_uuid": "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+            "status": "completed",
+            "tag": "8c1a3b5d-7e9f-4a2b-8c6d-0e1f2a3b4c5d"
+        },
+        {
+            "code": 404,
+            "name": "power-update",
+            "server_uuid": "7c6a2b9f-5b1a-4b2e-9a3f-2f8c7d6e5a40",
+            "status": "failed",
+            "tag": "POWER_OFF"
+        }
+    ]
+}
+`
+
+// ExpectedEvents is the result of CreateResponse.
+var ExpectedEvents = []externalevents.EventResult{
+	{
+		Code:       200,
+		Name:       externalevents.NetworkVIFPlugged,
+		ServerUUID: "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+		Status:     externalevents.EventCompleted,
+		Tag:        "0e8d6b4f-1a7c-4b5e-9a5d-2f6c3b1e4d7a",
+	},
+	{
+		Code:       200,
+		Name:       externalevents.VolumeExtended,
+		ServerUUID: "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+		Status:     externalevents.EventCompleted,
+		Tag:        "8c1a3b5d-7e9f-4a2b-8c6d-0e1f2a3b4c5d",
+	},
+	{
+		Code:       404,
+		Name:       externalevents.PowerUpdate,
+		ServerUUID: "7c6a2b9f-5b1a-4b2e-9a3f-2f8c7d6e5a40",
+		Status:     externalevents.EventFailed,
+		Tag:        "POWER_OFF",
+	},
+}
+
+// HandleCreateSuccessfully sets up the test server to respond to a Create
+// request with a partial success.
+func HandleCreateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/os-server-external-events", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusMultiStatus)
+		fmt.Fprint(w, CreateResponse)
+	})
+}
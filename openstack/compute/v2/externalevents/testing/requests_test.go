@@ -0,0 +1,52 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/externalevents"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleCreateSuccessfully(t, fakeServer)
+
+	createOpts := externalevents.CreateOpts{
+		Events: []externalevents.Event{
+			{
+				Name:       externalevents.NetworkVIFPlugged,
+				ServerUUID: "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+				Tag:        "0e8d6b4f-1a7c-4b5e-9a5d-2f6c3b1e4d7a",
+			},
+			{
+				Name:       externalevents.VolumeExtended,
+				ServerUUID: "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+				Status:     externalevents.EventCompleted,
+				Tag:        "8c1a3b5d-7e9f-4a2b-8c6d-0e1f2a3b4c5d",
+			},
+			{
+				Name:       externalevents.PowerUpdate,
+				ServerUUID: "7c6a2b9f-5b1a-4b2e-9a3f-2f8c7d6e5a40",
+				Tag:        "POWER_OFF",
+			},
+		},
+	}
+
+	actual, err := externalevents.Create(context.TODO(), client.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedEvents, actual)
+}
+
+func TestCreateMissingServerUUID(t *testing.T) {
+	createOpts := externalevents.CreateOpts{
+		Events: []externalevents.Event{
+			{Name: externalevents.NetworkChanged},
+		},
+	}
+
+	_, err := createOpts.ToExternalEventsCreateMap()
+	th.AssertErr(t, err)
+}
@@ -0,0 +1,53 @@
+/*
+Package hostdrain provides a helper to evacuate every instance from a compute
+host, for example before maintenance.
+
+Drain disables the nova-compute service of the host with a reason so that the
+scheduler stops placing new instances on it, lists the instances running on
+the host's hypervisors and moves each of them away according to a policy
+(live migration, cold migration or evacuation) with bounded concurrency. The
+outcome of every instance is tracked and progress is reported through an
+optional callback.
+
+Drain relies on the services, hypervisors and servers packages and requires
+a client using Compute API microversion 2.53 or later, as well as admin
+credentials to see the host of each instance.
+
+Example to Drain a Host
+
+	computeClient.Microversion = "2.53"
+
+	drainOpts := hostdrain.DrainOpts{
+		Host:            "compute-01",
+		DisabledReason:  "maintenance: kernel upgrade",
+		Policy:          hostdrain.PolicyLiveMigrate,
+		Concurrency:     2,
+		InstanceTimeout: 30 * time.Minute,
+		OnEvent: func(event hostdrain.Event) {
+			log.Printf("%s %s: %v", event.Type, event.ServerID, event.Err)
+		},
+	}
+
+	result, err := hostdrain.Drain(context.TODO(), computeClient, drainOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, instance := range result.Failed() {
+		fmt.Printf("%s (%s) is still on %s: %v\n", instance.Name, instance.ServerID, drainOpts.Host, instance.Err)
+	}
+
+Example to Cold Migrate Stopped Instances and Live Migrate the Others
+
+	drainOpts := hostdrain.DrainOpts{
+		Host:           "compute-01",
+		DisabledReason: "maintenance",
+		PolicyFunc: func(server *servers.Server) hostdrain.Policy {
+			if server.Status == "SHUTOFF" {
+				return hostdrain.PolicyColdMigrate
+			}
+			return hostdrain.PolicyLiveMigrate
+		},
+	}
+*/
+package hostdrain
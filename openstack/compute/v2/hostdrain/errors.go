@@ -0,0 +1,43 @@
+package hostdrain
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// ErrServiceNotFound is the error when no nova-compute service runs on the
+// host to drain.
+type ErrServiceNotFound struct {
+	gophercloud.BaseError
+	Host string
+}
+
+func (e ErrServiceNotFound) Error() string {
+	return fmt.Sprintf("no nova-compute service found on host %q", e.Host)
+}
+
+// ErrInstanceNotMoved is the error when a migration finished but the
+// instance is still on the drained host, which is how Nova reports most
+// failed live migrations.
+type ErrInstanceNotMoved struct {
+	gophercloud.BaseError
+	ServerID string
+	Host     string
+}
+
+func (e ErrInstanceNotMoved) Error() string {
+	return fmt.Sprintf("server %s is still on host %q after migration", e.ServerID, e.Host)
+}
+
+// ErrInstanceError is the error when an instance went into the ERROR status
+// while being moved.
+type ErrInstanceError struct {
+	gophercloud.BaseError
+	ServerID string
+	Fault    string
+}
+
+func (e ErrInstanceError) Error() string {
+	return fmt.Sprintf("server %s went into ERROR status: %s", e.ServerID, e.Fault)
+}
@@ -0,0 +1,344 @@
+package hostdrain
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/hypervisors"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/services"
+)
+
+// Policy describes how an instance is moved away from the drained host.
+type Policy string
+
+const (
+	// PolicyLiveMigrate live migrates the instance. This is the default.
+	PolicyLiveMigrate Policy = "live-migrate"
+
+	// PolicyColdMigrate migrates the instance and confirms the resulting
+	// resize.
+	PolicyColdMigrate Policy = "cold-migrate"
+
+	// PolicyEvacuate rebuilds the instance on another host. This requires
+	// the compute service of the drained host to be down.
+	PolicyEvacuate Policy = "evacuate"
+
+	// PolicySkip leaves the instance on the host.
+	PolicySkip Policy = "skip"
+)
+
+// DefaultInstanceTimeout is the time spent moving each instance when
+// DrainOpts.InstanceTimeout is not set.
+const DefaultInstanceTimeout = time.Hour
+
+// DrainOpts specifies how a host is drained.
+type DrainOpts struct {
+	// Host is the name of the compute host to drain. It is required.
+	Host string
+
+	// DisabledReason is recorded on the compute service when it is
+	// disabled.
+	DisabledReason string
+
+	// Policy is applied to every instance unless PolicyFunc is set. It
+	// defaults to PolicyLiveMigrate.
+	Policy Policy
+
+	// PolicyFunc, if set, chooses the policy of each instance. It is called
+	// with the current state of the instance.
+	PolicyFunc func(server *servers.Server) Policy
+
+	// TargetHost is the host to move instances to with PolicyLiveMigrate and
+	// PolicyEvacuate. If empty, the scheduler chooses a host.
+	TargetHost string
+
+	// BlockMigration is passed to live migrations.
+	BlockMigration *bool
+
+	// Concurrency is the maximum number of instances moved at the same
+	// time. It defaults to 1.
+	Concurrency int
+
+	// InstanceTimeout bounds the time spent moving each instance, so that an
+	// instance stuck in an intermediate state fails instead of blocking the
+	// drain. It defaults to DefaultInstanceTimeout.
+	InstanceTimeout time.Duration
+
+	// OnEvent, if set, is called to report progress. Calls are serialized.
+	OnEvent func(event Event)
+}
+
+// Drain disables the compute service of a host and moves every instance away
+// from it. The returned error only reports failures to prepare the drain;
+// the outcome of each instance is recorded in the DrainResult.
+func Drain(ctx context.Context, client *gophercloud.ServiceClient, opts DrainOpts) (*DrainResult, error) {
+	if opts.Host == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "hostdrain.DrainOpts.Host"
+		return nil, err
+	}
+
+	service, err := findService(ctx, client, opts.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &drainer{client: client, opts: opts}
+
+	updateOpts := services.UpdateOpts{
+		Status:         services.ServiceDisabled,
+		DisabledReason: opts.DisabledReason,
+	}
+	if _, err := services.Update(ctx, client, service.ID, updateOpts).Extract(); err != nil {
+		return nil, err
+	}
+	d.emit(Event{Type: EventServiceDisabled})
+
+	instances, err := listInstances(ctx, client, opts.Host)
+	if err != nil {
+		return nil, err
+	}
+	d.total = len(instances)
+
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]InstanceResult, len(instances))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, instance := range instances {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results[i] = d.drainInstance(ctx, instance)
+		}()
+	}
+	wg.Wait()
+
+	return &DrainResult{
+		ServiceID: service.ID,
+		Instances: results,
+	}, nil
+}
+
+// findService returns the nova-compute service running on host.
+func findService(ctx context.Context, client *gophercloud.ServiceClient, host string) (*services.Service, error) {
+	listOpts := services.ListOpts{
+		Binary: "nova-compute",
+		Host:   host,
+	}
+
+	allPages, err := services.List(client, listOpts).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allServices, err := services.ExtractServices(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, service := range allServices {
+		if service.Host == host {
+			return &service, nil
+		}
+	}
+
+	return nil, ErrServiceNotFound{Host: host}
+}
+
+// listInstances returns the instances of every hypervisor of host.
+func listInstances(ctx context.Context, client *gophercloud.ServiceClient, host string) ([]hypervisors.Server, error) {
+	withServers := true
+	allPages, err := hypervisors.List(client, hypervisors.ListOpts{WithServers: &withServers}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allHypervisors, err := hypervisors.ExtractHypervisors(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var instances []hypervisors.Server
+	seen := make(map[string]struct{})
+	for _, hypervisor := range allHypervisors {
+		if hypervisor.Service.Host != host || hypervisor.Servers == nil {
+			continue
+		}
+
+		for _, instance := range *hypervisor.Servers {
+			if _, ok := seen[instance.UUID]; ok {
+				continue
+			}
+			seen[instance.UUID] = struct{}{}
+			instances = append(instances, instance)
+		}
+	}
+
+	return instances, nil
+}
+
+// drainer holds the state shared by the goroutines of a Drain call.
+type drainer struct {
+	client *gophercloud.ServiceClient
+	opts   DrainOpts
+
+	mu    sync.Mutex
+	total int
+	done  int
+}
+
+// emit reports an event to OnEvent, filling in the fields common to all
+// events.
+func (d *drainer) emit(event Event) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	switch event.Type {
+	case EventInstanceSucceeded, EventInstanceFailed, EventInstanceSkipped:
+		d.done++
+	}
+
+	if d.opts.OnEvent == nil {
+		return
+	}
+
+	event.Host = d.opts.Host
+	event.Done = d.done
+	event.Total = d.total
+	d.opts.OnEvent(event)
+}
+
+func (d *drainer) drainInstance(ctx context.Context, instance hypervisors.Server) InstanceResult {
+	result := InstanceResult{
+		ServerID: instance.UUID,
+		Name:     instance.Name,
+		Policy:   d.opts.Policy,
+	}
+	if result.Policy == "" {
+		result.Policy = PolicyLiveMigrate
+	}
+
+	fail := func(err error) InstanceResult {
+		result.Outcome = OutcomeFailed
+		result.Err = err
+		d.emit(Event{Type: EventInstanceFailed, ServerID: result.ServerID, Policy: result.Policy, Err: err})
+		return result
+	}
+
+	if err := ctx.Err(); err != nil {
+		return fail(err)
+	}
+
+	timeout := d.opts.InstanceTimeout
+	if timeout <= 0 {
+		timeout = DefaultInstanceTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	server, err := servers.Get(ctx, d.client, instance.UUID).Extract()
+	if err != nil {
+		return fail(err)
+	}
+
+	if d.opts.PolicyFunc != nil {
+		result.Policy = d.opts.PolicyFunc(server)
+	}
+
+	if result.Policy == PolicySkip {
+		result.Outcome = OutcomeSkipped
+		d.emit(Event{Type: EventInstanceSkipped, ServerID: result.ServerID, Policy: result.Policy})
+		return result
+	}
+
+	d.emit(Event{Type: EventInstanceStarted, ServerID: result.ServerID, Policy: result.Policy})
+
+	if err := d.startMove(ctx, server.ID, result.Policy); err != nil {
+		return fail(err)
+	}
+
+	result.DestinationHost, err = d.waitForMove(ctx, server.ID, result.Policy)
+	if err != nil {
+		return fail(err)
+	}
+
+	result.Outcome = OutcomeSucceeded
+	d.emit(Event{Type: EventInstanceSucceeded, ServerID: result.ServerID, Policy: result.Policy})
+	return result
+}
+
+// startMove requests the migration of an instance according to policy.
+func (d *drainer) startMove(ctx context.Context, id string, policy Policy) error {
+	switch policy {
+	case PolicyLiveMigrate:
+		liveMigrateOpts := servers.LiveMigrateOpts{
+			BlockMigration: d.opts.BlockMigration,
+		}
+		if d.opts.TargetHost != "" {
+			liveMigrateOpts.Host = &d.opts.TargetHost
+		}
+		return servers.LiveMigrate(ctx, d.client, id, liveMigrateOpts).ExtractErr()
+	case PolicyColdMigrate:
+		return servers.Migrate(ctx, d.client, id).ExtractErr()
+	case PolicyEvacuate:
+		evacuateOpts := servers.EvacuateOpts{
+			Host: d.opts.TargetHost,
+		}
+		return servers.Evacuate(ctx, d.client, id, evacuateOpts).Err
+	default:
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "hostdrain.DrainOpts.Policy"
+		err.Value = policy
+		return err
+	}
+}
+
+// waitForMove polls an instance until its migration is over and returns the
+// host it ended on. Cold migrations are confirmed once the instance reaches
+// the VERIFY_RESIZE status.
+func (d *drainer) waitForMove(ctx context.Context, id string, policy Policy) (string, error) {
+	var host string
+	var confirmed bool
+	err := gophercloud.WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		server, err := servers.Get(ctx, d.client, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if server.Status == "ERROR" {
+			return false, ErrInstanceError{ServerID: id, Fault: server.Fault.Message}
+		}
+
+		if server.TaskState != "" {
+			return false, nil
+		}
+
+		if policy == PolicyColdMigrate && server.Status == "VERIFY_RESIZE" {
+			if confirmed {
+				return false, nil
+			}
+			confirmed = true
+			return false, servers.ConfirmResize(ctx, d.client, id).ExtractErr()
+		}
+
+		if server.Host == d.opts.Host {
+			return false, ErrInstanceNotMoved{ServerID: id, Host: d.opts.Host}
+		}
+
+		host = server.Host
+		return true, nil
+	})
+
+	return host, err
+}
@@ -0,0 +1,121 @@
+package hostdrain
+
+// EventType describes the kind of progress reported by an Event.
+type EventType string
+
+const (
+	// EventServiceDisabled is emitted once the compute service of the host
+	// has been disabled.
+	EventServiceDisabled EventType = "service-disabled"
+
+	// EventInstanceStarted is emitted when an instance starts being moved.
+	EventInstanceStarted EventType = "instance-started"
+
+	// EventInstanceSucceeded is emitted when an instance has left the host.
+	EventInstanceSucceeded EventType = "instance-succeeded"
+
+	// EventInstanceFailed is emitted when an instance could not be moved.
+	EventInstanceFailed EventType = "instance-failed"
+
+	// EventInstanceSkipped is emitted when the policy of an instance is
+	// PolicySkip.
+	EventInstanceSkipped EventType = "instance-skipped"
+)
+
+// Event reports the progress of a Drain call.
+type Event struct {
+	// Type is the kind of event.
+	Type EventType
+
+	// Host is the host being drained.
+	Host string
+
+	// ServerID is the ID of the instance the event relates to. It is empty
+	// for EventServiceDisabled.
+	ServerID string
+
+	// Policy is the policy applied to the instance.
+	Policy Policy
+
+	// Err is the reason of an EventInstanceFailed event.
+	Err error
+
+	// Done is the number of instances that have been processed so far,
+	// whatever their outcome.
+	Done int
+
+	// Total is the number of instances found on the host.
+	Total int
+}
+
+// Outcome is the final state of an instance after a Drain call.
+type Outcome string
+
+const (
+	// OutcomeSucceeded means that the instance has left the host.
+	OutcomeSucceeded Outcome = "succeeded"
+
+	// OutcomeFailed means that the instance is still on the host, or ended
+	// in the ERROR status.
+	OutcomeFailed Outcome = "failed"
+
+	// OutcomeSkipped means that the instance was left on the host on
+	// purpose.
+	OutcomeSkipped Outcome = "skipped"
+)
+
+// InstanceResult is the outcome of draining a single instance.
+type InstanceResult struct {
+	// ServerID is the ID of the instance.
+	ServerID string
+
+	// Name is the name of the instance.
+	Name string
+
+	// Policy is the policy that was applied to the instance.
+	Policy Policy
+
+	// Outcome is the final state of the instance.
+	Outcome Outcome
+
+	// DestinationHost is the host the instance was moved to, if known.
+	DestinationHost string
+
+	// Err is the reason of a failed outcome.
+	Err error
+}
+
+// DrainResult is the outcome of a Drain call.
+type DrainResult struct {
+	// ServiceID is the ID of the compute service that was disabled.
+	ServiceID string
+
+	// Instances holds the outcome of every instance found on the host, in
+	// the order they were listed.
+	Instances []InstanceResult
+}
+
+// Failed returns the instances that could not be moved.
+func (r DrainResult) Failed() []InstanceResult {
+	return r.filter(OutcomeFailed)
+}
+
+// Succeeded returns the instances that were moved.
+func (r DrainResult) Succeeded() []InstanceResult {
+	return r.filter(OutcomeSucceeded)
+}
+
+// Skipped returns the instances that were left on the host on purpose.
+func (r DrainResult) Skipped() []InstanceResult {
+	return r.filter(OutcomeSkipped)
+}
+
+func (r DrainResult) filter(outcome Outcome) []InstanceResult {
+	var instances []InstanceResult
+	for _, instance := range r.Instances {
+		if instance.Outcome == outcome {
+			instances = append(instances, instance)
+		}
+	}
+	return instances
+}
@@ -0,0 +1,2 @@
+// hostdrain unit tests
+package testing
@@ -0,0 +1,226 @@
+package testing
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+const (
+	serviceID  = "4c7e2b1f-9c6a-4bd3-8a5e-2a3c7f0d6b11"
+	migratedID = "9e5476bd-a4ec-4653-93d6-72c93aa682ba"
+	stuckID    = "7c6a2b9f-5b1a-4b2e-9a3f-2f8c7d6e5a40"
+	stoppedID  = "d3b8c1e2-0f4a-4c6b-8e7d-9a1b2c3d4e5f"
+	otherID    = "2f1e0d9c-8b7a-4654-9321-0fedcba98765"
+)
+
+// ServiceListBody is a sample response to a services.List call filtered on
+// the drained host.
+const ServiceListBody = `
+{
+    "services": [
+        {
+            "id": "4c7e2b1f-9c6a-4bd3-8a5e-2a3c7f0d6b11",
+            "binary": "nova-compute",
+            "disabled_reason": null,
+            "host": "compute-01",
+            "state": "up",
+            "status": "enabled",
+            "updated_at": "2012-10-29T13:42:05.000000",
+            "forced_down": false,
+            "zone": "nova"
+        }
+    ]
+}
+`
+
+// ServiceUpdateRequest is the expected body of the services.Update call.
+const ServiceUpdateRequest = `
+{
+    "status": "disabled",
+    "disabled_reason": "maintenance"
+}
+`
+
+// ServiceUpdateBody is a sample response to the services.Update call.
+const ServiceUpdateBody = `
+{
+    "service": {
+        "id": "4c7e2b1f-9c6a-4bd3-8a5e-2a3c7f0d6b11",
+        "binary": "nova-compute",
+        "disabled_reason": "maintenance",
+        "host": "compute-01",
+        "state": "up",
+        "status": "disabled",
+        "updated_at": "2012-10-29T13:42:05.000000",
+        "forced_down": false,
+        "zone": "nova"
+    }
+}
+`
+
+// HypervisorListBody is a sample response to a hypervisors.List call with
+// servers. Only the first hypervisor belongs to the drained host.
+const HypervisorListBody = `
+{
+    "hypervisors": [
+        {
+            "id": "c48f6247-abe4-4a24-824e-ea39e108874f",
+            "hypervisor_hostname": "compute-01.example.com",
+            "hypervisor_type": "QEMU",
+            "hypervisor_version": 4002000,
+            "state": "up",
+            "status": "enabled",
+            "service": {
+                "host": "compute-01",
+                "id": "4c7e2b1f-9c6a-4bd3-8a5e-2a3c7f0d6b11",
+                "disabled_reason": null
+            },
+            "servers": [
+                {"name": "migrated", "uuid": "9e5476bd-a4ec-4653-93d6-72c93aa682ba"},
+                {"name": "stuck", "uuid": "7c6a2b9f-5b1a-4b2e-9a3f-2f8c7d6e5a40"},
+                {"name": "stopped", "uuid": "d3b8c1e2-0f4a-4c6b-8e7d-9a1b2c3d4e5f"}
+            ]
+        },
+        {
+            "id": "7f6e5d4c-3b2a-4190-8f7e-6d5c4b3a2910",
+            "hypervisor_hostname": "compute-02.example.com",
+            "hypervisor_type": "QEMU",
+            "hypervisor_version": 4002000,
+            "state": "up",
+            "status": "enabled",
+            "service": {
+                "host": "compute-02",
+                "id": "0a1b2c3d-4e5f-4061-8293-a4b5c6d7e8f9",
+                "disabled_reason": null
+            },
+            "servers": [
+                {"name": "other", "uuid": "2f1e0d9c-8b7a-4654-9321-0fedcba98765"}
+            ]
+        }
+    ]
+}
+`
+
+// serverState is a state returned by the fake server for a GET on a server.
+type serverState struct {
+	Status    string
+	TaskState string
+	Host      string
+}
+
+// serverBody renders a minimal server representation.
+func serverBody(id string, state serverState) string {
+	taskState := "null"
+	if state.TaskState != "" {
+		taskState = fmt.Sprintf("%q", state.TaskState)
+	}
+
+	return fmt.Sprintf(`
+{
+    "server": {
+        "id": %q,
+        "status": %q,
+        "OS-EXT-STS:task_state": %s,
+        "OS-EXT-SRV-ATTR:host": %q
+    }
+}
+`, id, state.Status, taskState, state.Host)
+}
+
+// FakeCloud records the calls made against the fake compute API.
+type FakeCloud struct {
+	mu      sync.Mutex
+	States  map[string][]serverState
+	Gets    map[string]int
+	Actions map[string][]string
+}
+
+// HandleDrain sets up the test server to respond to the calls made by
+// hostdrain.Drain. Each GET on a server returns the next state in states,
+// the last state being repeated.
+func HandleDrain(t *testing.T, fakeServer th.FakeServer, states map[string][]serverState) *FakeCloud {
+	cloud := &FakeCloud{
+		States:  states,
+		Gets:    make(map[string]int),
+		Actions: make(map[string][]string),
+	}
+
+	fakeServer.Mux.HandleFunc("/os-services", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"binary": "nova-compute",
+			"host":   "compute-01",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ServiceListBody)
+	})
+
+	fakeServer.Mux.HandleFunc("/os-services/"+serviceID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, ServiceUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ServiceUpdateBody)
+	})
+
+	fakeServer.Mux.HandleFunc("/os-hypervisors/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"with_servers": "true"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, HypervisorListBody)
+	})
+
+	fakeServer.Mux.HandleFunc("/servers/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		id, action, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/servers/"), "/")
+
+		cloud.mu.Lock()
+		defer cloud.mu.Unlock()
+
+		if action == "action" {
+			th.TestMethod(t, r, "POST")
+
+			var body map[string]any
+			th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+			for k := range body {
+				cloud.Actions[id] = append(cloud.Actions[id], k)
+			}
+
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		th.TestMethod(t, r, "GET")
+
+		states, ok := cloud.States[id]
+		if !ok {
+			t.Errorf("unexpected GET on server %s", id)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		i := cloud.Gets[id]
+		if i >= len(states) {
+			i = len(states) - 1
+		}
+		cloud.Gets[id]++
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, serverBody(id, states[i]))
+	})
+
+	return cloud
+}
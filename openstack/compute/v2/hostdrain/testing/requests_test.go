@@ -0,0 +1,176 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/hostdrain"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestDrain(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	cloud := HandleDrain(t, fakeServer, map[string][]serverState{
+		migratedID: {
+			{Status: "ACTIVE", Host: "compute-01"},
+			{Status: "ACTIVE", Host: "compute-02"},
+		},
+		stuckID: {
+			{Status: "ACTIVE", Host: "compute-01"},
+		},
+		stoppedID: {
+			{Status: "SHUTOFF", Host: "compute-01"},
+			{Status: "VERIFY_RESIZE", Host: "compute-03"},
+			{Status: "SHUTOFF", Host: "compute-03"},
+		},
+	})
+
+	var mu sync.Mutex
+	var events []hostdrain.Event
+
+	drainOpts := hostdrain.DrainOpts{
+		Host:           "compute-01",
+		DisabledReason: "maintenance",
+		Concurrency:    3,
+		PolicyFunc: func(server *servers.Server) hostdrain.Policy {
+			if server.Status == "SHUTOFF" {
+				return hostdrain.PolicyColdMigrate
+			}
+			return hostdrain.PolicyLiveMigrate
+		},
+		OnEvent: func(event hostdrain.Event) {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, event)
+		},
+	}
+
+	result, err := hostdrain.Drain(context.TODO(), client.ServiceClient(fakeServer), drainOpts)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, serviceID, result.ServiceID)
+	th.AssertEquals(t, 3, len(result.Instances))
+
+	migrated := result.Instances[0]
+	th.AssertEquals(t, migratedID, migrated.ServerID)
+	th.AssertEquals(t, "migrated", migrated.Name)
+	th.AssertEquals(t, hostdrain.PolicyLiveMigrate, migrated.Policy)
+	th.AssertEquals(t, hostdrain.OutcomeSucceeded, migrated.Outcome)
+	th.AssertEquals(t, "compute-02", migrated.DestinationHost)
+	th.AssertNoErr(t, migrated.Err)
+
+	stuck := result.Instances[1]
+	th.AssertEquals(t, stuckID, stuck.ServerID)
+	th.AssertEquals(t, hostdrain.OutcomeFailed, stuck.Outcome)
+	var notMoved hostdrain.ErrInstanceNotMoved
+	th.AssertEquals(t, true, errors.As(stuck.Err, &notMoved))
+	th.AssertEquals(t, "compute-01", notMoved.Host)
+
+	stopped := result.Instances[2]
+	th.AssertEquals(t, stoppedID, stopped.ServerID)
+	th.AssertEquals(t, hostdrain.PolicyColdMigrate, stopped.Policy)
+	th.AssertEquals(t, hostdrain.OutcomeSucceeded, stopped.Outcome)
+	th.AssertEquals(t, "compute-03", stopped.DestinationHost)
+
+	th.AssertEquals(t, 2, len(result.Succeeded()))
+	th.AssertEquals(t, 1, len(result.Failed()))
+	th.AssertEquals(t, 0, len(result.Skipped()))
+
+	th.AssertDeepEquals(t, []string{"os-migrateLive"}, cloud.Actions[migratedID])
+	th.AssertDeepEquals(t, []string{"os-migrateLive"}, cloud.Actions[stuckID])
+	th.AssertDeepEquals(t, []string{"migrate", "confirmResize"}, cloud.Actions[stoppedID])
+	th.AssertEquals(t, 0, cloud.Gets[otherID])
+
+	th.AssertEquals(t, hostdrain.EventServiceDisabled, events[0].Type)
+	last := events[len(events)-1]
+	th.AssertEquals(t, 3, last.Done)
+	th.AssertEquals(t, 3, last.Total)
+
+	counts := make(map[hostdrain.EventType]int)
+	for _, event := range events {
+		th.AssertEquals(t, "compute-01", event.Host)
+		counts[event.Type]++
+	}
+	th.AssertDeepEquals(t, map[hostdrain.EventType]int{
+		hostdrain.EventServiceDisabled:   1,
+		hostdrain.EventInstanceStarted:   3,
+		hostdrain.EventInstanceSucceeded: 2,
+		hostdrain.EventInstanceFailed:    1,
+	}, counts)
+}
+
+func TestDrainSkip(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	cloud := HandleDrain(t, fakeServer, map[string][]serverState{
+		migratedID: {{Status: "ACTIVE", Host: "compute-01"}},
+		stuckID:    {{Status: "ACTIVE", Host: "compute-01"}},
+		stoppedID:  {{Status: "SHUTOFF", Host: "compute-01"}},
+	})
+
+	drainOpts := hostdrain.DrainOpts{
+		Host:           "compute-01",
+		DisabledReason: "maintenance",
+		Policy:         hostdrain.PolicySkip,
+	}
+
+	result, err := hostdrain.Drain(context.TODO(), client.ServiceClient(fakeServer), drainOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, len(result.Skipped()))
+	th.AssertEquals(t, 0, len(cloud.Actions))
+}
+
+func TestDrainInstanceErrorAndTimeout(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	HandleDrain(t, fakeServer, map[string][]serverState{
+		migratedID: {
+			{Status: "ACTIVE", Host: "compute-01"},
+			{Status: "ERROR", Host: "compute-01"},
+		},
+		stuckID: {
+			{Status: "ACTIVE", Host: "compute-01"},
+			{Status: "MIGRATING", TaskState: "migrating", Host: "compute-01"},
+		},
+		stoppedID: {
+			{Status: "SHUTOFF", Host: "compute-01"},
+		},
+	})
+
+	drainOpts := hostdrain.DrainOpts{
+		Host:            "compute-01",
+		DisabledReason:  "maintenance",
+		Concurrency:     3,
+		InstanceTimeout: 100 * time.Millisecond,
+		PolicyFunc: func(server *servers.Server) hostdrain.Policy {
+			if server.Status == "SHUTOFF" {
+				return hostdrain.PolicySkip
+			}
+			return hostdrain.PolicyLiveMigrate
+		},
+	}
+
+	result, err := hostdrain.Drain(context.TODO(), client.ServiceClient(fakeServer), drainOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(result.Failed()))
+
+	var instanceError hostdrain.ErrInstanceError
+	th.AssertEquals(t, true, errors.As(result.Instances[0].Err, &instanceError))
+	th.AssertEquals(t, migratedID, instanceError.ServerID)
+
+	th.AssertEquals(t, true, errors.Is(result.Instances[1].Err, context.DeadlineExceeded))
+}
+
+func TestDrainMissingHost(t *testing.T) {
+	_, err := hostdrain.Drain(context.TODO(), nil, hostdrain.DrainOpts{})
+	th.AssertErr(t, err)
+}
@@ -65,6 +65,38 @@ Example to Import a Key Pair
 		panic(err)
 	}
 
+Example to Generate a Key Pair Locally
+
+	// Starting with microversion 2.92, Nova no longer generates key pairs.
+	// Generate creates the key locally, imports its public key and verifies
+	// the fingerprint returned by Nova.
+	generateOpts := keypairs.GenerateOpts{
+		Name:    "keypair-name",
+		KeyType: keypairs.KeyTypeED25519,
+	}
+
+	keypair, err := keypairs.Generate(context.TODO(), computeClient, generateOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	err = os.WriteFile("keypair-name.pem", []byte(keypair.PrivateKey), 0600)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Verify the Fingerprint of a Key Pair
+
+	keypair, err := keypairs.Get(context.TODO(), computeClient, "keypair-name", nil).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = keypairs.VerifyFingerprint(localPublicKey, keypair.Fingerprint)
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Key Pair
 
 	err := keypairs.Delete(context.TODO(), computeClient, "keypair-name", nil).ExtractErr()
@@ -0,0 +1,31 @@
+package keypairs
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// ErrFingerprintMismatch is the error when the fingerprint returned by the
+// Compute service does not match the key pair that was uploaded.
+type ErrFingerprintMismatch struct {
+	gophercloud.BaseError
+	Name     string
+	Expected string
+	Actual   string
+}
+
+func (e ErrFingerprintMismatch) Error() string {
+	return fmt.Sprintf("Fingerprint of key pair [%s] is [%s], expected [%s]", e.Name, e.Actual, e.Expected)
+}
+
+// ErrUnsupportedKeyType is the error when GenerateOpts.KeyType is not a type
+// that can be generated.
+type ErrUnsupportedKeyType struct {
+	gophercloud.BaseError
+	KeyType KeyType
+}
+
+func (e ErrUnsupportedKeyType) Error() string {
+	return fmt.Sprintf("Unsupported key type [%s]", e.KeyType)
+}
@@ -0,0 +1,204 @@
+package keypairs
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"golang.org/x/crypto/ssh"
+)
+
+// KeyType is the algorithm of a locally generated key pair.
+type KeyType string
+
+const (
+	KeyTypeED25519 KeyType = "ed25519"
+	KeyTypeRSA     KeyType = "rsa"
+)
+
+// DefaultRSABits is the size of generated RSA keys when GenerateOpts.RSABits
+// is not set.
+const DefaultRSABits = 4096
+
+// GenerateOpts specifies the key pair to generate and import.
+type GenerateOpts struct {
+	// Name is a friendly name to refer to this KeyPair in other services.
+	Name string
+
+	// UserID [optional] is the user_id for a keypair.
+	// This requires microversion 2.10 or higher.
+	UserID string
+
+	// KeyType is the algorithm of the generated key. It defaults to
+	// KeyTypeED25519.
+	KeyType KeyType
+
+	// RSABits is the size of the generated key when KeyType is KeyTypeRSA.
+	// It defaults to DefaultRSABits.
+	RSABits int
+
+	// Comment [optional] is appended to the public key.
+	Comment string
+}
+
+// PrivateKey is a locally generated private key.
+type PrivateKey struct {
+	// Key is the private key, an ed25519.PrivateKey or an *rsa.PrivateKey.
+	Key any
+
+	// PEM is the private key encoded in PEM format. Ed25519 keys are encoded
+	// in the OpenSSH format and RSA keys in the PKCS#1 format, as Nova did
+	// when generating keys.
+	PEM []byte
+
+	// PublicKey is the public key in OpenSSH authorized_keys format.
+	PublicKey string
+}
+
+// GenerateKey generates a private key locally.
+func GenerateKey(keyType KeyType, rsaBits int, comment string) (*PrivateKey, error) {
+	var key any
+	var block *pem.Block
+	var err error
+
+	switch keyType {
+	case KeyTypeED25519, "":
+		var priv ed25519.PrivateKey
+		_, priv, err = ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		key = priv
+
+		block, err = ssh.MarshalPrivateKey(priv, comment)
+		if err != nil {
+			return nil, err
+		}
+	case KeyTypeRSA:
+		if rsaBits == 0 {
+			rsaBits = DefaultRSABits
+		}
+
+		var priv *rsa.PrivateKey
+		priv, err = rsa.GenerateKey(rand.Reader, rsaBits)
+		if err != nil {
+			return nil, err
+		}
+		key = priv
+
+		block = &pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(priv),
+		}
+	default:
+		return nil, ErrUnsupportedKeyType{KeyType: keyType}
+	}
+
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey := strings.TrimSuffix(string(ssh.MarshalAuthorizedKey(signer.PublicKey())), "\n")
+	if comment != "" {
+		publicKey += " " + comment
+	}
+
+	return &PrivateKey{
+		Key:       key,
+		PEM:       pem.EncodeToMemory(block),
+		PublicKey: publicKey,
+	}, nil
+}
+
+// FingerprintMD5 returns the MD5 fingerprint of an OpenSSH public key in the
+// colon-separated hexadecimal format used by Nova, for example
+// "1e:2c:9b:56:79:4b:45:77:f9:ca:7a:98:2c:b0:d5:3c".
+func FingerprintMD5(publicKey string) (string, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return "", err
+	}
+	return ssh.FingerprintLegacyMD5(key), nil
+}
+
+// FingerprintSHA256 returns the SHA256 fingerprint of an OpenSSH public key
+// in the format used by OpenSSH, for example "SHA256:GjXPfnvRFXr...".
+func FingerprintSHA256(publicKey string) (string, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return "", err
+	}
+	return ssh.FingerprintSHA256(key), nil
+}
+
+// VerifyFingerprint checks that fingerprint matches an OpenSSH public key.
+// The fingerprint may be in either the MD5 or the SHA256 format. It returns an
+// ErrFingerprintMismatch if it does not match.
+func VerifyFingerprint(publicKey, fingerprint string) error {
+	var expected string
+	var err error
+	if strings.HasPrefix(fingerprint, "SHA256:") {
+		expected, err = FingerprintSHA256(publicKey)
+	} else {
+		expected, err = FingerprintMD5(publicKey)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(expected, fingerprint) {
+		return ErrFingerprintMismatch{Expected: expected, Actual: fingerprint}
+	}
+	return nil
+}
+
+// Generate generates a key pair locally, imports its public key and checks
+// the fingerprint returned by the Compute service against the local key.
+//
+// The returned KeyPair has its PrivateKey field set to the PEM encoded
+// private key, which never leaves the client. If the fingerprint does not
+// match, the imported key pair is deleted on a best-effort basis and an
+// ErrFingerprintMismatch is returned.
+func Generate(ctx context.Context, client *gophercloud.ServiceClient, opts GenerateOpts) (*KeyPair, error) {
+	if opts.Name == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "keypairs.GenerateOpts.Name"
+		return nil, err
+	}
+
+	key, err := GenerateKey(opts.KeyType, opts.RSABits, opts.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	createOpts := CreateOpts{
+		Name:      opts.Name,
+		UserID:    opts.UserID,
+		PublicKey: key.PublicKey,
+	}
+
+	kp, err := Create(ctx, client, createOpts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := VerifyFingerprint(key.PublicKey, kp.Fingerprint); err != nil {
+		if e, ok := err.(ErrFingerprintMismatch); ok {
+			e.Name = opts.Name
+			err = e
+		}
+
+		// The mismatch is reported even if the key pair can't be removed.
+		_ = Delete(ctx, client, opts.Name, DeleteOpts{UserID: opts.UserID}).ExtractErr()
+		return nil, err
+	}
+
+	kp.PrivateKey = string(key.PEM)
+	return kp, nil
+}
@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -248,3 +249,62 @@ func HandleDeleteSuccessfullyOtherUser(t *testing.T, fakeServer th.FakeServer) {
 		w.WriteHeader(http.StatusAccepted)
 	})
 }
+
+// HandleGenerateSuccessfully configures the test server to respond to the
+// import of a locally generated key pair called "generatedkey" with the
+// fingerprint of the uploaded key.
+func HandleGenerateSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	handleGenerate(t, fakeServer, "")
+}
+
+// HandleGenerateFingerprintMismatch configures the test server to respond to
+// the import of a locally generated key pair called "generatedkey" with a
+// wrong fingerprint, and to its deletion. It returns the number of Delete
+// requests received.
+func HandleGenerateFingerprintMismatch(t *testing.T, fakeServer th.FakeServer) *int {
+	handleGenerate(t, fakeServer, ImportedKeyPair.Fingerprint)
+
+	deleted := 0
+	fakeServer.Mux.HandleFunc("/os-keypairs/generatedkey", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		deleted++
+		w.WriteHeader(http.StatusAccepted)
+	})
+	return &deleted
+}
+
+func handleGenerate(t *testing.T, fakeServer th.FakeServer, fingerprint string) {
+	fakeServer.Mux.HandleFunc("/os-keypairs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		var body struct {
+			KeyPair struct {
+				Name      string `json:"name"`
+				PublicKey string `json:"public_key"`
+			} `json:"keypair"`
+		}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		th.AssertNoErr(t, err)
+		th.AssertEquals(t, "generatedkey", body.KeyPair.Name)
+
+		actual := fingerprint
+		if actual == "" {
+			actual, err = keypairs.FingerprintMD5(body.KeyPair.PublicKey)
+			th.AssertNoErr(t, err)
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(map[string]any{
+			"keypair": map[string]any{
+				"name":        body.KeyPair.Name,
+				"public_key":  body.KeyPair.PublicKey,
+				"fingerprint": actual,
+				"user_id":     "fake",
+			},
+		})
+		th.AssertNoErr(t, err)
+	})
+}
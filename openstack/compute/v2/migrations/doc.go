@@ -0,0 +1,82 @@
+/*
+Package migrations provides the ability to observe and control the
+migrations of servers.
+
+The global list of migrations is available through the os-migrations API,
+while the in-progress live migrations of a given server are available
+through the servers/{server_id}/migrations API, which also allows forcing a
+live migration to complete or aborting it.
+
+Example to List Migrations
+
+	computeClient.Microversion = "2.80"
+
+	listOpts := migrations.ListOpts{
+		Host:          "compute-01",
+		Status:        "running",
+		MigrationType: "live-migration",
+	}
+
+	allPages, err := migrations.List(computeClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allMigrations, err := migrations.ExtractMigrations(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, migration := range allMigrations {
+		fmt.Printf("%+v\n", migration)
+	}
+
+Example to List the Live Migrations of a Server
+
+	computeClient.Microversion = "2.24"
+
+	allPages, err := migrations.ListServerMigrations(computeClient, "server-id").AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	serverMigrations, err := migrations.ExtractServerMigrations(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, migration := range serverMigrations {
+		fmt.Printf("%s: %d/%d bytes of memory remaining\n",
+			migration.Status, migration.MemoryRemainingBytes, migration.MemoryTotalBytes)
+	}
+
+Example to Get a Live Migration of a Server
+
+	computeClient.Microversion = "2.24"
+
+	migration, err := migrations.GetServerMigration(context.TODO(), computeClient, "server-id", 42).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", migration)
+
+Example to Force a Live Migration to Complete
+
+	computeClient.Microversion = "2.22"
+
+	err := migrations.ForceComplete(context.TODO(), computeClient, "server-id", 42).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Abort a Live Migration
+
+	computeClient.Microversion = "2.24"
+
+	err := migrations.Abort(context.TODO(), computeClient, "server-id", 42).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package migrations
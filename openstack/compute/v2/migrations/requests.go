@@ -0,0 +1,149 @@
+package migrations
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToMigrationListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and paging of the migrations returned by the
+// os-migrations API.
+type ListOpts struct {
+	// Hidden filters the response by the hidden status of the migrations.
+	Hidden *bool `q:"hidden"`
+
+	// Host filters the response by the source or destination compute host.
+	Host string `q:"host"`
+
+	// InstanceUUID filters the response by the UUID of the server.
+	InstanceUUID string `q:"instance_uuid"`
+
+	// SourceCompute filters the response by the source compute host.
+	SourceCompute string `q:"source_compute"`
+
+	// Status filters the response by the status of the migrations.
+	Status string `q:"status"`
+
+	// MigrationType filters the response by the type of migration.
+	// Valid values are "evacuation", "live-migration", "migration" and
+	// "resize".
+	// This requires microversion 2.23 or later.
+	MigrationType string `q:"migration_type"`
+
+	// Limit is the maximum number of migrations to return per page.
+	// This requires microversion 2.59 or later.
+	Limit int `q:"limit"`
+
+	// Marker is the UUID of the last-seen migration.
+	// This requires microversion 2.59 or later.
+	Marker string `q:"marker"`
+
+	// ChangesSince filters the response by migrations updated after the
+	// given time.
+	// This requires microversion 2.59 or later.
+	ChangesSince *time.Time `q:"changes-since"`
+
+	// ChangesBefore filters the response by migrations updated before the
+	// given time.
+	// This requires microversion 2.66 or later.
+	ChangesBefore *time.Time `q:"changes-before"`
+
+	// UserID filters the response by the ID of the user that initiated the
+	// migrations.
+	// This requires microversion 2.80 or later.
+	UserID string `q:"user_id"`
+
+	// ProjectID filters the response by the ID of the project that owns the
+	// migrated servers.
+	// This requires microversion 2.80 or later.
+	ProjectID string `q:"project_id"`
+}
+
+// ToMigrationListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToMigrationListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	params := q.Query()
+
+	if opts.ChangesSince != nil {
+		params.Add("changes-since", opts.ChangesSince.Format(time.RFC3339))
+	}
+
+	if opts.ChangesBefore != nil {
+		params.Add("changes-before", opts.ChangesBefore.Format(time.RFC3339))
+	}
+
+	q = &url.URL{RawQuery: params.Encode()}
+	return q.String(), nil
+}
+
+// List makes a request against the os-migrations API to list migrations.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToMigrationListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return MigrationPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListServerMigrations makes a request against the API to list the
+// in-progress live migrations of a server.
+// This requires microversion 2.23 or later.
+func ListServerMigrations(client *gophercloud.ServiceClient, serverID string) pagination.Pager {
+	return pagination.NewPager(client, serverMigrationsURL(client, serverID), func(r pagination.PageResult) pagination.Page {
+		return ServerMigrationPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// GetServerMigration makes a request against the API to get an in-progress
+// live migration of a server.
+// This requires microversion 2.23 or later.
+func GetServerMigration(ctx context.Context, client *gophercloud.ServiceClient, serverID string, migrationID int) (r GetServerMigrationResult) {
+	resp, err := client.Get(ctx, serverMigrationURL(client, serverID, migrationID), &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ForceComplete forces an in-progress live migration of a server to
+// complete, for example by pausing the server or switching to post-copy.
+// This requires microversion 2.22 or later.
+func ForceComplete(ctx context.Context, client *gophercloud.ServiceClient, serverID string, migrationID int) (r ForceCompleteResult) {
+	b := map[string]any{"force_complete": nil}
+	resp, err := client.Post(ctx, serverMigrationActionURL(client, serverID, migrationID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Abort aborts an in-progress live migration of a server.
+// This requires microversion 2.24 or later. Aborting a live migration in the
+// "queued" or "preparing" status requires microversion 2.65 or later.
+func Abort(ctx context.Context, client *gophercloud.ServiceClient, serverID string, migrationID int) (r AbortResult) {
+	resp, err := client.Delete(ctx, serverMigrationURL(client, serverID, migrationID), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
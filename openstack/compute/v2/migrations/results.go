@@ -0,0 +1,264 @@
+package migrations
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Migration represents a migration returned by the os-migrations API.
+type Migration struct {
+	// ID is the ID of the migration.
+	ID int `json:"id"`
+
+	// UUID is the UUID of the migration.
+	// This requires microversion 2.59 or later.
+	UUID string `json:"uuid"`
+
+	// InstanceUUID is the UUID of the migrated server.
+	InstanceUUID string `json:"instance_uuid"`
+
+	// MigrationType is the type of the migration: "evacuation",
+	// "live-migration", "migration" or "resize".
+	// This requires microversion 2.23 or later.
+	MigrationType string `json:"migration_type"`
+
+	// Status is the current status of the migration.
+	Status string `json:"status"`
+
+	// SourceCompute is the source compute host.
+	SourceCompute string `json:"source_compute"`
+
+	// SourceNode is the source compute node.
+	SourceNode string `json:"source_node"`
+
+	// DestCompute is the destination compute host.
+	DestCompute string `json:"dest_compute"`
+
+	// DestHost is the IP address of the destination compute host.
+	DestHost string `json:"dest_host"`
+
+	// DestNode is the destination compute node.
+	DestNode string `json:"dest_node"`
+
+	// OldInstanceTypeID is the ID of the flavor before the migration.
+	OldInstanceTypeID int `json:"old_instance_type_id"`
+
+	// NewInstanceTypeID is the ID of the flavor after the migration.
+	NewInstanceTypeID int `json:"new_instance_type_id"`
+
+	// Links are links to the server migration resource, which are only
+	// present for in-progress live migrations.
+	// This requires microversion 2.23 or later.
+	Links []gophercloud.Link `json:"links"`
+
+	// UserID is the ID of the user that initiated the migration.
+	// This requires microversion 2.80 or later.
+	UserID string `json:"user_id"`
+
+	// ProjectID is the ID of the project that owns the migrated server.
+	// This requires microversion 2.80 or later.
+	ProjectID string `json:"project_id"`
+
+	// CreatedAt is the date and time when the migration was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date and time when the migration was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// UnmarshalJSON converts our JSON API response into our migration struct.
+func (r *Migration) UnmarshalJSON(b []byte) error {
+	type tmp Migration
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Migration(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+// MigrationPage stores a single page of migrations from a List call.
+type MigrationPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a MigrationPage is empty.
+func (page MigrationPage) IsEmpty() (bool, error) {
+	if page.StatusCode == 204 {
+		return true, nil
+	}
+
+	migrations, err := ExtractMigrations(page)
+	return len(migrations) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (page MigrationPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"migrations_links"`
+	}
+	err := page.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// ExtractMigrations interprets a page of results as a slice of Migration.
+func ExtractMigrations(p pagination.Page) ([]Migration, error) {
+	var s struct {
+		Migrations []Migration `json:"migrations"`
+	}
+	err := (p.(MigrationPage)).ExtractInto(&s)
+	return s.Migrations, err
+}
+
+// ServerMigration represents an in-progress live migration of a server.
+type ServerMigration struct {
+	// ID is the ID of the migration.
+	ID int `json:"id"`
+
+	// UUID is the UUID of the migration.
+	// This requires microversion 2.59 or later.
+	UUID string `json:"uuid"`
+
+	// ServerUUID is the UUID of the migrated server.
+	ServerUUID string `json:"server_uuid"`
+
+	// Status is the current status of the migration.
+	Status string `json:"status"`
+
+	// SourceCompute is the source compute host.
+	SourceCompute string `json:"source_compute"`
+
+	// SourceNode is the source compute node.
+	SourceNode string `json:"source_node"`
+
+	// DestCompute is the destination compute host.
+	DestCompute string `json:"dest_compute"`
+
+	// DestHost is the IP address of the destination compute host.
+	DestHost string `json:"dest_host"`
+
+	// DestNode is the destination compute node.
+	DestNode string `json:"dest_node"`
+
+	// MemoryTotalBytes is the amount of memory to be transferred.
+	MemoryTotalBytes int64 `json:"memory_total_bytes"`
+
+	// MemoryProcessedBytes is the amount of memory transferred so far.
+	MemoryProcessedBytes int64 `json:"memory_processed_bytes"`
+
+	// MemoryRemainingBytes is the amount of memory left to transfer.
+	MemoryRemainingBytes int64 `json:"memory_remaining_bytes"`
+
+	// DiskTotalBytes is the amount of disk to be transferred.
+	DiskTotalBytes int64 `json:"disk_total_bytes"`
+
+	// DiskProcessedBytes is the amount of disk transferred so far.
+	DiskProcessedBytes int64 `json:"disk_processed_bytes"`
+
+	// DiskRemainingBytes is the amount of disk left to transfer.
+	DiskRemainingBytes int64 `json:"disk_remaining_bytes"`
+
+	// UserID is the ID of the user that initiated the migration.
+	// This requires microversion 2.80 or later.
+	UserID string `json:"user_id"`
+
+	// ProjectID is the ID of the project that owns the migrated server.
+	// This requires microversion 2.80 or later.
+	ProjectID string `json:"project_id"`
+
+	// CreatedAt is the date and time when the migration was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date and time when the migration was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// UnmarshalJSON converts our JSON API response into our server migration
+// struct.
+func (r *ServerMigration) UnmarshalJSON(b []byte) error {
+	type tmp ServerMigration
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = ServerMigration(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+// ServerMigrationPage stores a single page of server migrations from a
+// ListServerMigrations call.
+type ServerMigrationPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a ServerMigrationPage is empty.
+func (page ServerMigrationPage) IsEmpty() (bool, error) {
+	if page.StatusCode == 204 {
+		return true, nil
+	}
+
+	migrations, err := ExtractServerMigrations(page)
+	return len(migrations) == 0, err
+}
+
+// ExtractServerMigrations interprets a page of results as a slice of
+// ServerMigration.
+func ExtractServerMigrations(p pagination.Page) ([]ServerMigration, error) {
+	var s struct {
+		Migrations []ServerMigration `json:"migrations"`
+	}
+	err := (p.(ServerMigrationPage)).ExtractInto(&s)
+	return s.Migrations, err
+}
+
+// GetServerMigrationResult is the response from a GetServerMigration
+// operation. Call its Extract method to interpret it as a ServerMigration.
+type GetServerMigrationResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetServerMigrationResult as a ServerMigration.
+func (r GetServerMigrationResult) Extract() (*ServerMigration, error) {
+	var s struct {
+		Migration *ServerMigration `json:"migration"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Migration, err
+}
+
+// ForceCompleteResult is the response from a ForceComplete operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type ForceCompleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AbortResult is the response from an Abort operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type AbortResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,297 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/migrations"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+const serverID = "7f0e0b1c-3a5e-4a5e-9e0e-4e2d3c1b0a99"
+
+// MigrationListBodyPage1 is the first page of a sample response to a List
+// call. It expects the URL of the fake server as format argument.
+const MigrationListBodyPage1 = `
+{
+    "migrations": [
+        {
+            "created_at": "2016-06-23T14:42:02.000000",
+            "dest_compute": "compute20",
+            "dest_host": "5.6.7.8",
+            "dest_node": "node20",
+            "id": 6,
+            "instance_uuid": "7f0e0b1c-3a5e-4a5e-9e0e-4e2d3c1b0a99",
+            "new_instance_type_id": 1,
+            "old_instance_type_id": 1,
+            "source_compute": "compute10",
+            "source_node": "node10",
+            "status": "running",
+            "updated_at": "2016-06-23T14:42:02.000000",
+            "migration_type": "live-migration",
+            "uuid": "42341d4b-346a-40d0-83c6-5f4f6892b650",
+            "links": [
+                {
+                    "href": "http://openstack.example.com/v2.1/6f70656e737461636b20342065766572/servers/7f0e0b1c-3a5e-4a5e-9e0e-4e2d3c1b0a99/migrations/6",
+                    "rel": "self"
+                },
+                {
+                    "href": "http://openstack.example.com/6f70656e737461636b20342065766572/servers/7f0e0b1c-3a5e-4a5e-9e0e-4e2d3c1b0a99/migrations/6",
+                    "rel": "bookmark"
+                }
+            ],
+            "user_id": "5c48ebaa-193f-4c5d-948a-f559cc92cd5e",
+            "project_id": "ef92ccff-00f3-46e4-b015-811110e36ee4"
+        }
+    ],
+    "migrations_links": [
+        {
+            "href": "%s/os-migrations?limit=1&marker=42341d4b-346a-40d0-83c6-5f4f6892b650",
+            "rel": "next"
+        }
+    ]
+}
+`
+
+// MigrationListBodyPage2 is the second and last page of a sample response
+// to a List call.
+const MigrationListBodyPage2 = `
+{
+    "migrations": [
+        {
+            "created_at": "2016-01-29T11:42:02.000000",
+            "dest_compute": "compute2",
+            "dest_host": "1.2.3.4",
+            "dest_node": "node2",
+            "id": 1,
+            "instance_uuid": "8600d31b-d1a1-4632-b2ff-45c2be1a70ff",
+            "new_instance_type_id": 1,
+            "old_instance_type_id": 1,
+            "source_compute": "compute1",
+            "source_node": "node1",
+            "status": "finished",
+            "updated_at": "2016-01-29T11:42:02.000000",
+            "migration_type": "migration",
+            "uuid": "12341d4b-346a-40d0-83c6-5f4f6892b650",
+            "links": [],
+            "user_id": "5c48ebaa-193f-4c5d-948a-f559cc92cd5e",
+            "project_id": "ef92ccff-00f3-46e4-b015-811110e36ee4"
+        }
+    ]
+}
+`
+
+// ServerMigrationListBody is a sample response to a ListServerMigrations
+// call.
+const ServerMigrationListBody = `
+{
+    "migrations": [
+        {
+            "created_at": "2016-01-29T13:42:02.000000",
+            "dest_compute": "compute2",
+            "dest_host": "1.2.3.4",
+            "dest_node": "node2",
+            "id": 4,
+            "server_uuid": "7f0e0b1c-3a5e-4a5e-9e0e-4e2d3c1b0a99",
+            "source_compute": "compute1",
+            "source_node": "node1",
+            "status": "running",
+            "memory_total_bytes": 123456,
+            "memory_processed_bytes": 12345,
+            "memory_remaining_bytes": 111111,
+            "disk_total_bytes": 234567,
+            "disk_processed_bytes": 23456,
+            "disk_remaining_bytes": 211111,
+            "updated_at": "2016-01-29T13:42:02.000000",
+            "uuid": "12341d4b-346a-40d0-83c6-5f4f6892b650",
+            "user_id": "8dbaa0f0-ab95-4ffe-8cb4-9c89d2ac9d24",
+            "project_id": "5f705771-3aa9-4f4c-8660-0d9522ffdbea"
+        }
+    ]
+}
+`
+
+// ServerMigrationGetBody is a sample response to a GetServerMigration call.
+const ServerMigrationGetBody = `
+{
+    "migration": {
+        "created_at": "2016-01-29T13:42:02.000000",
+        "dest_compute": "compute2",
+        "dest_host": "1.2.3.4",
+        "dest_node": "node2",
+        "id": 4,
+        "server_uuid": "7f0e0b1c-3a5e-4a5e-9e0e-4e2d3c1b0a99",
+        "source_compute": "compute1",
+        "source_node": "node1",
+        "status": "running",
+        "memory_total_bytes": 123456,
+        "memory_processed_bytes": 12345,
+        "memory_remaining_bytes": 111111,
+        "disk_total_bytes": 234567,
+        "disk_processed_bytes": 23456,
+        "disk_remaining_bytes": 211111,
+        "updated_at": "2016-01-29T13:42:02.000000",
+        "uuid": "12341d4b-346a-40d0-83c6-5f4f6892b650",
+        "user_id": "8dbaa0f0-ab95-4ffe-8cb4-9c89d2ac9d24",
+        "project_id": "5f705771-3aa9-4f4c-8660-0d9522ffdbea"
+    }
+}
+`
+
+// ForceCompleteRequest is the expected body of a ForceComplete call.
+const ForceCompleteRequest = `
+{
+    "force_complete": null
+}
+`
+
+// FirstMigration is the first migration of MigrationListBodyPage1.
+var FirstMigration = migrations.Migration{
+	ID:                6,
+	UUID:              "42341d4b-346a-40d0-83c6-5f4f6892b650",
+	InstanceUUID:      "7f0e0b1c-3a5e-4a5e-9e0e-4e2d3c1b0a99",
+	MigrationType:     "live-migration",
+	Status:            "running",
+	SourceCompute:     "compute10",
+	SourceNode:        "node10",
+	DestCompute:       "compute20",
+	DestHost:          "5.6.7.8",
+	DestNode:          "node20",
+	OldInstanceTypeID: 1,
+	NewInstanceTypeID: 1,
+	Links: []gophercloud.Link{
+		{
+			Href: "http://openstack.example.com/v2.1/6f70656e737461636b20342065766572/servers/7f0e0b1c-3a5e-4a5e-9e0e-4e2d3c1b0a99/migrations/6",
+			Rel:  "self",
+		},
+		{
+			Href: "http://openstack.example.com/6f70656e737461636b20342065766572/servers/7f0e0b1c-3a5e-4a5e-9e0e-4e2d3c1b0a99/migrations/6",
+			Rel:  "bookmark",
+		},
+	},
+	UserID:    "5c48ebaa-193f-4c5d-948a-f559cc92cd5e",
+	ProjectID: "ef92ccff-00f3-46e4-b015-811110e36ee4",
+	CreatedAt: time.Date(2016, 6, 23, 14, 42, 2, 0, time.UTC),
+	UpdatedAt: time.Date(2016, 6, 23, 14, 42, 2, 0, time.UTC),
+}
+
+// SecondMigration is the migration of MigrationListBodyPage2.
+var SecondMigration = migrations.Migration{
+	ID:                1,
+	UUID:              "12341d4b-346a-40d0-83c6-5f4f6892b650",
+	InstanceUUID:      "8600d31b-d1a1-4632-b2ff-45c2be1a70ff",
+	MigrationType:     "migration",
+	Status:            "finished",
+	SourceCompute:     "compute1",
+	SourceNode:        "node1",
+	DestCompute:       "compute2",
+	DestHost:          "1.2.3.4",
+	DestNode:          "node2",
+	OldInstanceTypeID: 1,
+	NewInstanceTypeID: 1,
+	Links:             []gophercloud.Link{},
+	UserID:            "5c48ebaa-193f-4c5d-948a-f559cc92cd5e",
+	ProjectID:         "ef92ccff-00f3-46e4-b015-811110e36ee4",
+	CreatedAt:         time.Date(2016, 1, 29, 11, 42, 2, 0, time.UTC),
+	UpdatedAt:         time.Date(2016, 1, 29, 11, 42, 2, 0, time.UTC),
+}
+
+// ExpectedServerMigration is the server migration of ServerMigrationListBody
+// and ServerMigrationGetBody.
+var ExpectedServerMigration = migrations.ServerMigration{
+	ID:                   4,
+	UUID:                 "12341d4b-346a-40d0-83c6-5f4f6892b650",
+	ServerUUID:           "7f0e0b1c-3a5e-4a5e-9e0e-4e2d3c1b0a99",
+	Status:               "running",
+	SourceCompute:        "compute1",
+	SourceNode:           "node1",
+	DestCompute:          "compute2",
+	DestHost:             "1.2.3.4",
+	DestNode:             "node2",
+	MemoryTotalBytes:     123456,
+	MemoryProcessedBytes: 12345,
+	MemoryRemainingBytes: 111111,
+	DiskTotalBytes:       234567,
+	DiskProcessedBytes:   23456,
+	DiskRemainingBytes:   211111,
+	UserID:               "8dbaa0f0-ab95-4ffe-8cb4-9c89d2ac9d24",
+	ProjectID:            "5f705771-3aa9-4f4c-8660-0d9522ffdbea",
+	CreatedAt:            time.Date(2016, 1, 29, 13, 42, 2, 0, time.UTC),
+	UpdatedAt:            time.Date(2016, 1, 29, 13, 42, 2, 0, time.UTC),
+}
+
+// HandleMigrationListSuccessfully sets up the test server to respond to a
+// List request with two pages.
+func HandleMigrationListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/os-migrations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		switch r.URL.Query().Get("marker") {
+		case "":
+			th.TestFormValues(t, r, map[string]string{
+				"host":           "compute10",
+				"migration_type": "live-migration",
+				"changes-since":  "2016-06-23T00:00:00Z",
+				"limit":          "1",
+			})
+			fmt.Fprintf(w, MigrationListBodyPage1, fakeServer.Server.URL)
+		case "42341d4b-346a-40d0-83c6-5f4f6892b650":
+			fmt.Fprint(w, MigrationListBodyPage2)
+		default:
+			t.Fatalf("Unexpected marker: %q", r.URL.Query().Get("marker"))
+		}
+	})
+}
+
+// HandleServerMigrationListSuccessfully sets up the test server to respond
+// to a ListServerMigrations request.
+func HandleServerMigrationListSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/servers/"+serverID+"/migrations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ServerMigrationListBody)
+	})
+}
+
+// HandleServerMigrationGetSuccessfully sets up the test server to respond to
+// a GetServerMigration request.
+func HandleServerMigrationGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/servers/"+serverID+"/migrations/4", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ServerMigrationGetBody)
+	})
+}
+
+// HandleForceCompleteSuccessfully sets up the test server to respond to a
+// ForceComplete request.
+func HandleForceCompleteSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/servers/"+serverID+"/migrations/4/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, ForceCompleteRequest)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleAbortSuccessfully sets up the test server to respond to an Abort
+// request.
+func HandleAbortSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/servers/"+serverID+"/migrations/4", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/migrations"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleMigrationListSuccessfully(t, fakeServer)
+
+	changesSince := time.Date(2016, 6, 23, 0, 0, 0, 0, time.UTC)
+	listOpts := migrations.ListOpts{
+		Host:          "compute10",
+		MigrationType: "live-migration",
+		ChangesSince:  &changesSince,
+		Limit:         1,
+	}
+
+	expected := [][]migrations.Migration{{FirstMigration}, {SecondMigration}}
+	pages := 0
+	err := migrations.List(client.ServiceClient(fakeServer), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		actual, err := migrations.ExtractMigrations(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, expected[pages], actual)
+
+		pages++
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 2, pages)
+}
+
+func TestListServerMigrations(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleServerMigrationListSuccessfully(t, fakeServer)
+
+	allPages, err := migrations.ListServerMigrations(client.ServiceClient(fakeServer), serverID).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := migrations.ExtractServerMigrations(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []migrations.ServerMigration{ExpectedServerMigration}, actual)
+}
+
+func TestGetServerMigration(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleServerMigrationGetSuccessfully(t, fakeServer)
+
+	actual, err := migrations.GetServerMigration(context.TODO(), client.ServiceClient(fakeServer), serverID, 4).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedServerMigration, *actual)
+}
+
+func TestForceComplete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleForceCompleteSuccessfully(t, fakeServer)
+
+	err := migrations.ForceComplete(context.TODO(), client.ServiceClient(fakeServer), serverID, 4).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestAbort(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleAbortSuccessfully(t, fakeServer)
+
+	err := migrations.Abort(context.TODO(), client.ServiceClient(fakeServer), serverID, 4).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,62 @@
+/*
+Package quotacheck checks that a server create request fits within the
+quotas of a project before it is made.
+
+servers.Create only fails once Nova claims quota, which may happen after
+volumes and ports have already been provisioned by the caller. Check reads
+the flavor of the request, the compute quotas (or absolute limits) of the
+project and, when the corresponding clients are given, the block storage and
+networking quotas. It then reports every quota the request would exceed.
+
+The number of instances is taken from the Min and Max fields of
+servers.CreateOpts. As Nova does, the request is considered to fit when at
+least Min instances fit, and the number of instances that would actually be
+created is reported.
+
+Volumes created from a snapshot or an image without a VolumeSize are sized
+after their source, as Cinder does. The size of a snapshot is read with the
+block storage client, and the size of an image requires CheckOpts.ImageClient.
+
+Example to Check a Server Create Request
+
+	createOpts := servers.CreateOpts{
+		Name:      "web",
+		FlavorRef: "m1.large-id",
+		Min:       3,
+		Max:       5,
+		Networks: []servers.Network{
+			{UUID: "2f1e0d9c-8b7a-4654-9321-0fedcba98765"},
+		},
+		BlockDevice: []servers.BlockDevice{
+			{
+				SourceType:      servers.SourceImage,
+				DestinationType: servers.DestinationVolume,
+				UUID:            "f90f6034-2570-4974-8351-6b49732ef2eb",
+				VolumeSize:      20,
+				BootIndex:       0,
+			},
+		},
+	}
+
+	checkOpts := quotacheck.CheckOpts{
+		ProjectID:          "6f70656e737461636b20342065766572",
+		CreateOpts:         createOpts,
+		BlockStorageClient: blockStorageClient,
+		NetworkClient:      networkClient,
+	}
+
+	report, err := quotacheck.Check(context.TODO(), computeClient, checkOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := report.Err(); err != nil {
+		for _, r := range report.Exceeded() {
+			fmt.Printf("%s %s: %d requested, %d available\n", r.Service, r.Resource, r.Requested, r.Available())
+		}
+		panic(err)
+	}
+
+	fmt.Printf("%d servers will be created\n", report.Instances)
+*/
+package quotacheck
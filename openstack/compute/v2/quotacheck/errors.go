@@ -0,0 +1,37 @@
+package quotacheck
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+)
+
+// ErrQuotaExceeded is the error when a request does not fit in the quotas of
+// a project.
+type ErrQuotaExceeded struct {
+	gophercloud.BaseError
+	Exceeded []Requirement
+}
+
+func (e ErrQuotaExceeded) Error() string {
+	details := make([]string, 0, len(e.Exceeded))
+	for _, r := range e.Exceeded {
+		details = append(details, fmt.Sprintf("%s %s (requested %d, available %d)", r.Service, r.Resource, r.Requested, r.Available()))
+	}
+	return "quota exceeded: " + strings.Join(details, ", ")
+}
+
+// ErrUnknownVolumeSize is the error when the size of a volume created for a
+// block device mapping cannot be determined, because the mapping has no
+// VolumeSize and its source has no size that can be read.
+type ErrUnknownVolumeSize struct {
+	gophercloud.BaseError
+	Index      int
+	SourceType servers.SourceType
+}
+
+func (e ErrUnknownVolumeSize) Error() string {
+	return fmt.Sprintf("unable to determine the size of the volume of block device %d (source %q): set VolumeSize", e.Index, e.SourceType)
+}
@@ -0,0 +1,311 @@
+package quotacheck
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	blockstoragequotasets "github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/quotasets"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/snapshots"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/limits"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/quotasets"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/quotas"
+)
+
+// CheckOpts specifies the request to check.
+type CheckOpts struct {
+	// CreateOpts is the request to check.
+	CreateOpts servers.CreateOpts
+
+	// ProjectID is the project the servers are created in. If set, the
+	// compute quotas are read from the os-quota-sets API, which includes
+	// reservations. Otherwise the absolute limits of the current project are
+	// used. It is required to check block storage and networking quotas.
+	ProjectID string
+
+	// BlockStorageClient, if set, is used to check the volumes and gigabytes
+	// quotas for the volumes created from the block device mappings.
+	BlockStorageClient *gophercloud.ServiceClient
+
+	// ImageClient, if set, is used to read the size of the images that
+	// volumes are created from when their block device mapping has no
+	// VolumeSize. Without it, such mappings make Check fail with
+	// ErrUnknownVolumeSize.
+	ImageClient *gophercloud.ServiceClient
+
+	// NetworkClient, if set, is used to check the port quota for the ports
+	// created on the requested networks.
+	NetworkClient *gophercloud.ServiceClient
+}
+
+// Check reads the quotas of the project and reports which of them the
+// request would exceed. No resource is created.
+func Check(ctx context.Context, client *gophercloud.ServiceClient, opts CheckOpts) (*Report, error) {
+	if opts.CreateOpts.FlavorRef == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "quotacheck.CheckOpts.CreateOpts.FlavorRef"
+		return nil, err
+	}
+
+	if opts.ProjectID == "" && (opts.BlockStorageClient != nil || opts.NetworkClient != nil) {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "quotacheck.CheckOpts.ProjectID"
+		err.Info = "ProjectID is required to check block storage and networking quotas"
+		return nil, err
+	}
+
+	minCount := max(opts.CreateOpts.Min, 1)
+	maxCount := max(opts.CreateOpts.Max, minCount)
+
+	flavor, err := flavors.Get(ctx, client, opts.CreateOpts.FlavorRef).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	var requirements []Requirement
+
+	compute, err := computeRequirements(ctx, client, opts.ProjectID, flavor)
+	if err != nil {
+		return nil, err
+	}
+	requirements = append(requirements, compute...)
+
+	if opts.BlockStorageClient != nil {
+		volume, err := blockStorageRequirements(ctx, opts.BlockStorageClient, opts.ImageClient, opts.ProjectID, opts.CreateOpts.BlockDevice)
+		if err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, volume...)
+	}
+
+	if opts.NetworkClient != nil {
+		network, err := networkRequirements(ctx, opts.NetworkClient, opts.ProjectID, opts.CreateOpts.Networks)
+		if err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, network...)
+	}
+
+	report := &Report{Instances: maxCount}
+	for _, r := range requirements {
+		r.Requested = r.PerInstance * minCount
+		report.Requirements = append(report.Requirements, r)
+
+		if fits := r.fits(); fits >= 0 && fits < report.Instances {
+			report.Instances = fits
+		}
+	}
+
+	if report.Instances < minCount {
+		report.Instances = 0
+	}
+
+	return report, nil
+}
+
+// computeRequirements returns the instances, cores and RAM needed by each
+// instance.
+func computeRequirements(ctx context.Context, client *gophercloud.ServiceClient, projectID string, flavor *flavors.Flavor) ([]Requirement, error) {
+	if projectID != "" {
+		q, err := quotasets.GetDetail(ctx, client, projectID).Extract()
+		if err != nil {
+			return nil, err
+		}
+
+		return []Requirement{
+			computeRequirement("instances", 1, q.Instances),
+			computeRequirement("cores", flavor.VCPUs, q.Cores),
+			computeRequirement("ram", flavor.RAM, q.RAM),
+		}, nil
+	}
+
+	l, err := limits.Get(ctx, client, nil).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	return []Requirement{
+		{
+			Service:     ServiceCompute,
+			Resource:    "instances",
+			PerInstance: 1,
+			InUse:       l.Absolute.TotalInstancesUsed,
+			Limit:       l.Absolute.MaxTotalInstances,
+		},
+		{
+			Service:     ServiceCompute,
+			Resource:    "cores",
+			PerInstance: flavor.VCPUs,
+			InUse:       l.Absolute.TotalCoresUsed,
+			Limit:       l.Absolute.MaxTotalCores,
+		},
+		{
+			Service:     ServiceCompute,
+			Resource:    "ram",
+			PerInstance: flavor.RAM,
+			InUse:       l.Absolute.TotalRAMUsed,
+			Limit:       l.Absolute.MaxTotalRAMSize,
+		},
+	}, nil
+}
+
+func computeRequirement(resource string, perInstance int, q quotasets.QuotaDetail) Requirement {
+	return Requirement{
+		Service:     ServiceCompute,
+		Resource:    resource,
+		PerInstance: perInstance,
+		InUse:       q.InUse,
+		Reserved:    q.Reserved,
+		Limit:       q.Limit,
+	}
+}
+
+// blockStorageRequirements returns the volumes and gigabytes needed by each
+// instance for the volumes created from its block device mappings, in total
+// and per volume type.
+func blockStorageRequirements(ctx context.Context, client, imageClient *gophercloud.ServiceClient, projectID string, blockDevices []servers.BlockDevice) ([]Requirement, error) {
+	volumes := make(map[string]int)
+	gigabytes := make(map[string]int)
+	var types []string
+
+	for i, bd := range blockDevices {
+		// Existing volumes are attached, not created.
+		if bd.DestinationType != servers.DestinationVolume || bd.SourceType == servers.SourceVolume {
+			continue
+		}
+
+		size, err := volumeSize(ctx, client, imageClient, i, bd)
+		if err != nil {
+			return nil, err
+		}
+
+		volumes[""]++
+		gigabytes[""] += size
+
+		if bd.VolumeType != "" {
+			if _, ok := volumes[bd.VolumeType]; !ok {
+				types = append(types, bd.VolumeType)
+			}
+			volumes[bd.VolumeType]++
+			gigabytes[bd.VolumeType] += size
+		}
+	}
+
+	if volumes[""] == 0 {
+		return nil, nil
+	}
+
+	q, err := blockstoragequotasets.GetUsage(ctx, client, projectID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	requirements := []Requirement{
+		blockStorageRequirement("volumes", volumes[""], q.Volumes),
+		blockStorageRequirement("gigabytes", gigabytes[""], q.Gigabytes),
+	}
+
+	for _, volumeType := range types {
+		if usage, ok := q.Extra["volumes_"+volumeType]; ok {
+			requirements = append(requirements, blockStorageRequirement("volumes_"+volumeType, volumes[volumeType], usage))
+		}
+		if usage, ok := q.Extra["gigabytes_"+volumeType]; ok {
+			requirements = append(requirements, blockStorageRequirement("gigabytes_"+volumeType, gigabytes[volumeType], usage))
+		}
+	}
+
+	return requirements, nil
+}
+
+// volumeSize returns the size in GB of the volume created for a block device
+// mapping. When VolumeSize is not set, Cinder sizes the volume after its
+// source, so the size of the snapshot or image is used instead.
+func volumeSize(ctx context.Context, client, imageClient *gophercloud.ServiceClient, index int, bd servers.BlockDevice) (int, error) {
+	if bd.VolumeSize > 0 {
+		return bd.VolumeSize, nil
+	}
+
+	switch bd.SourceType {
+	case servers.SourceSnapshot:
+		snapshot, err := snapshots.Get(ctx, client, bd.UUID).Extract()
+		if err != nil {
+			return 0, err
+		}
+		return snapshot.Size, nil
+	case servers.SourceImage:
+		if imageClient == nil {
+			break
+		}
+
+		image, err := images.Get(ctx, imageClient, bd.UUID).Extract()
+		if err != nil {
+			return 0, err
+		}
+
+		size := image.VirtualSize
+		if size == 0 {
+			size = image.SizeBytes
+		}
+		return max(image.MinDiskGigabytes, int((size+gibibyte-1)/gibibyte)), nil
+	}
+
+	return 0, ErrUnknownVolumeSize{Index: index, SourceType: bd.SourceType}
+}
+
+// gibibyte is the unit of volume sizes.
+const gibibyte = 1 << 30
+
+func blockStorageRequirement(resource string, perInstance int, q blockstoragequotasets.QuotaUsage) Requirement {
+	return Requirement{
+		Service:     ServiceBlockStorage,
+		Resource:    resource,
+		PerInstance: perInstance,
+		InUse:       q.InUse,
+		Reserved:    q.Reserved,
+		Limit:       q.Limit,
+	}
+}
+
+// networkRequirements returns the ports needed by each instance.
+func networkRequirements(ctx context.Context, client *gophercloud.ServiceClient, projectID string, networks any) ([]Requirement, error) {
+	ports := 0
+	switch v := networks.(type) {
+	case []servers.Network:
+		for _, network := range v {
+			// Ports given by the caller already exist.
+			if network.Port == "" {
+				ports++
+			}
+		}
+	case string:
+		if v == "auto" {
+			ports = 1
+		}
+	case nil:
+		// Nova attaches the server to the only network available to the
+		// project, if any.
+		ports = 1
+	}
+
+	if ports == 0 {
+		return nil, nil
+	}
+
+	q, err := quotas.GetDetail(ctx, client, projectID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	return []Requirement{
+		{
+			Service:     ServiceNetwork,
+			Resource:    "port",
+			PerInstance: ports,
+			InUse:       q.Port.Used,
+			Reserved:    q.Port.Reserved,
+			Limit:       q.Port.Limit,
+		},
+	}, nil
+}
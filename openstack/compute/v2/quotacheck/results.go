@@ -0,0 +1,93 @@
+package quotacheck
+
+// Service is the service enforcing a quota.
+type Service string
+
+const (
+	ServiceCompute      Service = "compute"
+	ServiceBlockStorage Service = "blockstorage"
+	ServiceNetwork      Service = "network"
+)
+
+// Requirement is the amount of a quota-limited resource needed by a request,
+// along with the current state of the quota.
+type Requirement struct {
+	// Service is the service enforcing the quota.
+	Service Service
+
+	// Resource is the name of the quota, as used by the service, for example
+	// "cores", "gigabytes" or "port".
+	Resource string
+
+	// Requested is the amount needed by the minimum number of instances.
+	Requested int
+
+	// PerInstance is the amount needed by each instance.
+	PerInstance int
+
+	// InUse is the amount currently used by the project.
+	InUse int
+
+	// Reserved is the amount currently reserved by the project.
+	Reserved int
+
+	// Limit is the quota of the project. A negative value means unlimited.
+	Limit int
+}
+
+// Unlimited returns true if the quota does not limit the resource.
+func (r Requirement) Unlimited() bool {
+	return r.Limit < 0
+}
+
+// Available returns the amount that can still be used. It returns -1 if the
+// quota is unlimited.
+func (r Requirement) Available() int {
+	if r.Unlimited() {
+		return -1
+	}
+	return max(r.Limit-r.InUse-r.Reserved, 0)
+}
+
+// Exceeded returns true if the requested amount does not fit in the quota.
+func (r Requirement) Exceeded() bool {
+	return !r.Unlimited() && r.Requested > r.Available()
+}
+
+// fits returns how many instances fit in the quota.
+func (r Requirement) fits() int {
+	if r.Unlimited() || r.PerInstance == 0 {
+		return -1
+	}
+	return r.Available() / r.PerInstance
+}
+
+// Report is the outcome of a Check call.
+type Report struct {
+	// Requirements holds every quota checked.
+	Requirements []Requirement
+
+	// Instances is the number of instances that Nova would create, between
+	// the Min and Max of the request. It is 0 if the minimum does not fit.
+	Instances int
+}
+
+// Exceeded returns the requirements that do not fit in their quota.
+func (r Report) Exceeded() []Requirement {
+	var exceeded []Requirement
+	for _, requirement := range r.Requirements {
+		if requirement.Exceeded() {
+			exceeded = append(exceeded, requirement)
+		}
+	}
+	return exceeded
+}
+
+// Err returns an ErrQuotaExceeded if any quota would be exceeded, or nil.
+func (r Report) Err() error {
+	exceeded := r.Exceeded()
+	if len(exceeded) == 0 {
+		return nil
+	}
+	return ErrQuotaExceeded{Exceeded: exceeded}
+}
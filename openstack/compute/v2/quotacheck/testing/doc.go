@@ -0,0 +1,2 @@
+// quotacheck unit tests
+package testing
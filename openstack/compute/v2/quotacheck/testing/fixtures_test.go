@@ -0,0 +1,172 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+const (
+	projectID = "6f70656e737461636b20342065766572"
+	flavorID  = "1"
+
+	snapshotID = "2b7f4c1e-3d5a-4e6f-8a9b-0c1d2e3f4a5b"
+	imageID    = "f90f6034-2570-4974-8351-6b49732ef2eb"
+)
+
+// FlavorBody is a sample response to a flavors.Get call.
+const FlavorBody = `
+{
+    "flavor": {
+        "id": "1",
+        "name": "m1.medium",
+        "vcpus": 2,
+        "disk": 40,
+        "ram": 4096,
+        "swap": "",
+        "os-flavor-access:is_public": true,
+        "OS-FLV-EXT-DATA:ephemeral": 0
+    }
+}
+`
+
+// ComputeQuotaBody is a sample response to a quotasets.GetDetail call. It
+// leaves room for 4 instances, 7 cores and 20480 MB of RAM.
+const ComputeQuotaBody = `
+{
+    "quota_set": {
+        "id": "6f70656e737461636b20342065766572",
+        "instances": {
+            "in_use": 5,
+            "limit": 10,
+            "reserved": 1
+        },
+        "cores": {
+            "in_use": 12,
+            "limit": 20,
+            "reserved": 1
+        },
+        "ram": {
+            "in_use": 30720,
+            "limit": 51200,
+            "reserved": 0
+        }
+    }
+}
+`
+
+// LimitsBody is a sample response to a limits.Get call. It leaves room for
+// 2 instances and an unlimited number of cores.
+const LimitsBody = `
+{
+    "limits": {
+        "rate": [],
+        "absolute": {
+            "maxTotalInstances": 10,
+            "totalInstancesUsed": 8,
+            "maxTotalCores": -1,
+            "totalCoresUsed": 16,
+            "maxTotalRAMSize": 51200,
+            "totalRAMUsed": 32768
+        }
+    }
+}
+`
+
+// VolumeQuotaBody is a sample response to a blockstorage quotasets.GetUsage
+// call. It leaves room for 3 volumes, 100 gigabytes and 30 gigabytes of ssd
+// volumes.
+const VolumeQuotaBody = `
+{
+    "quota_set": {
+        "id": "6f70656e737461636b20342065766572",
+        "volumes": {
+            "in_use": 7,
+            "limit": 10,
+            "reserved": 0
+        },
+        "gigabytes": {
+            "in_use": 900,
+            "limit": 1000,
+            "reserved": 0
+        },
+        "volumes_ssd": {
+            "in_use": 1,
+            "limit": -1,
+            "reserved": 0
+        },
+        "gigabytes_ssd": {
+            "in_use": 70,
+            "limit": 100,
+            "reserved": 0
+        }
+    }
+}
+`
+
+// PortQuotaBody is a sample response to a networking quotas.GetDetail call.
+// It leaves room for 5 ports.
+const PortQuotaBody = `
+{
+    "quota": {
+        "port": {
+            "used": 44,
+            "limit": 50,
+            "reserved": 1
+        }
+    }
+}
+`
+
+// SnapshotBody is a sample response to a block storage snapshots.Get call.
+const SnapshotBody = `
+{
+    "snapshot": {
+        "id": "2b7f4c1e-3d5a-4e6f-8a9b-0c1d2e3f4a5b",
+        "name": "db-snapshot",
+        "status": "available",
+        "size": 15,
+        "volume_id": "d3b8c1e2-0f4a-4c6b-8e7d-9a1b2c3d4e5f"
+    }
+}
+`
+
+// ImageBody is a sample response to an image service images.Get call. Its
+// virtual size rounds up to 11 GB.
+const ImageBody = `
+{
+    "id": "f90f6034-2570-4974-8351-6b49732ef2eb",
+    "name": "ubuntu",
+    "status": "active",
+    "min_disk": 8,
+    "size": 1073741824,
+    "virtual_size": 11274289152
+}
+`
+
+// HandleQuotas registers handlers for the flavor, compute quotas, limits,
+// block storage quotas and networking quotas, all on the same fake server.
+func HandleQuotas(t *testing.T, fakeServer th.FakeServer) {
+	responses := map[string]string{
+		"/flavors/" + flavorID:                    FlavorBody,
+		"/os-quota-sets/" + projectID + "/detail": ComputeQuotaBody,
+		"/limits":                                LimitsBody,
+		"/os-quota-sets/" + projectID:            VolumeQuotaBody,
+		"/quotas/" + projectID + "/details.json": PortQuotaBody,
+		"/snapshots/" + snapshotID:               SnapshotBody,
+		"/images/" + imageID:                     ImageBody,
+	}
+
+	for path, body := range responses {
+		fakeServer.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprint(w, body)
+		})
+	}
+}
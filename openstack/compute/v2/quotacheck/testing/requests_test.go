@@ -0,0 +1,197 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/quotacheck"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestCheckFits(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleQuotas(t, fakeServer)
+
+	sc := client.ServiceClient(fakeServer)
+	opts := quotacheck.CheckOpts{
+		ProjectID: projectID,
+		CreateOpts: servers.CreateOpts{
+			Name:      "web",
+			FlavorRef: flavorID,
+			Min:       2,
+			Max:       5,
+			Networks: []servers.Network{
+				{UUID: "2f1e0d9c-8b7a-4654-9321-0fedcba98765"},
+				{Port: "9e5476bd-a4ec-4653-93d6-72c93aa682ba"},
+			},
+			BlockDevice: []servers.BlockDevice{
+				{
+					SourceType:      servers.SourceImage,
+					DestinationType: servers.DestinationVolume,
+					UUID:            "f90f6034-2570-4974-8351-6b49732ef2eb",
+					VolumeSize:      20,
+				},
+				{
+					SourceType:      servers.SourceVolume,
+					DestinationType: servers.DestinationVolume,
+					UUID:            "d3b8c1e2-0f4a-4c6b-8e7d-9a1b2c3d4e5f",
+				},
+			},
+		},
+		BlockStorageClient: sc,
+		NetworkClient:      sc,
+	}
+
+	report, err := quotacheck.Check(context.TODO(), sc, opts)
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, report.Err())
+
+	expected := []quotacheck.Requirement{
+		{Service: quotacheck.ServiceCompute, Resource: "instances", Requested: 2, PerInstance: 1, InUse: 5, Reserved: 1, Limit: 10},
+		{Service: quotacheck.ServiceCompute, Resource: "cores", Requested: 4, PerInstance: 2, InUse: 12, Reserved: 1, Limit: 20},
+		{Service: quotacheck.ServiceCompute, Resource: "ram", Requested: 8192, PerInstance: 4096, InUse: 30720, Limit: 51200},
+		{Service: quotacheck.ServiceBlockStorage, Resource: "volumes", Requested: 2, PerInstance: 1, InUse: 7, Limit: 10},
+		{Service: quotacheck.ServiceBlockStorage, Resource: "gigabytes", Requested: 40, PerInstance: 20, InUse: 900, Limit: 1000},
+		{Service: quotacheck.ServiceNetwork, Resource: "port", Requested: 2, PerInstance: 1, InUse: 44, Reserved: 1, Limit: 50},
+	}
+	th.CheckDeepEquals(t, expected, report.Requirements)
+
+	// Cores and volumes leave room for 3 of the 5 requested instances.
+	th.CheckEquals(t, 3, report.Instances)
+}
+
+func TestCheckExceeded(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleQuotas(t, fakeServer)
+
+	sc := client.ServiceClient(fakeServer)
+	opts := quotacheck.CheckOpts{
+		ProjectID: projectID,
+		CreateOpts: servers.CreateOpts{
+			Name:      "db",
+			FlavorRef: flavorID,
+			Min:       2,
+			Networks:  "none",
+			BlockDevice: []servers.BlockDevice{
+				{
+					SourceType:      servers.SourceBlank,
+					DestinationType: servers.DestinationVolume,
+					VolumeSize:      20,
+					VolumeType:      "ssd",
+				},
+			},
+		},
+		BlockStorageClient: sc,
+		NetworkClient:      sc,
+	}
+
+	report, err := quotacheck.Check(context.TODO(), sc, opts)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 0, report.Instances)
+	th.CheckEquals(t, 7, len(report.Requirements))
+
+	expected := []quotacheck.Requirement{
+		{Service: quotacheck.ServiceBlockStorage, Resource: "gigabytes_ssd", Requested: 40, PerInstance: 20, InUse: 70, Limit: 100},
+	}
+	th.CheckDeepEquals(t, expected, report.Exceeded())
+	th.CheckEquals(t, 30, report.Exceeded()[0].Available())
+
+	var quotaErr quotacheck.ErrQuotaExceeded
+	th.AssertEquals(t, true, errors.As(report.Err(), &quotaErr))
+	th.CheckEquals(t, "quota exceeded: blockstorage gigabytes_ssd (requested 40, available 30)", quotaErr.Error())
+}
+
+func TestCheckVolumeSizeFromSource(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleQuotas(t, fakeServer)
+
+	sc := client.ServiceClient(fakeServer)
+	opts := quotacheck.CheckOpts{
+		ProjectID: projectID,
+		CreateOpts: servers.CreateOpts{
+			Name:      "web",
+			FlavorRef: flavorID,
+			Networks:  "none",
+			BlockDevice: []servers.BlockDevice{
+				{
+					SourceType:      servers.SourceImage,
+					DestinationType: servers.DestinationVolume,
+					UUID:            imageID,
+				},
+				{
+					SourceType:      servers.SourceSnapshot,
+					DestinationType: servers.DestinationVolume,
+					UUID:            snapshotID,
+				},
+			},
+		},
+		BlockStorageClient: sc,
+		ImageClient:        sc,
+	}
+
+	report, err := quotacheck.Check(context.TODO(), sc, opts)
+	th.AssertNoErr(t, err)
+
+	gigabytes := report.Requirements[4]
+	th.CheckEquals(t, "gigabytes", gigabytes.Resource)
+	th.CheckEquals(t, 26, gigabytes.PerInstance)
+
+	// Without an image client, the size of the image cannot be read.
+	opts.ImageClient = nil
+	_, err = quotacheck.Check(context.TODO(), sc, opts)
+	var unknown quotacheck.ErrUnknownVolumeSize
+	th.AssertEquals(t, true, errors.As(err, &unknown))
+	th.CheckEquals(t, 0, unknown.Index)
+	th.CheckEquals(t, servers.SourceImage, unknown.SourceType)
+}
+
+func TestCheckLimits(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandleQuotas(t, fakeServer)
+
+	sc := client.ServiceClient(fakeServer)
+	opts := quotacheck.CheckOpts{
+		CreateOpts: servers.CreateOpts{
+			Name:      "batch",
+			FlavorRef: flavorID,
+			Min:       3,
+		},
+	}
+
+	report, err := quotacheck.Check(context.TODO(), sc, opts)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 0, report.Instances)
+
+	expected := []quotacheck.Requirement{
+		{Service: quotacheck.ServiceCompute, Resource: "instances", Requested: 3, PerInstance: 1, InUse: 8, Limit: 10},
+	}
+	th.CheckDeepEquals(t, expected, report.Exceeded())
+	th.CheckEquals(t, true, report.Requirements[1].Unlimited())
+	th.CheckEquals(t, -1, report.Requirements[1].Available())
+}
+
+func TestCheckRequiresProjectID(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	sc := client.ServiceClient(fakeServer)
+	opts := quotacheck.CheckOpts{
+		CreateOpts: servers.CreateOpts{
+			Name:      "web",
+			FlavorRef: flavorID,
+		},
+		NetworkClient: sc,
+	}
+
+	_, err := quotacheck.Check(context.TODO(), sc, opts)
+	var missing gophercloud.ErrMissingInput
+	th.AssertEquals(t, true, errors.As(err, &missing))
+}
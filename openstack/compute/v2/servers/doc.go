@@ -309,5 +309,29 @@ Example to Snapshot a Server
 	if err != nil {
 		panic(err)
 	}
+
+Example to Retrieve the Administrative Password of a Windows Server
+
+	pemBytes, err := os.ReadFile("id_rsa")
+	if err != nil {
+		panic(err)
+	}
+
+	privateKey, err := servers.ParsePasswordPrivateKey(pemBytes, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	serverID := "d9072956-1560-487c-97f2-18bdf65ec749"
+
+	password, err := servers.GetPassword(context.TODO(), computeClient, serverID).ExtractPasswordWithDecrypter(privateKey)
+	if err != nil {
+		panic(err)
+	}
+
+	err = servers.ClearPassword(context.TODO(), computeClient, serverID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
 */
 package servers
@@ -69,3 +69,14 @@ type ErrServerNotFound struct {
 func (e ErrServerNotFound) Error() string {
 	return fmt.Sprintf("I couldn't find server [%s]", e.ID)
 }
+
+// ErrPasswordKeyNotRSA is the error when the key given to decrypt a server
+// password is not an RSA key.
+type ErrPasswordKeyNotRSA struct {
+	gophercloud.BaseError
+	KeyType string
+}
+
+func (e ErrPasswordKeyNotRSA) Error() string {
+	return fmt.Sprintf("Server passwords can only be decrypted with an RSA key, got [%s]", e.KeyType)
+}
@@ -1068,6 +1068,15 @@ func GetPassword(ctx context.Context, client *gophercloud.ServiceClient, serverI
 	return
 }
 
+// ClearPassword removes the encrypted administrative password of a server
+// from the metadata server. It does not change the password on the server
+// itself.
+func ClearPassword(ctx context.Context, client *gophercloud.ServiceClient, serverID string) (r ClearPasswordResult) {
+	resp, err := client.Delete(ctx, passwordURL(client, serverID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
 // ShowConsoleOutputOptsBuilder is the interface types must satisfy in order to be
 // used as ShowConsoleOutput options
 type ShowConsoleOutputOptsBuilder interface {
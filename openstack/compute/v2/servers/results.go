@@ -1,9 +1,12 @@
 package servers
 
 import (
+	"crypto"
+	"crypto/rand"
 	"crypto/rsa"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"path"
@@ -11,6 +14,7 @@ import (
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/pagination"
+	"golang.org/x/crypto/ssh"
 )
 
 type serverResult struct {
@@ -95,6 +99,12 @@ type GetPasswordResult struct {
 	gophercloud.Result
 }
 
+// ClearPasswordResult is the response from a ClearPassword operation. Call
+// its ExtractErr method to determine if the call succeeded or failed.
+type ClearPasswordResult struct {
+	gophercloud.ErrResult
+}
+
 // ExtractPassword gets the encrypted password.
 // If privateKey != nil the password is decrypted with the private key.
 // If privateKey == nil the encrypted password is returned and can be decrypted
@@ -102,24 +112,49 @@ type GetPasswordResult struct {
 //
 //	echo '<pwd>' | base64 -D | openssl rsautl -decrypt -inkey <private_key>
 func (r GetPasswordResult) ExtractPassword(privateKey *rsa.PrivateKey) (string, error) {
+	if privateKey == nil {
+		return r.extractEncryptedPassword()
+	}
+	return r.ExtractPasswordWithDecrypter(privateKey)
+}
+
+// ExtractPasswordWithDecrypter gets the password and decrypts it with the
+// given RSA private key. Any crypto.Decrypter backed by an RSA key can be
+// used, for example a key held in a hardware security module or a key
+// management service. Use ParsePasswordPrivateKey to load a key from a PEM
+// file.
+//
+// An empty string is returned if no password has been set yet.
+func (r GetPasswordResult) ExtractPasswordWithDecrypter(decrypter crypto.Decrypter) (string, error) {
+	encryptedPassword, err := r.extractEncryptedPassword()
+	if err != nil || encryptedPassword == "" {
+		return encryptedPassword, err
+	}
+	return DecryptPassword(encryptedPassword, decrypter)
+}
+
+func (r GetPasswordResult) extractEncryptedPassword() (string, error) {
 	var s struct {
 		Password string `json:"password"`
 	}
 	err := r.ExtractInto(&s)
-	if err == nil && privateKey != nil && s.Password != "" {
-		return decryptPassword(s.Password, privateKey)
-	}
 	return s.Password, err
 }
 
-func decryptPassword(encryptedPassword string, privateKey *rsa.PrivateKey) (string, error) {
+// DecryptPassword decrypts a base64 encoded password, as returned by the
+// os-server-password API, with an RSA private key.
+func DecryptPassword(encryptedPassword string, decrypter crypto.Decrypter) (string, error) {
+	if _, ok := decrypter.Public().(*rsa.PublicKey); !ok {
+		return "", ErrPasswordKeyNotRSA{KeyType: fmt.Sprintf("%T", decrypter.Public())}
+	}
+
 	b64EncryptedPassword := make([]byte, base64.StdEncoding.DecodedLen(len(encryptedPassword)))
 
 	n, err := base64.StdEncoding.Decode(b64EncryptedPassword, []byte(encryptedPassword))
 	if err != nil {
 		return "", fmt.Errorf("failed to base64 decode encrypted password: %s", err)
 	}
-	password, err := rsa.DecryptPKCS1v15(nil, privateKey, b64EncryptedPassword[0:n])
+	password, err := decrypter.Decrypt(rand.Reader, b64EncryptedPassword[0:n], &rsa.PKCS1v15DecryptOptions{})
 	if err != nil {
 		return "", fmt.Errorf("failed to decrypt password: %s", err)
 	}
@@ -127,6 +162,30 @@ func decryptPassword(encryptedPassword string, privateKey *rsa.PrivateKey) (stri
 	return string(password), nil
 }
 
+// ParsePasswordPrivateKey parses a PEM encoded RSA private key to decrypt
+// server passwords with. PKCS#1 ("RSA PRIVATE KEY"), PKCS#8 ("PRIVATE KEY")
+// and OpenSSH ("OPENSSH PRIVATE KEY") keys are supported. The passphrase is
+// only used if the key is encrypted.
+//
+// Keys held by an ssh-agent can't be used: the agent protocol only allows
+// signing, not decryption.
+func ParsePasswordPrivateKey(pemBytes []byte, passphrase []byte) (crypto.Decrypter, error) {
+	key, err := ssh.ParseRawPrivateKey(pemBytes)
+	var missing *ssh.PassphraseMissingError
+	if errors.As(err, &missing) && len(passphrase) > 0 {
+		key, err = ssh.ParseRawPrivateKeyWithPassphrase(pemBytes, passphrase)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, ErrPasswordKeyNotRSA{KeyType: fmt.Sprintf("%T", key)}
+	}
+	return privateKey, nil
+}
+
 // ExtractImageID gets the ID of the newly created server image from the header.
 func (r CreateImageResult) ExtractImageID() (string, error) {
 	if r.Err != nil {
@@ -0,0 +1,110 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/ptr"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/v2/testhelper/contract"
+)
+
+func TestContract(t *testing.T) {
+	contract.Suite{
+		Package: "compute/v2/servers",
+		Requests: []contract.RequestCase{
+			{
+				Name:   "create",
+				Schema: "compute/v2/servers/create",
+				Build: func() (map[string]any, error) {
+					return servers.CreateOpts{
+						Name:      "derp",
+						ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
+						FlavorRef: "1",
+					}.ToServerCreateMap()
+				},
+			},
+			{
+				Name:   "create with all options",
+				Schema: "compute/v2/servers/create",
+				Build: func() (map[string]any, error) {
+					return servers.CreateOpts{
+						Name:             "derp",
+						ImageRef:         "f90f6034-2570-4974-8351-6b49732ef2eb",
+						FlavorRef:        "1",
+						SecurityGroups:   []string{"default"},
+						UserData:         []byte("#cloud-config\n"),
+						AvailabilityZone: "nova",
+						Networks: []servers.Network{
+							{UUID: "9a5d2f3e-4c55-4a3a-8b0d-0e6e5e3c4a8f", Tag: "nic1"},
+						},
+						Metadata:    map[string]string{"foo": "bar"},
+						Personality: servers.Personality{{Path: "/etc/motd", Contents: []byte("hello")}},
+						ConfigDrive: ptr.To(true),
+						AdminPass:   "secret",
+						Min:         1,
+						Max:         2,
+						Tags:        []string{"foo"},
+						Hostname:    "derp",
+						BlockDevice: []servers.BlockDevice{
+							{
+								SourceType:      servers.SourceImage,
+								DestinationType: servers.DestinationVolume,
+								UUID:            "f90f6034-2570-4974-8351-6b49732ef2eb",
+								VolumeSize:      10,
+							},
+						},
+						DiskConfig: servers.Manual,
+						KeyName:    "derp",
+					}.ToServerCreateMap()
+				},
+			},
+			{
+				Name:   "create without network",
+				Schema: "compute/v2/servers/create",
+				Build: func() (map[string]any, error) {
+					return servers.CreateOpts{
+						Name:      "derp",
+						FlavorRef: "1",
+						Networks:  "none",
+					}.ToServerCreateMap()
+				},
+			},
+			{
+				Name:   "update",
+				Schema: "compute/v2/servers/update",
+				Build: func() (map[string]any, error) {
+					return servers.UpdateOpts{
+						Name:     ptr.To("new-name"),
+						Hostname: ptr.To("new-hostname"),
+					}.ToServerUpdateMap()
+				},
+			},
+		},
+		Responses: []contract.ResponseCase{
+			{
+				Name:   "list",
+				Body:   ServerListBody,
+				Key:    "servers",
+				Target: &[]servers.Server{},
+				KnownGaps: []string{
+					"image",
+					"OS-SRV-USG:launched_at",
+					"OS-SRV-USG:terminated_at",
+					"config_drive",
+				},
+			},
+			{
+				Name:   "get",
+				Body:   SingleServerBody,
+				Key:    "server",
+				Target: &servers.Server{},
+				KnownGaps: []string{
+					"image",
+					"OS-SRV-USG:launched_at",
+					"OS-SRV-USG:terminated_at",
+					"config_drive",
+				},
+			},
+		},
+	}.Run(t)
+}
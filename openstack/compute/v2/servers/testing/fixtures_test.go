@@ -1324,6 +1324,16 @@ func HandlePasswordGetSuccessfully(t *testing.T, fakeServer th.FakeServer) {
 	})
 }
 
+// HandlePasswordClearSuccessfully sets up the test server to respond to a password Delete request.
+func HandlePasswordClearSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/servers/1234asdf/os-server-password", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
 // HandleServerWithTagsCreationSuccessfully sets up the test server to respond
 // to a server creation request with a given response.
 func HandleServerWithTagsCreationSuccessfully(t *testing.T, fakeServer th.FakeServer) {
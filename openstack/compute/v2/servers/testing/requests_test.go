@@ -849,6 +849,15 @@ func TestGetPassword(t *testing.T) {
 	th.AssertNoErr(t, res.Err)
 }
 
+func TestClearPassword(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+	HandlePasswordClearSuccessfully(t, fakeServer)
+
+	res := servers.ClearPassword(context.TODO(), client.ServiceClient(fakeServer), "1234asdf")
+	th.AssertNoErr(t, res.Err)
+}
+
 func TestRebootServer(t *testing.T) {
 	fakeServer := th.SetupHTTP()
 	defer fakeServer.Teardown()
@@ -0,0 +1,21 @@
+/*
+Package topology provides the ability to retrieve the NUMA topology of a
+server.
+
+This requires microversion 2.78 or later. The host NUMA node and CPU pinning
+of each guest NUMA node are only returned to administrators by default.
+
+Example to Get the Topology of a Server
+
+	computeClient.Microversion = "2.78"
+
+	serverTopology, err := topology.Get(context.TODO(), computeClient, serverID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, node := range serverTopology.Nodes {
+		fmt.Printf("vCPUs %v use %d MB of memory\n", node.VCPUSet, node.MemoryMB)
+	}
+*/
+package topology
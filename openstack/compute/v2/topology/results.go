@@ -0,0 +1,48 @@
+package topology
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// Topology represents the NUMA topology of a server.
+type Topology struct {
+	// Nodes are the guest NUMA nodes of the server.
+	Nodes []Node `json:"nodes"`
+
+	// PagesizeKB is the page size of the server memory, in KiB. It is nil
+	// when the server does not use huge pages.
+	PagesizeKB *int `json:"pagesize_kb"`
+}
+
+// Node represents a guest NUMA node of a server.
+type Node struct {
+	// CPUPinning maps the guest vCPUs of the node to host CPUs.
+	// This is only returned to administrators by default.
+	CPUPinning map[string]int `json:"cpu_pinning"`
+
+	// HostNode is the host NUMA node the guest node is placed on.
+	// This is only returned to administrators by default.
+	HostNode *int `json:"host_node"`
+
+	// MemoryMB is the amount of memory of the node, in MiB.
+	MemoryMB int `json:"memory_mb"`
+
+	// Siblings lists the sets of vCPUs that are thread siblings.
+	Siblings [][]int `json:"siblings"`
+
+	// VCPUSet is the list of guest vCPUs of the node.
+	VCPUSet []int `json:"vcpu_set"`
+}
+
+// GetResult is the response from a Get operation. Call its Extract method to
+// interpret it as a Topology.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as a Topology.
+func (r GetResult) Extract() (*Topology, error) {
+	var s Topology
+	err := r.ExtractInto(&s)
+	return &s, err
+}
@@ -0,0 +1,114 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/topology"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+const serverID = "4f1b6c2a-8d3e-4b5f-9a7c-1e2d3f4a5b6c"
+
+// GetResponse is a sample response to a Get request made by an
+// administrator.
+const GetResponse = `
+{
+    "nodes": [
+        {
+            "cpu_pinning": {
+                "0": 0,
+                "1": 5
+            },
+            "host_node": 0,
+            "memory_mb": 1024,
+            "siblings": [
+                [0, 1]
+            ],
+            "vcpu_set": [0, 1]
+        },
+        {
+            "cpu_pinning": {
+                "2": 1,
+                "3": 8
+            },
+            "host_node": 1,
+            "memory_mb": 2048,
+            "siblings": [
+                [2, 3]
+            ],
+            "vcpu_set": [2, 3]
+        }
+    ],
+    "pagesize_kb": 4
+}
+`
+
+// GetNonAdminResponse is a sample response to a Get request made by a
+// regular user.
+const GetNonAdminResponse = `
+{
+    "nodes": [
+        {
+            "memory_mb": 1024,
+            "siblings": [
+                [0, 1]
+            ],
+            "vcpu_set": [0, 1]
+        }
+    ],
+    "pagesize_kb": null
+}
+`
+
+var (
+	hostNode0  = 0
+	hostNode1  = 1
+	pagesizeKB = 4
+)
+
+// ExpectedTopology is the result of GetResponse.
+var ExpectedTopology = topology.Topology{
+	Nodes: []topology.Node{
+		{
+			CPUPinning: map[string]int{"0": 0, "1": 5},
+			HostNode:   &hostNode0,
+			MemoryMB:   1024,
+			Siblings:   [][]int{{0, 1}},
+			VCPUSet:    []int{0, 1},
+		},
+		{
+			CPUPinning: map[string]int{"2": 1, "3": 8},
+			HostNode:   &hostNode1,
+			MemoryMB:   2048,
+			Siblings:   [][]int{{2, 3}},
+			VCPUSet:    []int{2, 3},
+		},
+	},
+	PagesizeKB: &pagesizeKB,
+}
+
+// ExpectedNonAdminTopology is the result of GetNonAdminResponse.
+var ExpectedNonAdminTopology = topology.Topology{
+	Nodes: []topology.Node{
+		{
+			MemoryMB: 1024,
+			Siblings: [][]int{{0, 1}},
+			VCPUSet:  []int{0, 1},
+		},
+	},
+}
+
+// HandleGetSuccessfully sets up the test server to respond to a Get request
+// with the given body.
+func HandleGetSuccessfully(t *testing.T, fakeServer th.FakeServer, body string) {
+	fakeServer.Mux.HandleFunc("/servers/"+serverID+"/topology", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, body)
+	})
+}
@@ -0,0 +1,70 @@
+/*
+Package conntrackhelpers enables management and retrieval of the conntrack
+helpers of routers from the OpenStack Networking service, as provided by the
+l3-conntrack-helper extension.
+
+A conntrack helper enables a connection tracking helper, such as the FTP,
+TFTP or SIP application layer gateways, for the traffic of a protocol and
+port passing through a router.
+
+Example to List the Conntrack Helpers of a Router
+
+	routerID := "ae3d1b5e-4a8c-4b1e-9a21-6d0f5c2b7e41"
+	listOpts := conntrackhelpers.ListOpts{
+		Helper: "ftp",
+	}
+
+	allPages, err := conntrackhelpers.List(networkClient, routerID, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allHelpers, err := conntrackhelpers.ExtractConntrackHelpers(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, helper := range allHelpers {
+		fmt.Printf("%+v\n", helper)
+	}
+
+Example to Create a Conntrack Helper
+
+	routerID := "ae3d1b5e-4a8c-4b1e-9a21-6d0f5c2b7e41"
+	createOpts := conntrackhelpers.CreateOpts{
+		Protocol: "tcp",
+		Port:     21,
+		Helper:   "ftp",
+	}
+
+	helper, err := conntrackhelpers.Create(context.TODO(), networkClient, routerID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Conntrack Helper
+
+	routerID := "ae3d1b5e-4a8c-4b1e-9a21-6d0f5c2b7e41"
+	helperID := "6a5a0e8e-1b2f-4e3a-8b1c-7e5d3c2a1f09"
+
+	port := 2121
+	updateOpts := conntrackhelpers.UpdateOpts{
+		Port: &port,
+	}
+
+	helper, err := conntrackhelpers.Update(context.TODO(), networkClient, routerID, helperID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Conntrack Helper
+
+	routerID := "ae3d1b5e-4a8c-4b1e-9a21-6d0f5c2b7e41"
+	helperID := "6a5a0e8e-1b2f-4e3a-8b1c-7e5d3c2a1f09"
+
+	err := conntrackhelpers.Delete(context.TODO(), networkClient, routerID, helperID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package conntrackhelpers
@@ -0,0 +1,106 @@
+package conntrackhelpers
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ConntrackHelper represents a conntrack helper of a router.
+type ConntrackHelper struct {
+	// ID is the unique ID of the conntrack helper.
+	ID string `json:"id"`
+
+	// Protocol is the network protocol of the traffic.
+	Protocol string `json:"protocol"`
+
+	// Port is the network port of the traffic.
+	Port int `json:"port"`
+
+	// Helper is the name of the netfilter conntrack helper module.
+	Helper string `json:"helper"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a
+// ConntrackHelper.
+func (r commonResult) Extract() (*ConntrackHelper, error) {
+	var s ConntrackHelper
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v any) error {
+	return r.ExtractIntoStructPtr(v, "conntrack_helper")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a ConntrackHelper.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a ConntrackHelper.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a ConntrackHelper.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ConntrackHelperPage is the page returned by a pager when traversing over a
+// collection of conntrack helpers.
+type ConntrackHelperPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of conntrack helpers has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r ConntrackHelperPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"conntrack_helpers_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a ConntrackHelperPage struct is empty.
+func (r ConntrackHelperPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractConntrackHelpers(r)
+	return len(is) == 0, err
+}
+
+// ExtractConntrackHelpers accepts a Page struct, specifically a
+// ConntrackHelperPage struct, and extracts the elements into a slice of
+// ConntrackHelper structs.
+func ExtractConntrackHelpers(r pagination.Page) ([]ConntrackHelper, error) {
+	var s []ConntrackHelper
+	err := ExtractConntrackHelpersInto(r, &s)
+	return s, err
+}
+
+// ExtractConntrackHelpersInto extracts the elements into a slice of
+// ConntrackHelper structs.
+func ExtractConntrackHelpersInto(r pagination.Page, v any) error {
+	return r.(ConntrackHelperPage).ExtractIntoSlicePtr(v, "conntrack_helpers")
+}
@@ -0,0 +1,2 @@
+// conntrack helpers unit tests
+package testing
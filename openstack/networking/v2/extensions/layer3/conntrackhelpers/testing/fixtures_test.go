@@ -0,0 +1,83 @@
+package testing
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/conntrackhelpers"
+)
+
+const (
+	RouterID = "ae3d1b5e-4a8c-4b1e-9a21-6d0f5c2b7e41"
+	HelperID = "6a5a0e8e-1b2f-4e3a-8b1c-7e5d3c2a1f09"
+)
+
+const ListResponse = `
+{
+    "conntrack_helpers": [
+        {
+            "id": "6a5a0e8e-1b2f-4e3a-8b1c-7e5d3c2a1f09",
+            "protocol": "tcp",
+            "port": 21,
+            "helper": "ftp"
+        },
+        {
+            "id": "0f7e6d5c-4b3a-4291-8f7e-6d5c4b3a2910",
+            "protocol": "udp",
+            "port": 69,
+            "helper": "tftp"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "conntrack_helper": {
+        "id": "6a5a0e8e-1b2f-4e3a-8b1c-7e5d3c2a1f09",
+        "protocol": "tcp",
+        "port": 21,
+        "helper": "ftp"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "conntrack_helper": {
+        "protocol": "tcp",
+        "port": 21,
+        "helper": "ftp"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "conntrack_helper": {
+        "port": 2121
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "conntrack_helper": {
+        "id": "6a5a0e8e-1b2f-4e3a-8b1c-7e5d3c2a1f09",
+        "protocol": "tcp",
+        "port": 2121,
+        "helper": "ftp"
+    }
+}
+`
+
+var FTPHelper = conntrackhelpers.ConntrackHelper{
+	ID:       "6a5a0e8e-1b2f-4e3a-8b1c-7e5d3c2a1f09",
+	Protocol: "tcp",
+	Port:     21,
+	Helper:   "ftp",
+}
+
+var TFTPHelper = conntrackhelpers.ConntrackHelper{
+	ID:       "0f7e6d5c-4b3a-4291-8f7e-6d5c4b3a2910",
+	Protocol: "udp",
+	Port:     69,
+	Helper:   "tftp",
+}
@@ -0,0 +1,165 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/conntrackhelpers"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/routers/"+RouterID+"/conntrack_helpers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+
+	count := 0
+	err := conntrackhelpers.List(fake.ServiceClient(fakeServer), RouterID, nil).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := conntrackhelpers.ExtractConntrackHelpers(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []conntrackhelpers.ConntrackHelper{FTPHelper, TFTPHelper}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestListWithOpts(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/routers/"+RouterID+"/conntrack_helpers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"helper":   "ftp",
+			"protocol": "tcp",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+
+	listOpts := conntrackhelpers.ListOpts{
+		Protocol: "tcp",
+		Helper:   "ftp",
+	}
+
+	allPages, err := conntrackhelpers.List(fake.ServiceClient(fakeServer), RouterID, listOpts).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	_, err = conntrackhelpers.ExtractConntrackHelpers(allPages)
+	th.AssertNoErr(t, err)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/routers/"+RouterID+"/conntrack_helpers/"+HelperID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+
+	actual, err := conntrackhelpers.Get(context.TODO(), fake.ServiceClient(fakeServer), RouterID, HelperID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FTPHelper, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/routers/"+RouterID+"/conntrack_helpers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+
+	createOpts := conntrackhelpers.CreateOpts{
+		Protocol: "tcp",
+		Port:     21,
+		Helper:   "ftp",
+	}
+
+	actual, err := conntrackhelpers.Create(context.TODO(), fake.ServiceClient(fakeServer), RouterID, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FTPHelper, *actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	res := conntrackhelpers.Create(context.TODO(), fake.ServiceClient(fakeServer), RouterID, conntrackhelpers.CreateOpts{Protocol: "tcp", Port: 21})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/routers/"+RouterID+"/conntrack_helpers/"+HelperID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	port := 2121
+	updateOpts := conntrackhelpers.UpdateOpts{
+		Port: &port,
+	}
+
+	actual, err := conntrackhelpers.Update(context.TODO(), fake.ServiceClient(fakeServer), RouterID, HelperID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := FTPHelper
+	expected.Port = 2121
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/routers/"+RouterID+"/conntrack_helpers/"+HelperID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := conntrackhelpers.Delete(context.TODO(), fake.ServiceClient(fakeServer), RouterID, HelperID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -85,6 +85,14 @@ type CreateOpts struct {
 	ProjectID             string       `json:"project_id,omitempty"`
 	GatewayInfo           *GatewayInfo `json:"external_gateway_info,omitempty"`
 	AvailabilityZoneHints []string     `json:"availability_zone_hints,omitempty"`
+
+	// FlavorID is the ID of the router flavor. It requires the flavors
+	// extension and can only be set at creation.
+	FlavorID string `json:"flavor_id,omitempty"`
+
+	// EnableNDPProxy enables IPv6 NDP proxies on the router. It requires the
+	// l3-ndp-proxy extension.
+	EnableNDPProxy *bool `json:"enable_ndp_proxy,omitempty"`
 }
 
 // ToRouterCreateMap builds a create request body from CreateOpts.
@@ -133,6 +141,10 @@ type UpdateOpts struct {
 	GatewayInfo  *GatewayInfo `json:"external_gateway_info,omitempty"`
 	Routes       *[]Route     `json:"routes,omitempty"`
 
+	// EnableNDPProxy enables or disables IPv6 NDP proxies on the router. It
+	// requires the l3-ndp-proxy extension.
+	EnableNDPProxy *bool `json:"enable_ndp_proxy,omitempty"`
+
 	// RevisionNumber implements extension:standard-attr-revisions. If != "" it
 	// will set revision_number=%s. If the revision number does not match, the
 	// update will fail.
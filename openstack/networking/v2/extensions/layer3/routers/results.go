@@ -75,6 +75,16 @@ type Router struct {
 	// Used to make network resources highly available.
 	AvailabilityZoneHints []string `json:"availability_zone_hints"`
 
+	// AvailabilityZones are the availability zones the router is scheduled
+	// to.
+	AvailabilityZones []string `json:"availability_zones"`
+
+	// FlavorID is the ID of the router flavor.
+	FlavorID string `json:"flavor_id"`
+
+	// EnableNDPProxy is whether IPv6 NDP proxies are enabled on the router.
+	EnableNDPProxy bool `json:"enable_ndp_proxy"`
+
 	// Tags optionally set via extensions/attributestags
 	Tags []string `json:"tags"`
 
@@ -221,6 +221,65 @@ func TestCreate(t *testing.T) {
 	th.AssertDeepEquals(t, []string{"zone1", "zone2"}, r.AvailabilityZoneHints)
 }
 
+func TestCreateWithFlavorAndNDPProxy(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/routers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "router": {
+        "name": "foo_router",
+        "availability_zone_hints": ["zone1"],
+        "flavor_id": "5b8d1f4c-7e2a-4b3c-9f6d-0a1e2c3d4f5a",
+        "enable_ndp_proxy": true
+    }
+}
+			`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, `
+{
+    "router": {
+        "status": "ACTIVE",
+        "external_gateway_info": null,
+        "name": "foo_router",
+        "admin_state_up": true,
+        "tenant_id": "6b96ff0cb17a4b859e1e575d221683d3",
+        "distributed": false,
+        "availability_zone_hints": ["zone1"],
+        "availability_zones": ["zone1"],
+        "flavor_id": "5b8d1f4c-7e2a-4b3c-9f6d-0a1e2c3d4f5a",
+        "enable_ndp_proxy": true,
+        "id": "8604a0de-7f6b-409a-a47c-a1cc7bc77b2e"
+    }
+}
+		`)
+	})
+
+	enableNDPProxy := true
+	options := routers.CreateOpts{
+		Name:                  "foo_router",
+		AvailabilityZoneHints: []string{"zone1"},
+		FlavorID:              "5b8d1f4c-7e2a-4b3c-9f6d-0a1e2c3d4f5a",
+		EnableNDPProxy:        &enableNDPProxy,
+	}
+	r, err := routers.Create(context.TODO(), fake.ServiceClient(fakeServer), options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "foo_router", r.Name)
+	th.AssertDeepEquals(t, []string{"zone1"}, r.AvailabilityZoneHints)
+	th.AssertDeepEquals(t, []string{"zone1"}, r.AvailabilityZones)
+	th.AssertEquals(t, "5b8d1f4c-7e2a-4b3c-9f6d-0a1e2c3d4f5a", r.FlavorID)
+	th.AssertEquals(t, true, r.EnableNDPProxy)
+}
+
 func TestGet(t *testing.T) {
 	fakeServer := th.SetupHTTP()
 	defer fakeServer.Teardown()
@@ -355,6 +414,50 @@ func TestUpdate(t *testing.T) {
 	th.AssertDeepEquals(t, n.Routes, []routers.Route{{DestinationCIDR: "40.0.1.0/24", NextHop: "10.1.0.10"}})
 }
 
+func TestUpdateEnableNDPProxy(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/routers/4e8e5957-649f-477b-9e5b-f1f75b21c03c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "router": {
+        "enable_ndp_proxy": false
+    }
+}
+			`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, `
+{
+    "router": {
+        "status": "ACTIVE",
+        "external_gateway_info": null,
+        "name": "foo_router",
+        "admin_state_up": true,
+        "tenant_id": "6b96ff0cb17a4b859e1e575d221683d3",
+        "distributed": false,
+        "enable_ndp_proxy": false,
+        "id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+    }
+}
+		`)
+	})
+
+	enableNDPProxy := false
+	options := routers.UpdateOpts{EnableNDPProxy: &enableNDPProxy}
+
+	n, err := routers.Update(context.TODO(), fake.ServiceClient(fakeServer), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", options).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, n.EnableNDPProxy)
+}
+
 func TestUpdateWithoutRoutes(t *testing.T) {
 	fakeServer := th.SetupHTTP()
 	defer fakeServer.Teardown()
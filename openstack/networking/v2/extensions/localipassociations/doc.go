@@ -0,0 +1,46 @@
+/*
+Package localipassociations manages the associations between Local IPs and
+ports in the OpenStack Networking Service, as provided by the local_ip
+extension.
+
+Example to List the Ports Associated with a Local IP
+
+	localIPID := "f0d79a9e-5d1f-4c9a-b1d5-6e2b2f06b1a3"
+
+	allPages, err := localipassociations.List(networkClient, localIPID, nil).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allAssociations, err := localipassociations.ExtractAssociations(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, association := range allAssociations {
+		fmt.Printf("%+v\n", association)
+	}
+
+Example to Associate a Local IP with a Port
+
+	localIPID := "f0d79a9e-5d1f-4c9a-b1d5-6e2b2f06b1a3"
+	createOpts := localipassociations.CreateOpts{
+		FixedPortID: "c5a2d8e3-7a4b-4a32-9bd2-7f1a6e5d4c3b",
+	}
+
+	association, err := localipassociations.Create(context.TODO(), networkClient, localIPID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Disassociate a Local IP from a Port
+
+	localIPID := "f0d79a9e-5d1f-4c9a-b1d5-6e2b2f06b1a3"
+	portID := "c5a2d8e3-7a4b-4a32-9bd2-7f1a6e5d4c3b"
+
+	err := localipassociations.Delete(context.TODO(), networkClient, localIPID, portID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package localipassociations
@@ -0,0 +1,95 @@
+package localipassociations
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToAssociationListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the association attributes you want to see returned. SortKey allows you to
+// sort by a particular attribute. SortDir sets the direction, and is either
+// `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	LocalIPAddress string `q:"local_ip_address"`
+	FixedPortID    string `q:"fixed_port_id"`
+	FixedIP        string `q:"fixed_ip"`
+	Host           string `q:"host"`
+	Limit          int    `q:"limit"`
+	Marker         string `q:"marker"`
+	SortKey        string `q:"sort_key"`
+	SortDir        string `q:"sort_dir"`
+	Fields         string `q:"fields"`
+}
+
+// ToAssociationListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToAssociationListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over the ports associated
+// with a Local IP. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, localIPID string, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c, localIPID)
+	if opts != nil {
+		query, err := opts.ToAssociationListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return AssociationPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToAssociationCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to associate a Local IP with a
+// port.
+type CreateOpts struct {
+	// FixedPortID is the port to associate the Local IP with.
+	FixedPortID string `json:"fixed_port_id" required:"true"`
+
+	// FixedIP selects the fixed IP of the port the Local IP is translated
+	// to. It is required when the port has several fixed IPs.
+	FixedIP string `json:"fixed_ip,omitempty"`
+}
+
+// ToAssociationCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToAssociationCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "port_association")
+}
+
+// Create associates a Local IP with a port.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, localIPID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToAssociationCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c, localIPID), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete removes the association between a Local IP and a port.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, localIPID, fixedPortID string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, localIPID, fixedPortID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,91 @@
+package localipassociations
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Association represents the association between a Local IP and a port.
+type Association struct {
+	// LocalIPID is the ID of the Local IP.
+	LocalIPID string `json:"local_ip_id"`
+
+	// LocalIPAddress is the IP address of the Local IP.
+	LocalIPAddress string `json:"local_ip_address"`
+
+	// FixedPortID is the ID of the associated port.
+	FixedPortID string `json:"fixed_port_id"`
+
+	// FixedIP is the fixed IP of the port the Local IP is translated to.
+	FixedIP string `json:"fixed_ip"`
+
+	// Host is the compute node the associated port is bound to.
+	Host string `json:"host"`
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as an Association.
+type CreateResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts an Association.
+func (r CreateResult) Extract() (*Association, error) {
+	var s Association
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r CreateResult) ExtractInto(v any) error {
+	return r.ExtractIntoStructPtr(v, "port_association")
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AssociationPage is the page returned by a pager when traversing over a
+// collection of associations.
+type AssociationPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of associations has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r AssociationPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"port_associations_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether an AssociationPage struct is empty.
+func (r AssociationPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractAssociations(r)
+	return len(is) == 0, err
+}
+
+// ExtractAssociations accepts a Page struct, specifically an AssociationPage
+// struct, and extracts the elements into a slice of Association structs.
+func ExtractAssociations(r pagination.Page) ([]Association, error) {
+	var s []Association
+	err := ExtractAssociationsInto(r, &s)
+	return s, err
+}
+
+// ExtractAssociationsInto extracts the elements into a slice of Association
+// structs.
+func ExtractAssociationsInto(r pagination.Page, v any) error {
+	return r.(AssociationPage).ExtractIntoSlicePtr(v, "port_associations")
+}
@@ -0,0 +1,2 @@
+// localipassociations unit tests
+package testing
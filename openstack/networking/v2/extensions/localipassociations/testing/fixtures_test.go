@@ -0,0 +1,53 @@
+package testing
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/localipassociations"
+)
+
+const (
+	LocalIPID = "f0d79a9e-5d1f-4c9a-b1d5-6e2b2f06b1a3"
+	PortID    = "c5a2d8e3-7a4b-4a32-9bd2-7f1a6e5d4c3b"
+)
+
+const ListResponse = `
+{
+    "port_associations": [
+        {
+            "local_ip_id": "f0d79a9e-5d1f-4c9a-b1d5-6e2b2f06b1a3",
+            "local_ip_address": "10.0.0.250",
+            "fixed_port_id": "c5a2d8e3-7a4b-4a32-9bd2-7f1a6e5d4c3b",
+            "fixed_ip": "192.168.1.12",
+            "host": "compute-1"
+        }
+    ]
+}
+`
+
+const CreateRequest = `
+{
+    "port_association": {
+        "fixed_port_id": "c5a2d8e3-7a4b-4a32-9bd2-7f1a6e5d4c3b",
+        "fixed_ip": "192.168.1.12"
+    }
+}
+`
+
+const CreateResponse = `
+{
+    "port_association": {
+        "local_ip_id": "f0d79a9e-5d1f-4c9a-b1d5-6e2b2f06b1a3",
+        "local_ip_address": "10.0.0.250",
+        "fixed_port_id": "c5a2d8e3-7a4b-4a32-9bd2-7f1a6e5d4c3b",
+        "fixed_ip": "192.168.1.12",
+        "host": "compute-1"
+    }
+}
+`
+
+var Association1 = localipassociations.Association{
+	LocalIPID:      "f0d79a9e-5d1f-4c9a-b1d5-6e2b2f06b1a3",
+	LocalIPAddress: "10.0.0.250",
+	FixedPortID:    "c5a2d8e3-7a4b-4a32-9bd2-7f1a6e5d4c3b",
+	FixedIP:        "192.168.1.12",
+	Host:           "compute-1",
+}
@@ -0,0 +1,16 @@
+package localipassociations
+
+import "github.com/gophercloud/gophercloud/v2"
+
+const (
+	localIPsPath         = "local_ips"
+	portAssociationsPath = "port_associations"
+)
+
+func rootURL(c *gophercloud.ServiceClient, localIPID string) string {
+	return c.ServiceURL(localIPsPath, localIPID, portAssociationsPath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, localIPID, fixedPortID string) string {
+	return c.ServiceURL(localIPsPath, localIPID, portAssociationsPath, fixedPortID)
+}
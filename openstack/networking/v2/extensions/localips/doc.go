@@ -0,0 +1,62 @@
+/*
+Package localips manages and retrieves Local IPs in the OpenStack Networking
+Service, as provided by the local_ip extension.
+
+A Local IP is a virtual IP address that can be associated with several ports
+at once, and that is only reachable from the compute node those ports are
+bound to. Use the localipassociations package to associate a Local IP with a
+port.
+
+Example to List Local IPs
+
+	listOpts := localips.ListOpts{
+		NetworkID: "2bf7fba1-2b91-4e26-8d91-b0b8c2d4bcb2",
+	}
+
+	allPages, err := localips.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allLocalIPs, err := localips.ExtractLocalIPs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, localIP := range allLocalIPs {
+		fmt.Printf("%+v\n", localIP)
+	}
+
+Example to Create a Local IP
+
+	createOpts := localips.CreateOpts{
+		Name:      "dns-cache",
+		NetworkID: "2bf7fba1-2b91-4e26-8d91-b0b8c2d4bcb2",
+		IPMode:    localips.IPModeTranslate,
+	}
+
+	localIP, err := localips.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Local IP
+
+	description := "Node-local DNS cache"
+	updateOpts := localips.UpdateOpts{
+		Description: &description,
+	}
+
+	localIP, err := localips.Update(context.TODO(), networkClient, "f0d79a9e-5d1f-4c9a-b1d5-6e2b2f06b1a3", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Local IP
+
+	err := localips.Delete(context.TODO(), networkClient, "f0d79a9e-5d1f-4c9a-b1d5-6e2b2f06b1a3").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package localips
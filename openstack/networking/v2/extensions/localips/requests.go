@@ -0,0 +1,166 @@
+package localips
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// IPMode is the way traffic to a Local IP reaches the associated port.
+type IPMode string
+
+const (
+	// IPModeTranslate translates the Local IP to the fixed IP of the port.
+	IPModeTranslate IPMode = "translate"
+
+	// IPModePassthrough delivers the traffic to the port unchanged.
+	IPModePassthrough IPMode = "passthrough"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToLocalIPListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the Local IP attributes you want to see returned. SortKey allows you to sort
+// by a particular Local IP attribute. SortDir sets the direction, and is
+// either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID             string `q:"id"`
+	Name           string `q:"name"`
+	Description    string `q:"description"`
+	TenantID       string `q:"tenant_id"`
+	ProjectID      string `q:"project_id"`
+	LocalPortID    string `q:"local_port_id"`
+	NetworkID      string `q:"network_id"`
+	LocalIPAddress string `q:"local_ip_address"`
+	IPMode         IPMode `q:"ip_mode"`
+	RevisionNumber *int   `q:"revision_number"`
+	Limit          int    `q:"limit"`
+	Marker         string `q:"marker"`
+	SortKey        string `q:"sort_key"`
+	SortDir        string `q:"sort_dir"`
+	Fields         string `q:"fields"`
+}
+
+// ToLocalIPListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToLocalIPListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// Local IPs. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToLocalIPListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return LocalIPPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific Local IP based on its ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToLocalIPCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new Local IP. Either
+// NetworkID or LocalPortID must be set.
+type CreateOpts struct {
+	// Name is the human-readable name of the Local IP.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human-readable description of the Local IP.
+	Description string `json:"description,omitempty"`
+
+	// ProjectID is the owner of the Local IP. Only administrators can set it.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// NetworkID is the network to allocate a new port for the Local IP on.
+	NetworkID string `json:"network_id,omitempty"`
+
+	// LocalPortID is an existing port whose fixed IP becomes the Local IP.
+	LocalPortID string `json:"local_port_id,omitempty"`
+
+	// LocalIPAddress selects the address among the fixed IPs of the port.
+	LocalIPAddress string `json:"local_ip_address,omitempty"`
+
+	// IPMode is the way traffic reaches the associated ports. Neutron
+	// defaults to IPModeTranslate.
+	IPMode IPMode `json:"ip_mode,omitempty"`
+}
+
+// ToLocalIPCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToLocalIPCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "local_ip")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// Local IP.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToLocalIPCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToLocalIPUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contains the values used when updating a Local IP.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToLocalIPUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToLocalIPUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "local_ip")
+}
+
+// Update allows Local IPs to be updated.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToLocalIPUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will permanently delete a Local IP based on its ID.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,129 @@
+package localips
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// LocalIP represents a Local IP.
+type LocalIP struct {
+	// ID is the unique ID of the Local IP.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the Local IP.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the Local IP.
+	Description string `json:"description"`
+
+	// TenantID is the project owner of the Local IP.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the Local IP.
+	ProjectID string `json:"project_id"`
+
+	// LocalPortID is the port holding the Local IP.
+	LocalPortID string `json:"local_port_id"`
+
+	// NetworkID is the network of the Local IP.
+	NetworkID string `json:"network_id"`
+
+	// LocalIPAddress is the IP address of the Local IP.
+	LocalIPAddress string `json:"local_ip_address"`
+
+	// IPMode is the way traffic reaches the associated ports.
+	IPMode IPMode `json:"ip_mode"`
+
+	// RevisionNumber is the revision number of the Local IP.
+	RevisionNumber int `json:"revision_number"`
+
+	// CreatedAt is the time the Local IP was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the time the Local IP was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a LocalIP.
+func (r commonResult) Extract() (*LocalIP, error) {
+	var s LocalIP
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v any) error {
+	return r.ExtractIntoStructPtr(v, "local_ip")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a LocalIP.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a LocalIP.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a LocalIP.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// LocalIPPage is the page returned by a pager when traversing over a
+// collection of Local IPs.
+type LocalIPPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of Local IPs has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r LocalIPPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"local_ips_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a LocalIPPage struct is empty.
+func (r LocalIPPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractLocalIPs(r)
+	return len(is) == 0, err
+}
+
+// ExtractLocalIPs accepts a Page struct, specifically a LocalIPPage struct,
+// and extracts the elements into a slice of LocalIP structs.
+func ExtractLocalIPs(r pagination.Page) ([]LocalIP, error) {
+	var s []LocalIP
+	err := ExtractLocalIPsInto(r, &s)
+	return s, err
+}
+
+// ExtractLocalIPsInto extracts the elements into a slice of LocalIP structs.
+func ExtractLocalIPsInto(r pagination.Page, v any) error {
+	return r.(LocalIPPage).ExtractIntoSlicePtr(v, "local_ips")
+}
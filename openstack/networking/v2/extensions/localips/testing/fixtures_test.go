@@ -0,0 +1,100 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/localips"
+)
+
+const LocalIPID = "f0d79a9e-5d1f-4c9a-b1d5-6e2b2f06b1a3"
+
+const ListResponse = `
+{
+    "local_ips": [
+        {
+            "id": "f0d79a9e-5d1f-4c9a-b1d5-6e2b2f06b1a3",
+            "name": "dns-cache",
+            "description": "",
+            "tenant_id": "26a7980765d0414dbc1fc1f88cdb7e6e",
+            "project_id": "26a7980765d0414dbc1fc1f88cdb7e6e",
+            "local_port_id": "9a3d1b5e-0f3c-4c4c-8b9e-4d7a5c2e1f00",
+            "network_id": "2bf7fba1-2b91-4e26-8d91-b0b8c2d4bcb2",
+            "local_ip_address": "10.0.0.250",
+            "ip_mode": "translate",
+            "revision_number": 0,
+            "created_at": "2024-04-10T14:00:00Z",
+            "updated_at": "2024-04-10T14:00:00Z"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "local_ip": {
+        "id": "f0d79a9e-5d1f-4c9a-b1d5-6e2b2f06b1a3",
+        "name": "dns-cache",
+        "description": "",
+        "tenant_id": "26a7980765d0414dbc1fc1f88cdb7e6e",
+        "project_id": "26a7980765d0414dbc1fc1f88cdb7e6e",
+        "local_port_id": "9a3d1b5e-0f3c-4c4c-8b9e-4d7a5c2e1f00",
+        "network_id": "2bf7fba1-2b91-4e26-8d91-b0b8c2d4bcb2",
+        "local_ip_address": "10.0.0.250",
+        "ip_mode": "translate",
+        "revision_number": 0,
+        "created_at": "2024-04-10T14:00:00Z",
+        "updated_at": "2024-04-10T14:00:00Z"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "local_ip": {
+        "name": "dns-cache",
+        "network_id": "2bf7fba1-2b91-4e26-8d91-b0b8c2d4bcb2",
+        "local_ip_address": "10.0.0.250",
+        "ip_mode": "translate"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "local_ip": {
+        "description": "Node-local DNS cache"
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "local_ip": {
+        "id": "f0d79a9e-5d1f-4c9a-b1d5-6e2b2f06b1a3",
+        "name": "dns-cache",
+        "description": "Node-local DNS cache",
+        "tenant_id": "26a7980765d0414dbc1fc1f88cdb7e6e",
+        "project_id": "26a7980765d0414dbc1fc1f88cdb7e6e",
+        "local_port_id": "9a3d1b5e-0f3c-4c4c-8b9e-4d7a5c2e1f00",
+        "network_id": "2bf7fba1-2b91-4e26-8d91-b0b8c2d4bcb2",
+        "local_ip_address": "10.0.0.250",
+        "ip_mode": "translate",
+        "revision_number": 1,
+        "created_at": "2024-04-10T14:00:00Z",
+        "updated_at": "2024-04-11T10:00:00Z"
+    }
+}
+`
+
+var LocalIP1 = localips.LocalIP{
+	ID:             "f0d79a9e-5d1f-4c9a-b1d5-6e2b2f06b1a3",
+	Name:           "dns-cache",
+	TenantID:       "26a7980765d0414dbc1fc1f88cdb7e6e",
+	ProjectID:      "26a7980765d0414dbc1fc1f88cdb7e6e",
+	LocalPortID:    "9a3d1b5e-0f3c-4c4c-8b9e-4d7a5c2e1f00",
+	NetworkID:      "2bf7fba1-2b91-4e26-8d91-b0b8c2d4bcb2",
+	LocalIPAddress: "10.0.0.250",
+	IPMode:         localips.IPModeTranslate,
+	CreatedAt:      time.Date(2024, 4, 10, 14, 0, 0, 0, time.UTC),
+	UpdatedAt:      time.Date(2024, 4, 10, 14, 0, 0, 0, time.UTC),
+}
@@ -0,0 +1,139 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/localips"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/local_ips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"network_id": "2bf7fba1-2b91-4e26-8d91-b0b8c2d4bcb2",
+			"ip_mode":    "translate",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+
+	listOpts := localips.ListOpts{
+		NetworkID: "2bf7fba1-2b91-4e26-8d91-b0b8c2d4bcb2",
+		IPMode:    localips.IPModeTranslate,
+	}
+
+	count := 0
+	err := localips.List(fake.ServiceClient(fakeServer), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := localips.ExtractLocalIPs(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []localips.LocalIP{LocalIP1}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/local_ips/"+LocalIPID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+
+	actual, err := localips.Get(context.TODO(), fake.ServiceClient(fakeServer), LocalIPID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, LocalIP1, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/local_ips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+
+	createOpts := localips.CreateOpts{
+		Name:           "dns-cache",
+		NetworkID:      "2bf7fba1-2b91-4e26-8d91-b0b8c2d4bcb2",
+		LocalIPAddress: "10.0.0.250",
+		IPMode:         localips.IPModeTranslate,
+	}
+
+	actual, err := localips.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, LocalIP1, *actual)
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/local_ips/"+LocalIPID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	description := "Node-local DNS cache"
+	updateOpts := localips.UpdateOpts{
+		Description: &description,
+	}
+
+	actual, err := localips.Update(context.TODO(), fake.ServiceClient(fakeServer), LocalIPID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := LocalIP1
+	expected.Description = "Node-local DNS cache"
+	expected.RevisionNumber = 1
+	expected.UpdatedAt = time.Date(2024, 4, 11, 10, 0, 0, 0, time.UTC)
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/local_ips/"+LocalIPID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := localips.Delete(context.TODO(), fake.ServiceClient(fakeServer), LocalIPID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,13 @@
+package localips
+
+import "github.com/gophercloud/gophercloud/v2"
+
+const resourcePath = "local_ips"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
@@ -0,0 +1,77 @@
+/*
+Package logging manages and retrieves network logs in the OpenStack Networking
+Service, as provided by the logging extension.
+
+Network logs record the packets accepted or dropped by security groups and
+firewall groups.
+
+Example to List Loggable Resource Types
+
+	allPages, err := logging.ListLoggableResources(networkClient).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allResources, err := logging.ExtractLoggableResources(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, resource := range allResources {
+		fmt.Printf("%s\n", resource.Type)
+	}
+
+Example to Log the Dropped Packets of a Security Group
+
+	createOpts := logging.CreateOpts{
+		Name:         "sg-drops",
+		ResourceType: logging.ResourceTypeSecurityGroup,
+		ResourceID:   "80c86fc4-6c48-4b4a-9f2a-0d7b6ba1d7e5",
+		Event:        logging.EventDrop,
+	}
+
+	log, err := logging.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Logs
+
+	listOpts := logging.ListOpts{
+		ResourceType: logging.ResourceTypeSecurityGroup,
+	}
+
+	allPages, err := logging.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allLogs, err := logging.ExtractLogs(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, log := range allLogs {
+		fmt.Printf("%+v\n", log)
+	}
+
+Example to Disable a Log
+
+	enabled := false
+	updateOpts := logging.UpdateOpts{
+		Enabled: &enabled,
+	}
+
+	log, err := logging.Update(context.TODO(), networkClient, "2f245a7b-796b-4f26-9cf9-9e82d248fda7", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Log
+
+	err := logging.Delete(context.TODO(), networkClient, "2f245a7b-796b-4f26-9cf9-9e82d248fda7").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package logging
@@ -0,0 +1,183 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Event is the type of packets a log records.
+type Event string
+
+const (
+	EventAll    Event = "ALL"
+	EventAccept Event = "ACCEPT"
+	EventDrop   Event = "DROP"
+)
+
+// ResourceType is the type of resource a log applies to.
+type ResourceType string
+
+const (
+	ResourceTypeSecurityGroup ResourceType = "security_group"
+	ResourceTypeFirewallGroup ResourceType = "firewall_group"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToLogListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the log attributes you want to see returned. SortKey allows you to sort
+// by a particular log attribute. SortDir sets the direction, and is either
+// `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID             string       `q:"id"`
+	Name           string       `q:"name"`
+	Description    string       `q:"description"`
+	TenantID       string       `q:"tenant_id"`
+	ProjectID      string       `q:"project_id"`
+	ResourceType   ResourceType `q:"resource_type"`
+	ResourceID     string       `q:"resource_id"`
+	TargetID       string       `q:"target_id"`
+	Event          Event        `q:"event"`
+	Enabled        *bool        `q:"enabled"`
+	RevisionNumber *int         `q:"revision_number"`
+	Limit          int          `q:"limit"`
+	Marker         string       `q:"marker"`
+	SortKey        string       `q:"sort_key"`
+	SortDir        string       `q:"sort_dir"`
+}
+
+// ToLogListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToLogListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// logs. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToLogListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return LogPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific log based on its ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToLogCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new log.
+type CreateOpts struct {
+	// Name is the human-readable name of the log.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human-readable description of the log.
+	Description string `json:"description,omitempty"`
+
+	// ProjectID is the owner of the log. Only administrators can set it.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// ResourceType is the type of resource to log.
+	ResourceType ResourceType `json:"resource_type" required:"true"`
+
+	// ResourceID is the ID of the security group or firewall group to log.
+	// If it is not set, all resources of ResourceType in the project are
+	// logged.
+	ResourceID string `json:"resource_id,omitempty"`
+
+	// TargetID is the ID of a port or router to limit the log to.
+	TargetID string `json:"target_id,omitempty"`
+
+	// Event is the type of packets to log. Neutron defaults to EventAll.
+	Event Event `json:"event,omitempty"`
+
+	// Enabled indicates whether the log is enabled. Neutron defaults to true.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ToLogCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToLogCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "log")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new log.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToLogCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToLogUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contains the values used when updating a log.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// ToLogUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToLogUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "log")
+}
+
+// Update allows logs to be updated.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToLogUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will permanently delete a log based on its ID.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// ListLoggableResources returns a Pager which allows you to iterate over the
+// types of resources that can be logged.
+func ListLoggableResources(c *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(c, loggableResourcesURL(c), func(r pagination.PageResult) pagination.Page {
+		return LoggableResourcePage{pagination.SinglePageBase(r)}
+	})
+}
@@ -0,0 +1,166 @@
+package logging
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Log represents a network log.
+type Log struct {
+	// ID is the unique ID of the log.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the log.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the log.
+	Description string `json:"description"`
+
+	// TenantID is the project owner of the log.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the log.
+	ProjectID string `json:"project_id"`
+
+	// ResourceType is the type of resource logged.
+	ResourceType ResourceType `json:"resource_type"`
+
+	// ResourceID is the ID of the logged resource. It is empty if all the
+	// resources of ResourceType in the project are logged.
+	ResourceID string `json:"resource_id"`
+
+	// TargetID is the ID of the port or router the log is limited to.
+	TargetID string `json:"target_id"`
+
+	// Event is the type of packets logged.
+	Event Event `json:"event"`
+
+	// Enabled indicates whether the log is enabled.
+	Enabled bool `json:"enabled"`
+
+	// RevisionNumber is the revision number of the log.
+	RevisionNumber int `json:"revision_number"`
+
+	// CreatedAt is the time the log was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the time the log was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Log.
+func (r commonResult) Extract() (*Log, error) {
+	var s Log
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v any) error {
+	return r.ExtractIntoStructPtr(v, "log")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a Log.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Log.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a Log.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// LogPage is the page returned by a pager when traversing over a collection
+// of logs.
+type LogPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of logs has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r LogPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"logs_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a LogPage struct is empty.
+func (r LogPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractLogs(r)
+	return len(is) == 0, err
+}
+
+// ExtractLogs accepts a Page struct, specifically a LogPage struct, and
+// extracts the elements into a slice of Log structs.
+func ExtractLogs(r pagination.Page) ([]Log, error) {
+	var s []Log
+	err := ExtractLogsInto(r, &s)
+	return s, err
+}
+
+// ExtractLogsInto extracts the elements into a slice of Log structs.
+func ExtractLogsInto(r pagination.Page, v any) error {
+	return r.(LogPage).ExtractIntoSlicePtr(v, "logs")
+}
+
+// LoggableResource is a type of resource that can be logged.
+type LoggableResource struct {
+	// Type is the resource type, to be used as ResourceType of a log.
+	Type ResourceType `json:"type"`
+}
+
+// LoggableResourcePage is the page returned by a pager when traversing over
+// the loggable resources.
+type LoggableResourcePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks whether a LoggableResourcePage struct is empty.
+func (r LoggableResourcePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractLoggableResources(r)
+	return len(is) == 0, err
+}
+
+// ExtractLoggableResources accepts a Page struct, specifically a
+// LoggableResourcePage struct, and extracts the elements into a slice of
+// LoggableResource structs.
+func ExtractLoggableResources(r pagination.Page) ([]LoggableResource, error) {
+	var s struct {
+		LoggableResources []LoggableResource `json:"loggable_resources"`
+	}
+	err := (r.(LoggableResourcePage)).ExtractInto(&s)
+	return s.LoggableResources, err
+}
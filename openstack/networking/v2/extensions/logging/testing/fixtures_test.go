@@ -0,0 +1,150 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/logging"
+)
+
+const LogID = "2f245a7b-796b-4f26-9cf9-9e82d248fda7"
+
+const ListResponse = `
+{
+    "logs": [
+        {
+            "id": "2f245a7b-796b-4f26-9cf9-9e82d248fda7",
+            "name": "sg-drops",
+            "description": "Dropped packets of the web security group",
+            "tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+            "project_id": "4fd44f30292945e481c7b8a0c8908869",
+            "resource_type": "security_group",
+            "resource_id": "80c86fc4-6c48-4b4a-9f2a-0d7b6ba1d7e5",
+            "target_id": "",
+            "event": "DROP",
+            "enabled": true,
+            "revision_number": 1,
+            "created_at": "2024-05-21T10:15:30Z",
+            "updated_at": "2024-05-21T10:15:30Z"
+        },
+        {
+            "id": "a3f71b7e-9f4a-4c6e-8d0b-0a5f4c3e2b11",
+            "name": "fw-all",
+            "description": "",
+            "tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+            "project_id": "4fd44f30292945e481c7b8a0c8908869",
+            "resource_type": "firewall_group",
+            "resource_id": "",
+            "target_id": "5b1ae4ea-1d9c-4f58-9f5a-3f2e0c7b1a22",
+            "event": "ALL",
+            "enabled": false,
+            "revision_number": 3,
+            "created_at": "2024-05-20T08:00:00Z",
+            "updated_at": "2024-05-22T09:30:00Z"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "log": {
+        "id": "2f245a7b-796b-4f26-9cf9-9e82d248fda7",
+        "name": "sg-drops",
+        "description": "Dropped packets of the web security group",
+        "tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+        "project_id": "4fd44f30292945e481c7b8a0c8908869",
+        "resource_type": "security_group",
+        "resource_id": "80c86fc4-6c48-4b4a-9f2a-0d7b6ba1d7e5",
+        "target_id": "",
+        "event": "DROP",
+        "enabled": true,
+        "revision_number": 1,
+        "created_at": "2024-05-21T10:15:30Z",
+        "updated_at": "2024-05-21T10:15:30Z"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "log": {
+        "name": "sg-drops",
+        "description": "Dropped packets of the web security group",
+        "resource_type": "security_group",
+        "resource_id": "80c86fc4-6c48-4b4a-9f2a-0d7b6ba1d7e5",
+        "event": "DROP",
+        "enabled": true
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "log": {
+        "name": "sg-drops-disabled",
+        "enabled": false
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "log": {
+        "id": "2f245a7b-796b-4f26-9cf9-9e82d248fda7",
+        "name": "sg-drops-disabled",
+        "description": "Dropped packets of the web security group",
+        "tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+        "project_id": "4fd44f30292945e481c7b8a0c8908869",
+        "resource_type": "security_group",
+        "resource_id": "80c86fc4-6c48-4b4a-9f2a-0d7b6ba1d7e5",
+        "target_id": "",
+        "event": "DROP",
+        "enabled": false,
+        "revision_number": 2,
+        "created_at": "2024-05-21T10:15:30Z",
+        "updated_at": "2024-05-21T11:00:00Z"
+    }
+}
+`
+
+const LoggableResourcesResponse = `
+{
+    "loggable_resources": [
+        {
+            "type": "security_group"
+        },
+        {
+            "type": "firewall_group"
+        }
+    ]
+}
+`
+
+var Log1 = logging.Log{
+	ID:             "2f245a7b-796b-4f26-9cf9-9e82d248fda7",
+	Name:           "sg-drops",
+	Description:    "Dropped packets of the web security group",
+	TenantID:       "4fd44f30292945e481c7b8a0c8908869",
+	ProjectID:      "4fd44f30292945e481c7b8a0c8908869",
+	ResourceType:   logging.ResourceTypeSecurityGroup,
+	ResourceID:     "80c86fc4-6c48-4b4a-9f2a-0d7b6ba1d7e5",
+	Event:          logging.EventDrop,
+	Enabled:        true,
+	RevisionNumber: 1,
+	CreatedAt:      time.Date(2024, 5, 21, 10, 15, 30, 0, time.UTC),
+	UpdatedAt:      time.Date(2024, 5, 21, 10, 15, 30, 0, time.UTC),
+}
+
+var Log2 = logging.Log{
+	ID:             "a3f71b7e-9f4a-4c6e-8d0b-0a5f4c3e2b11",
+	Name:           "fw-all",
+	TenantID:       "4fd44f30292945e481c7b8a0c8908869",
+	ProjectID:      "4fd44f30292945e481c7b8a0c8908869",
+	ResourceType:   logging.ResourceTypeFirewallGroup,
+	TargetID:       "5b1ae4ea-1d9c-4f58-9f5a-3f2e0c7b1a22",
+	Event:          logging.EventAll,
+	Enabled:        false,
+	RevisionNumber: 3,
+	CreatedAt:      time.Date(2024, 5, 20, 8, 0, 0, 0, time.UTC),
+	UpdatedAt:      time.Date(2024, 5, 22, 9, 30, 0, 0, time.UTC),
+}
@@ -0,0 +1,182 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/logging"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/log/logs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"project_id": "4fd44f30292945e481c7b8a0c8908869",
+			"enabled":    "true",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+
+	enabled := true
+	listOpts := logging.ListOpts{
+		ProjectID: "4fd44f30292945e481c7b8a0c8908869",
+		Enabled:   &enabled,
+	}
+
+	count := 0
+	err := logging.List(fake.ServiceClient(fakeServer), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := logging.ExtractLogs(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []logging.Log{Log1, Log2}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/log/logs/"+LogID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+
+	actual, err := logging.Get(context.TODO(), fake.ServiceClient(fakeServer), LogID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Log1, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/log/logs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+
+	enabled := true
+	createOpts := logging.CreateOpts{
+		Name:         "sg-drops",
+		Description:  "Dropped packets of the web security group",
+		ResourceType: logging.ResourceTypeSecurityGroup,
+		ResourceID:   "80c86fc4-6c48-4b4a-9f2a-0d7b6ba1d7e5",
+		Event:        logging.EventDrop,
+		Enabled:      &enabled,
+	}
+
+	actual, err := logging.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Log1, *actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	res := logging.Create(context.TODO(), fake.ServiceClient(fakeServer), logging.CreateOpts{Name: "sg-drops"})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/log/logs/"+LogID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	name := "sg-drops-disabled"
+	enabled := false
+	updateOpts := logging.UpdateOpts{
+		Name:    &name,
+		Enabled: &enabled,
+	}
+
+	actual, err := logging.Update(context.TODO(), fake.ServiceClient(fakeServer), LogID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := Log1
+	expected.Name = "sg-drops-disabled"
+	expected.Enabled = false
+	expected.RevisionNumber = 2
+	expected.UpdatedAt = time.Date(2024, 5, 21, 11, 0, 0, 0, time.UTC)
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/log/logs/"+LogID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := logging.Delete(context.TODO(), fake.ServiceClient(fakeServer), LogID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListLoggableResources(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/log/loggable-resources", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, LoggableResourcesResponse)
+	})
+
+	allPages, err := logging.ListLoggableResources(fake.ServiceClient(fakeServer)).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := logging.ExtractLoggableResources(allPages)
+	th.AssertNoErr(t, err)
+
+	expected := []logging.LoggableResource{
+		{Type: logging.ResourceTypeSecurityGroup},
+		{Type: logging.ResourceTypeFirewallGroup},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
@@ -0,0 +1,21 @@
+package logging
+
+import "github.com/gophercloud/gophercloud/v2"
+
+const (
+	rootPath                  = "log"
+	logsResourcePath          = "logs"
+	loggableResourcesResource = "loggable-resources"
+)
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, logsResourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, logsResourcePath, id)
+}
+
+func loggableResourcesURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, loggableResourcesResource)
+}
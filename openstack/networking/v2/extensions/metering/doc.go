@@ -0,0 +1,11 @@
+// Package metering contains functionality to work with metering label and
+// metering label rule Neutron resources.
+//
+// Metering labels and their rules account for the layer 3 traffic passing
+// through routers. A metering label is a container for metering label rules,
+// each of which selects traffic by direction and IP prefix. Traffic matched by
+// an excluded rule is not counted.
+//
+// Shared labels apply to the routers of every project. Creating metering
+// labels and rules is an administrative operation by default.
+package metering
@@ -0,0 +1,46 @@
+/*
+Package labels provides information and interaction with Metering Labels for
+the OpenStack Networking service.
+
+Example to List Metering Labels
+
+	listOpts := labels.ListOpts{
+		ProjectID: "a2f1f29d571f4533a7dfc7fe5f14ce1c",
+	}
+
+	allPages, err := labels.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allLabels, err := labels.ExtractLabels(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, label := range allLabels {
+		fmt.Printf("%+v\n", label)
+	}
+
+Example to Create a Shared Metering Label
+
+	shared := true
+	createOpts := labels.CreateOpts{
+		Name:   "internet",
+		Shared: &shared,
+	}
+
+	label, err := labels.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Metering Label
+
+	labelID := "bc91b832-8465-40a7-a5d8-ba87de442266"
+	err := labels.Delete(context.TODO(), networkClient, labelID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package labels
@@ -0,0 +1,112 @@
+package labels
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToMeteringLabelListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the metering label attributes you want to see returned. SortKey allows you
+// to sort by a particular metering label attribute. SortDir sets the
+// direction, and is either `asc' or `desc'. Marker and Limit are used for
+// pagination.
+type ListOpts struct {
+	ID          string `q:"id"`
+	Name        string `q:"name"`
+	Description string `q:"description"`
+	Shared      *bool  `q:"shared"`
+	TenantID    string `q:"tenant_id"`
+	ProjectID   string `q:"project_id"`
+	Limit       int    `q:"limit"`
+	Marker      string `q:"marker"`
+	SortKey     string `q:"sort_key"`
+	SortDir     string `q:"sort_dir"`
+	Fields      string `q:"fields"`
+}
+
+// ToMeteringLabelListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToMeteringLabelListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// metering labels. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToMeteringLabelListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return LabelPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific metering label based on its unique ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToMeteringLabelCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new metering label.
+type CreateOpts struct {
+	// Name is the human-readable name of the label.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human-readable description of the label.
+	Description string `json:"description,omitempty"`
+
+	// Shared indicates whether the label applies to the routers of every
+	// project.
+	Shared *bool `json:"shared,omitempty"`
+
+	// ProjectID is the owner of the label. Only administrators can set it.
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// ToMeteringLabelCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToMeteringLabelCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "metering_label")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// metering label.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToMeteringLabelCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will permanently delete a particular metering label based on its
+// unique ID. Its rules are deleted with it.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,105 @@
+package labels
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Label represents a metering label, which groups the metering label rules
+// whose traffic is accounted together.
+type Label struct {
+	// ID is the unique ID of the label.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the label.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the label.
+	Description string `json:"description"`
+
+	// Shared indicates whether the label applies to the routers of every
+	// project.
+	Shared bool `json:"shared"`
+
+	// TenantID is the project owner of the label.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the label.
+	ProjectID string `json:"project_id"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Label.
+func (r commonResult) Extract() (*Label, error) {
+	var s Label
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v any) error {
+	return r.ExtractIntoStructPtr(v, "metering_label")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a Label.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Label.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// LabelPage is the page returned by a pager when traversing over a collection
+// of metering labels.
+type LabelPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of metering labels has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r LabelPage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"metering_labels_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a LabelPage struct is empty.
+func (r LabelPage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractLabels(r)
+	return len(is) == 0, err
+}
+
+// ExtractLabels accepts a Page struct, specifically a LabelPage struct, and
+// extracts the elements into a slice of Label structs.
+func ExtractLabels(r pagination.Page) ([]Label, error) {
+	var s []Label
+	err := ExtractLabelsInto(r, &s)
+	return s, err
+}
+
+// ExtractLabelsInto extracts the elements into a slice of Label structs.
+func ExtractLabelsInto(r pagination.Page, v any) error {
+	return r.(LabelPage).ExtractIntoSlicePtr(v, "metering_labels")
+}
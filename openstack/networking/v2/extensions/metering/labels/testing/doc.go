@@ -0,0 +1,2 @@
+// metering labels unit tests
+package testing
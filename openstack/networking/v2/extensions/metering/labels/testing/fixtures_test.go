@@ -0,0 +1,106 @@
+package testing
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/metering/labels"
+)
+
+const LabelID = "bc91b832-8465-40a7-a5d8-ba87de442266"
+
+// ListResponsePage1 is the first page of a paginated label listing.
+const ListResponsePage1 = `
+{
+    "metering_labels": [
+        {
+            "id": "bc91b832-8465-40a7-a5d8-ba87de442266",
+            "name": "internet",
+            "description": "Traffic to the internet",
+            "shared": true,
+            "tenant_id": "a2f1f29d571f4533a7dfc7fe5f14ce1c",
+            "project_id": "a2f1f29d571f4533a7dfc7fe5f14ce1c"
+        }
+    ],
+    "metering_labels_links": [
+        {
+            "href": "%s/v2.0/metering/metering-labels?limit=1&marker=bc91b832-8465-40a7-a5d8-ba87de442266",
+            "rel": "next"
+        }
+    ]
+}
+`
+
+// ListResponsePage2 is the last page of a paginated label listing.
+const ListResponsePage2 = `
+{
+    "metering_labels": [
+        {
+            "id": "a6700594-5b7a-4105-8bfe-723b346ce866",
+            "name": "private",
+            "description": "",
+            "shared": false,
+            "tenant_id": "45345b0ee1ea477fac0f541b2cb79cd4",
+            "project_id": "45345b0ee1ea477fac0f541b2cb79cd4"
+        }
+    ],
+    "metering_labels_links": [
+        {
+            "href": "%s/v2.0/metering/metering-labels?limit=1&marker=bc91b832-8465-40a7-a5d8-ba87de442266",
+            "rel": "previous"
+        }
+    ]
+}
+`
+
+// ListSharedResponse is a label listing filtered on the shared flag.
+const ListSharedResponse = `
+{
+    "metering_labels": [
+        {
+            "id": "bc91b832-8465-40a7-a5d8-ba87de442266",
+            "name": "internet",
+            "description": "Traffic to the internet",
+            "shared": true,
+            "tenant_id": "a2f1f29d571f4533a7dfc7fe5f14ce1c",
+            "project_id": "a2f1f29d571f4533a7dfc7fe5f14ce1c"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "metering_label": {
+        "id": "bc91b832-8465-40a7-a5d8-ba87de442266",
+        "name": "internet",
+        "description": "Traffic to the internet",
+        "shared": true,
+        "tenant_id": "a2f1f29d571f4533a7dfc7fe5f14ce1c",
+        "project_id": "a2f1f29d571f4533a7dfc7fe5f14ce1c"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "metering_label": {
+        "name": "internet",
+        "description": "Traffic to the internet",
+        "shared": true
+    }
+}
+`
+
+var Label1 = labels.Label{
+	ID:          "bc91b832-8465-40a7-a5d8-ba87de442266",
+	Name:        "internet",
+	Description: "Traffic to the internet",
+	Shared:      true,
+	TenantID:    "a2f1f29d571f4533a7dfc7fe5f14ce1c",
+	ProjectID:   "a2f1f29d571f4533a7dfc7fe5f14ce1c",
+}
+
+var Label2 = labels.Label{
+	ID:        "a6700594-5b7a-4105-8bfe-723b346ce866",
+	Name:      "private",
+	TenantID:  "45345b0ee1ea477fac0f541b2cb79cd4",
+	ProjectID: "45345b0ee1ea477fac0f541b2cb79cd4",
+}
@@ -0,0 +1,135 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/metering/labels"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-labels", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		switch r.Form.Get("marker") {
+		case "":
+			th.TestFormValues(t, r, map[string]string{"limit": "1"})
+			fmt.Fprintf(w, ListResponsePage1, fakeServer.Server.URL)
+		case LabelID:
+			fmt.Fprintf(w, ListResponsePage2, fakeServer.Server.URL)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", r.Form.Get("marker"))
+		}
+	})
+
+	count := 0
+	var actual []labels.Label
+	err := labels.List(fake.ServiceClient(fakeServer), labels.ListOpts{Limit: 1}).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		s, err := labels.ExtractLabels(page)
+		th.AssertNoErr(t, err)
+		actual = append(actual, s...)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, count)
+	th.CheckDeepEquals(t, []labels.Label{Label1, Label2}, actual)
+}
+
+func TestListShared(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-labels", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"shared": "true"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListSharedResponse)
+	})
+
+	shared := true
+	allPages, err := labels.List(fake.ServiceClient(fakeServer), labels.ListOpts{Shared: &shared}).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := labels.ExtractLabels(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []labels.Label{Label1}, actual)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-labels/"+LabelID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+
+	actual, err := labels.Get(context.TODO(), fake.ServiceClient(fakeServer), LabelID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Label1, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-labels", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+
+	shared := true
+	createOpts := labels.CreateOpts{
+		Name:        "internet",
+		Description: "Traffic to the internet",
+		Shared:      &shared,
+	}
+
+	actual, err := labels.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Label1, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-labels/"+LabelID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := labels.Delete(context.TODO(), fake.ServiceClient(fakeServer), LabelID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
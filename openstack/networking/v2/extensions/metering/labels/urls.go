@@ -0,0 +1,16 @@
+package labels
+
+import "github.com/gophercloud/gophercloud/v2"
+
+const (
+	rootPath     = "metering"
+	resourcePath = "metering-labels"
+)
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(rootPath, resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(rootPath, resourcePath, id)
+}
@@ -0,0 +1,60 @@
+/*
+Package rules provides information and interaction with Metering Label Rules
+for the OpenStack Networking service.
+
+Example to List Metering Label Rules
+
+	listOpts := rules.ListOpts{
+		MeteringLabelID: "bc91b832-8465-40a7-a5d8-ba87de442266",
+	}
+
+	allPages, err := rules.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allRules, err := rules.ExtractRules(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, rule := range allRules {
+		fmt.Printf("%+v\n", rule)
+	}
+
+Example to Create a Metering Label Rule
+
+	createOpts := rules.CreateOpts{
+		MeteringLabelID:     "bc91b832-8465-40a7-a5d8-ba87de442266",
+		Direction:           rules.DirEgress,
+		DestinationIPPrefix: "0.0.0.0/0",
+	}
+
+	rule, err := rules.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Exclude Private Traffic from a Metering Label
+
+	createOpts := rules.CreateOpts{
+		MeteringLabelID:     "bc91b832-8465-40a7-a5d8-ba87de442266",
+		Direction:           rules.DirEgress,
+		DestinationIPPrefix: "10.0.0.0/8",
+		Excluded:            true,
+	}
+
+	rule, err := rules.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Metering Label Rule
+
+	ruleID := "f1694764-bdd6-4d9b-8b8b-6a2d6b7e3a55"
+	err := rules.Delete(context.TODO(), networkClient, ruleID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package rules
@@ -0,0 +1,137 @@
+package rules
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// RuleDirection is the direction of the traffic a rule matches, relative to
+// the router.
+type RuleDirection string
+
+// Constants useful for CreateOpts
+const (
+	DirIngress RuleDirection = "ingress"
+	DirEgress  RuleDirection = "egress"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToMeteringLabelRuleListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the metering label rule attributes you want to see returned. SortKey allows
+// you to sort by a particular rule attribute. SortDir sets the direction,
+// and is either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID                  string        `q:"id"`
+	MeteringLabelID     string        `q:"metering_label_id"`
+	Direction           RuleDirection `q:"direction"`
+	RemoteIPPrefix      string        `q:"remote_ip_prefix"`
+	SourceIPPrefix      string        `q:"source_ip_prefix"`
+	DestinationIPPrefix string        `q:"destination_ip_prefix"`
+	Excluded            *bool         `q:"excluded"`
+	TenantID            string        `q:"tenant_id"`
+	ProjectID           string        `q:"project_id"`
+	Limit               int           `q:"limit"`
+	Marker              string        `q:"marker"`
+	SortKey             string        `q:"sort_key"`
+	SortDir             string        `q:"sort_dir"`
+	Fields              string        `q:"fields"`
+}
+
+// ToMeteringLabelRuleListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToMeteringLabelRuleListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// metering label rules. It accepts a ListOpts struct, which allows you to
+// filter and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToMeteringLabelRuleListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return RulePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific metering label rule based on its unique ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToMeteringLabelRuleCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new metering label
+// rule. At least one of RemoteIPPrefix, SourceIPPrefix and
+// DestinationIPPrefix must be set, and RemoteIPPrefix cannot be combined with
+// the others.
+type CreateOpts struct {
+	// MeteringLabelID is the label the rule belongs to.
+	MeteringLabelID string `json:"metering_label_id" required:"true"`
+
+	// Direction is the direction of the traffic the rule matches.
+	Direction RuleDirection `json:"direction" required:"true"`
+
+	// RemoteIPPrefix matches the remote end of the traffic. It is deprecated
+	// by Neutron in favour of SourceIPPrefix and DestinationIPPrefix.
+	RemoteIPPrefix string `json:"remote_ip_prefix,omitempty"`
+
+	// SourceIPPrefix matches the source address of the traffic.
+	SourceIPPrefix string `json:"source_ip_prefix,omitempty"`
+
+	// DestinationIPPrefix matches the destination address of the traffic.
+	DestinationIPPrefix string `json:"destination_ip_prefix,omitempty"`
+
+	// Excluded indicates whether the matched traffic is left out of the
+	// label's counters.
+	Excluded bool `json:"excluded,omitempty"`
+
+	// ProjectID is the owner of the rule. Only administrators can set it.
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// ToMeteringLabelRuleCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToMeteringLabelRuleCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "metering_label_rule")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// metering label rule.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToMeteringLabelRuleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will permanently delete a particular metering label rule based on
+// its unique ID.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
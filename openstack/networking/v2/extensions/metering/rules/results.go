@@ -0,0 +1,114 @@
+package rules
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// Rule represents a metering label rule, which selects the traffic accounted
+// by its metering label.
+type Rule struct {
+	// ID is the unique ID of the rule.
+	ID string `json:"id"`
+
+	// MeteringLabelID is the label the rule belongs to.
+	MeteringLabelID string `json:"metering_label_id"`
+
+	// Direction is the direction of the traffic the rule matches.
+	Direction RuleDirection `json:"direction"`
+
+	// RemoteIPPrefix matches the remote end of the traffic.
+	RemoteIPPrefix string `json:"remote_ip_prefix"`
+
+	// SourceIPPrefix matches the source address of the traffic.
+	SourceIPPrefix string `json:"source_ip_prefix"`
+
+	// DestinationIPPrefix matches the destination address of the traffic.
+	DestinationIPPrefix string `json:"destination_ip_prefix"`
+
+	// Excluded indicates whether the matched traffic is left out of the
+	// label's counters.
+	Excluded bool `json:"excluded"`
+
+	// TenantID is the project owner of the rule.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the rule.
+	ProjectID string `json:"project_id"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Rule.
+func (r commonResult) Extract() (*Rule, error) {
+	var s Rule
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v any) error {
+	return r.ExtractIntoStructPtr(v, "metering_label_rule")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a Rule.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Rule.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// RulePage is the page returned by a pager when traversing over a collection
+// of metering label rules.
+type RulePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of metering label rules
+// has reached the end of a page and the pager seeks to traverse over a new
+// one. In order to do this, it needs to construct the next page's URL.
+func (r RulePage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"metering_label_rules_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a RulePage struct is empty.
+func (r RulePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractRules(r)
+	return len(is) == 0, err
+}
+
+// ExtractRules accepts a Page struct, specifically a RulePage struct, and
+// extracts the elements into a slice of Rule structs.
+func ExtractRules(r pagination.Page) ([]Rule, error) {
+	var s []Rule
+	err := ExtractRulesInto(r, &s)
+	return s, err
+}
+
+// ExtractRulesInto extracts the elements into a slice of Rule structs.
+func ExtractRulesInto(r pagination.Page, v any) error {
+	return r.(RulePage).ExtractIntoSlicePtr(v, "metering_label_rules")
+}
@@ -0,0 +1,2 @@
+// metering label rules unit tests
+package testing
@@ -0,0 +1,126 @@
+package testing
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/metering/rules"
+)
+
+const (
+	LabelID = "bc91b832-8465-40a7-a5d8-ba87de442266"
+	RuleID  = "f1694764-bdd6-4d9b-8b8b-6a2d6b7e3a55"
+)
+
+// ListResponsePage1 is the first page of a paginated rule listing.
+const ListResponsePage1 = `
+{
+    "metering_label_rules": [
+        {
+            "id": "f1694764-bdd6-4d9b-8b8b-6a2d6b7e3a55",
+            "metering_label_id": "bc91b832-8465-40a7-a5d8-ba87de442266",
+            "direction": "egress",
+            "remote_ip_prefix": null,
+            "source_ip_prefix": null,
+            "destination_ip_prefix": "0.0.0.0/0",
+            "excluded": false,
+            "tenant_id": "a2f1f29d571f4533a7dfc7fe5f14ce1c",
+            "project_id": "a2f1f29d571f4533a7dfc7fe5f14ce1c"
+        }
+    ],
+    "metering_label_rules_links": [
+        {
+            "href": "%s/v2.0/metering/metering-label-rules?limit=1&marker=f1694764-bdd6-4d9b-8b8b-6a2d6b7e3a55&metering_label_id=bc91b832-8465-40a7-a5d8-ba87de442266",
+            "rel": "next"
+        }
+    ]
+}
+`
+
+// ListResponsePage2 is the last page of a paginated rule listing.
+const ListResponsePage2 = `
+{
+    "metering_label_rules": [
+        {
+            "id": "9536641a-7d14-4dc5-afaf-93a973ce0eb8",
+            "metering_label_id": "bc91b832-8465-40a7-a5d8-ba87de442266",
+            "direction": "egress",
+            "remote_ip_prefix": null,
+            "source_ip_prefix": null,
+            "destination_ip_prefix": "10.0.0.0/8",
+            "excluded": true,
+            "tenant_id": "a2f1f29d571f4533a7dfc7fe5f14ce1c",
+            "project_id": "a2f1f29d571f4533a7dfc7fe5f14ce1c"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "metering_label_rule": {
+        "id": "f1694764-bdd6-4d9b-8b8b-6a2d6b7e3a55",
+        "metering_label_id": "bc91b832-8465-40a7-a5d8-ba87de442266",
+        "direction": "egress",
+        "remote_ip_prefix": null,
+        "source_ip_prefix": null,
+        "destination_ip_prefix": "0.0.0.0/0",
+        "excluded": false,
+        "tenant_id": "a2f1f29d571f4533a7dfc7fe5f14ce1c",
+        "project_id": "a2f1f29d571f4533a7dfc7fe5f14ce1c"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "metering_label_rule": {
+        "metering_label_id": "bc91b832-8465-40a7-a5d8-ba87de442266",
+        "direction": "egress",
+        "destination_ip_prefix": "0.0.0.0/0"
+    }
+}
+`
+
+const CreateExcludedRequest = `
+{
+    "metering_label_rule": {
+        "metering_label_id": "bc91b832-8465-40a7-a5d8-ba87de442266",
+        "direction": "ingress",
+        "source_ip_prefix": "192.168.0.0/16",
+        "excluded": true
+    }
+}
+`
+
+const CreateExcludedResponse = `
+{
+    "metering_label_rule": {
+        "id": "5c7e4c26-4b5e-4b35-9a4c-4d8a2f3f0f6e",
+        "metering_label_id": "bc91b832-8465-40a7-a5d8-ba87de442266",
+        "direction": "ingress",
+        "remote_ip_prefix": null,
+        "source_ip_prefix": "192.168.0.0/16",
+        "destination_ip_prefix": null,
+        "excluded": true,
+        "tenant_id": "a2f1f29d571f4533a7dfc7fe5f14ce1c",
+        "project_id": "a2f1f29d571f4533a7dfc7fe5f14ce1c"
+    }
+}
+`
+
+var Rule1 = rules.Rule{
+	ID:                  "f1694764-bdd6-4d9b-8b8b-6a2d6b7e3a55",
+	MeteringLabelID:     "bc91b832-8465-40a7-a5d8-ba87de442266",
+	Direction:           rules.DirEgress,
+	DestinationIPPrefix: "0.0.0.0/0",
+	TenantID:            "a2f1f29d571f4533a7dfc7fe5f14ce1c",
+	ProjectID:           "a2f1f29d571f4533a7dfc7fe5f14ce1c",
+}
+
+var Rule2 = rules.Rule{
+	ID:                  "9536641a-7d14-4dc5-afaf-93a973ce0eb8",
+	MeteringLabelID:     "bc91b832-8465-40a7-a5d8-ba87de442266",
+	Direction:           rules.DirEgress,
+	DestinationIPPrefix: "10.0.0.0/8",
+	Excluded:            true,
+	TenantID:            "a2f1f29d571f4533a7dfc7fe5f14ce1c",
+	ProjectID:           "a2f1f29d571f4533a7dfc7fe5f14ce1c",
+}
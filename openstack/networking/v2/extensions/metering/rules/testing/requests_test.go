@@ -0,0 +1,162 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/metering/rules"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-label-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Failed to parse request form %v", err)
+		}
+		switch r.Form.Get("marker") {
+		case "":
+			th.TestFormValues(t, r, map[string]string{
+				"limit":             "1",
+				"metering_label_id": LabelID,
+			})
+			fmt.Fprintf(w, ListResponsePage1, fakeServer.Server.URL)
+		case RuleID:
+			fmt.Fprint(w, ListResponsePage2)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", r.Form.Get("marker"))
+		}
+	})
+
+	listOpts := rules.ListOpts{
+		MeteringLabelID: LabelID,
+		Limit:           1,
+	}
+
+	count := 0
+	var actual []rules.Rule
+	err := rules.List(fake.ServiceClient(fakeServer), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		s, err := rules.ExtractRules(page)
+		th.AssertNoErr(t, err)
+		actual = append(actual, s...)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, count)
+	th.CheckDeepEquals(t, []rules.Rule{Rule1, Rule2}, actual)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-label-rules/"+RuleID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+
+	actual, err := rules.Get(context.TODO(), fake.ServiceClient(fakeServer), RuleID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Rule1, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-label-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+
+	createOpts := rules.CreateOpts{
+		MeteringLabelID:     LabelID,
+		Direction:           rules.DirEgress,
+		DestinationIPPrefix: "0.0.0.0/0",
+	}
+
+	actual, err := rules.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Rule1, *actual)
+}
+
+func TestCreateExcluded(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-label-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateExcludedRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, CreateExcludedResponse)
+	})
+
+	createOpts := rules.CreateOpts{
+		MeteringLabelID: LabelID,
+		Direction:       rules.DirIngress,
+		SourceIPPrefix:  "192.168.0.0/16",
+		Excluded:        true,
+	}
+
+	actual, err := rules.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, rules.DirIngress, actual.Direction)
+	th.AssertEquals(t, "192.168.0.0/16", actual.SourceIPPrefix)
+	th.AssertEquals(t, "", actual.DestinationIPPrefix)
+	th.AssertEquals(t, true, actual.Excluded)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	res := rules.Create(context.TODO(), fake.ServiceClient(fakeServer), rules.CreateOpts{Direction: rules.DirEgress})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+	res = rules.Create(context.TODO(), fake.ServiceClient(fakeServer), rules.CreateOpts{MeteringLabelID: LabelID})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/metering/metering-label-rules/"+RuleID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := rules.Delete(context.TODO(), fake.ServiceClient(fakeServer), RuleID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,61 @@
+/*
+Package ndpproxies manages and retrieves NDP proxies in the OpenStack
+Networking Service, as provided by the l3-ndp-proxy extension.
+
+An NDP proxy publishes an IPv6 address of an internal port on the external
+network of a router, which must have NDP proxying enabled.
+
+Example to List NDP Proxies
+
+	listOpts := ndpproxies.ListOpts{
+		RouterID: "eb3a2ad1-4a5c-4e2c-8e0c-5a6ad3d6b0c1",
+	}
+
+	allPages, err := ndpproxies.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allProxies, err := ndpproxies.ExtractNDPProxies(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, proxy := range allProxies {
+		fmt.Printf("%+v\n", proxy)
+	}
+
+Example to Create an NDP Proxy
+
+	createOpts := ndpproxies.CreateOpts{
+		Name:      "web",
+		RouterID:  "eb3a2ad1-4a5c-4e2c-8e0c-5a6ad3d6b0c1",
+		PortID:    "8e2c3b1a-63b0-4d4e-8d2a-1c0b7f3f4a55",
+		IPAddress: "2001:db8::10",
+	}
+
+	proxy, err := ndpproxies.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update an NDP Proxy
+
+	name := "web-v6"
+	updateOpts := ndpproxies.UpdateOpts{
+		Name: &name,
+	}
+
+	proxy, err := ndpproxies.Update(context.TODO(), networkClient, "91ab9f2e-4b1b-4c1e-9f5c-2c5fbb7d1c3d", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an NDP Proxy
+
+	err := ndpproxies.Delete(context.TODO(), networkClient, "91ab9f2e-4b1b-4c1e-9f5c-2c5fbb7d1c3d").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package ndpproxies
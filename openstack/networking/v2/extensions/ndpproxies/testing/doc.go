@@ -0,0 +1,2 @@
+// ndpproxies unit tests
+package testing
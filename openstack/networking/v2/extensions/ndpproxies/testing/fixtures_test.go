@@ -0,0 +1,97 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/ndpproxies"
+)
+
+const NDPProxyID = "91ab9f2e-4b1b-4c1e-9f5c-2c5fbb7d1c3d"
+
+const ListResponse = `
+{
+    "ndp_proxies": [
+        {
+            "id": "91ab9f2e-4b1b-4c1e-9f5c-2c5fbb7d1c3d",
+            "name": "web",
+            "description": "",
+            "tenant_id": "e2e3cbb1b0a34a3c8a0d5d1b0e4e7f2a",
+            "project_id": "e2e3cbb1b0a34a3c8a0d5d1b0e4e7f2a",
+            "router_id": "eb3a2ad1-4a5c-4e2c-8e0c-5a6ad3d6b0c1",
+            "port_id": "8e2c3b1a-63b0-4d4e-8d2a-1c0b7f3f4a55",
+            "ip_address": "2001:db8::10",
+            "revision_number": 0,
+            "created_at": "2024-06-01T07:30:00Z",
+            "updated_at": "2024-06-01T07:30:00Z"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "ndp_proxy": {
+        "id": "91ab9f2e-4b1b-4c1e-9f5c-2c5fbb7d1c3d",
+        "name": "web",
+        "description": "",
+        "tenant_id": "e2e3cbb1b0a34a3c8a0d5d1b0e4e7f2a",
+        "project_id": "e2e3cbb1b0a34a3c8a0d5d1b0e4e7f2a",
+        "router_id": "eb3a2ad1-4a5c-4e2c-8e0c-5a6ad3d6b0c1",
+        "port_id": "8e2c3b1a-63b0-4d4e-8d2a-1c0b7f3f4a55",
+        "ip_address": "2001:db8::10",
+        "revision_number": 0,
+        "created_at": "2024-06-01T07:30:00Z",
+        "updated_at": "2024-06-01T07:30:00Z"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "ndp_proxy": {
+        "name": "web",
+        "router_id": "eb3a2ad1-4a5c-4e2c-8e0c-5a6ad3d6b0c1",
+        "port_id": "8e2c3b1a-63b0-4d4e-8d2a-1c0b7f3f4a55",
+        "ip_address": "2001:db8::10"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "ndp_proxy": {
+        "name": "web-v6",
+        "description": "Public web address"
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "ndp_proxy": {
+        "id": "91ab9f2e-4b1b-4c1e-9f5c-2c5fbb7d1c3d",
+        "name": "web-v6",
+        "description": "Public web address",
+        "tenant_id": "e2e3cbb1b0a34a3c8a0d5d1b0e4e7f2a",
+        "project_id": "e2e3cbb1b0a34a3c8a0d5d1b0e4e7f2a",
+        "router_id": "eb3a2ad1-4a5c-4e2c-8e0c-5a6ad3d6b0c1",
+        "port_id": "8e2c3b1a-63b0-4d4e-8d2a-1c0b7f3f4a55",
+        "ip_address": "2001:db8::10",
+        "revision_number": 1,
+        "created_at": "2024-06-01T07:30:00Z",
+        "updated_at": "2024-06-02T16:45:00Z"
+    }
+}
+`
+
+var NDPProxy1 = ndpproxies.NDPProxy{
+	ID:        "91ab9f2e-4b1b-4c1e-9f5c-2c5fbb7d1c3d",
+	Name:      "web",
+	TenantID:  "e2e3cbb1b0a34a3c8a0d5d1b0e4e7f2a",
+	ProjectID: "e2e3cbb1b0a34a3c8a0d5d1b0e4e7f2a",
+	RouterID:  "eb3a2ad1-4a5c-4e2c-8e0c-5a6ad3d6b0c1",
+	PortID:    "8e2c3b1a-63b0-4d4e-8d2a-1c0b7f3f4a55",
+	IPAddress: "2001:db8::10",
+	CreatedAt: time.Date(2024, 6, 1, 7, 30, 0, 0, time.UTC),
+	UpdatedAt: time.Date(2024, 6, 1, 7, 30, 0, 0, time.UTC),
+}
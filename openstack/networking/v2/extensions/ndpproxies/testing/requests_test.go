@@ -0,0 +1,150 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/ndpproxies"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/ndp_proxies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"router_id": "eb3a2ad1-4a5c-4e2c-8e0c-5a6ad3d6b0c1",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+
+	listOpts := ndpproxies.ListOpts{
+		RouterID: "eb3a2ad1-4a5c-4e2c-8e0c-5a6ad3d6b0c1",
+	}
+
+	count := 0
+	err := ndpproxies.List(fake.ServiceClient(fakeServer), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := ndpproxies.ExtractNDPProxies(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []ndpproxies.NDPProxy{NDPProxy1}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/ndp_proxies/"+NDPProxyID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+
+	actual, err := ndpproxies.Get(context.TODO(), fake.ServiceClient(fakeServer), NDPProxyID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, NDPProxy1, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/ndp_proxies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+
+	createOpts := ndpproxies.CreateOpts{
+		Name:      "web",
+		RouterID:  "eb3a2ad1-4a5c-4e2c-8e0c-5a6ad3d6b0c1",
+		PortID:    "8e2c3b1a-63b0-4d4e-8d2a-1c0b7f3f4a55",
+		IPAddress: "2001:db8::10",
+	}
+
+	actual, err := ndpproxies.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, NDPProxy1, *actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	res := ndpproxies.Create(context.TODO(), fake.ServiceClient(fakeServer), ndpproxies.CreateOpts{RouterID: "eb3a2ad1-4a5c-4e2c-8e0c-5a6ad3d6b0c1"})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/ndp_proxies/"+NDPProxyID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	name := "web-v6"
+	description := "Public web address"
+	updateOpts := ndpproxies.UpdateOpts{
+		Name:        &name,
+		Description: &description,
+	}
+
+	actual, err := ndpproxies.Update(context.TODO(), fake.ServiceClient(fakeServer), NDPProxyID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := NDPProxy1
+	expected.Name = "web-v6"
+	expected.Description = "Public web address"
+	expected.RevisionNumber = 1
+	expected.UpdatedAt = time.Date(2024, 6, 2, 16, 45, 0, 0, time.UTC)
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/ndp_proxies/"+NDPProxyID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := ndpproxies.Delete(context.TODO(), fake.ServiceClient(fakeServer), NDPProxyID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,65 @@
+/*
+Package networksegmentranges manages and retrieves network segment ranges in
+the OpenStack Networking Service, as provided by the network-segment-range
+extension.
+
+Network segment ranges define the pools of VLAN, VXLAN, GRE or Geneve
+segmentation IDs from which tenant networks are allocated. Managing them is
+an administrative operation.
+
+Example to List Network Segment Ranges
+
+	listOpts := networksegmentranges.ListOpts{
+		NetworkType: "vlan",
+	}
+
+	allPages, err := networksegmentranges.List(networkClient, listOpts).AllPages(context.TODO())
+	if err != nil {
+		panic(err)
+	}
+
+	allRanges, err := networksegmentranges.ExtractNetworkSegmentRanges(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, r := range allRanges {
+		fmt.Printf("%+v\n", r)
+	}
+
+Example to Create a Network Segment Range
+
+	createOpts := networksegmentranges.CreateOpts{
+		Name:            "physnet1-tenant",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "physnet1",
+		Minimum:         100,
+		Maximum:         199,
+		ProjectID:       "7011dc7fccac4efda89dc3b7f0d0975a",
+	}
+
+	r, err := networksegmentranges.Create(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Network Segment Range
+
+	maximum := 249
+	updateOpts := networksegmentranges.UpdateOpts{
+		Maximum: &maximum,
+	}
+
+	r, err := networksegmentranges.Update(context.TODO(), networkClient, "1ab0bee5-8b4d-4bde-8a0c-18b4bd0ed1a7", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Network Segment Range
+
+	err := networksegmentranges.Delete(context.TODO(), networkClient, "1ab0bee5-8b4d-4bde-8a0c-18b4bd0ed1a7").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package networksegmentranges
@@ -0,0 +1,161 @@
+package networksegmentranges
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToNetworkSegmentRangeListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the network segment range attributes you want to see returned. SortKey
+// allows you to sort by a particular attribute. SortDir sets the direction,
+// and is either `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID              string `q:"id"`
+	Name            string `q:"name"`
+	Description     string `q:"description"`
+	TenantID        string `q:"tenant_id"`
+	ProjectID       string `q:"project_id"`
+	NetworkType     string `q:"network_type"`
+	PhysicalNetwork string `q:"physical_network"`
+	Shared          *bool  `q:"shared"`
+	Default         *bool  `q:"default"`
+	RevisionNumber  *int   `q:"revision_number"`
+	Limit           int    `q:"limit"`
+	Marker          string `q:"marker"`
+	SortKey         string `q:"sort_key"`
+	SortDir         string `q:"sort_dir"`
+	Fields          string `q:"fields"`
+}
+
+// ToNetworkSegmentRangeListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToNetworkSegmentRangeListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// network segment ranges. It accepts a ListOpts struct, which allows you to
+// filter and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToNetworkSegmentRangeListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return NetworkSegmentRangePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific network segment range based on its ID.
+func Get(ctx context.Context, c *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := c.Get(ctx, resourceURL(c, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToNetworkSegmentRangeCreateMap() (map[string]any, error)
+}
+
+// CreateOpts contains all the values needed to create a new network segment
+// range.
+type CreateOpts struct {
+	// Name is the human-readable name of the range.
+	Name string `json:"name,omitempty"`
+
+	// Description is the human-readable description of the range.
+	Description string `json:"description,omitempty"`
+
+	// Shared indicates whether the range is available to all projects. It
+	// must be false when ProjectID is set.
+	Shared *bool `json:"shared,omitempty"`
+
+	// ProjectID is the project the range is reserved for.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// NetworkType is the type of the segments, such as vlan, vxlan, gre or
+	// geneve.
+	NetworkType string `json:"network_type" required:"true"`
+
+	// PhysicalNetwork is the physical network of a vlan range.
+	PhysicalNetwork string `json:"physical_network,omitempty"`
+
+	// Minimum is the first segmentation ID of the range.
+	Minimum int `json:"minimum" required:"true"`
+
+	// Maximum is the last segmentation ID of the range.
+	Maximum int `json:"maximum" required:"true"`
+}
+
+// ToNetworkSegmentRangeCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToNetworkSegmentRangeCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "network_segment_range")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// network segment range.
+func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToNetworkSegmentRangeCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Post(ctx, rootURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToNetworkSegmentRangeUpdateMap() (map[string]any, error)
+}
+
+// UpdateOpts contains the values used when updating a network segment range.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Minimum     *int    `json:"minimum,omitempty"`
+	Maximum     *int    `json:"maximum,omitempty"`
+}
+
+// ToNetworkSegmentRangeUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToNetworkSegmentRangeUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "network_segment_range")
+}
+
+// Update allows network segment ranges to be updated.
+func Update(ctx context.Context, c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToNetworkSegmentRangeUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete will permanently delete a network segment range based on its ID.
+func Delete(ctx context.Context, c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := c.Delete(ctx, resourceURL(c, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
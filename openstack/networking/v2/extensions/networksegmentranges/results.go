@@ -0,0 +1,150 @@
+package networksegmentranges
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+)
+
+// NetworkSegmentRange represents a pool of segmentation IDs.
+type NetworkSegmentRange struct {
+	// ID is the unique ID of the range.
+	ID string `json:"id"`
+
+	// Name is the human-readable name of the range.
+	Name string `json:"name"`
+
+	// Description is the human-readable description of the range.
+	Description string `json:"description"`
+
+	// Default indicates whether the range was created from the Neutron
+	// configuration. Default ranges cannot be deleted.
+	Default bool `json:"default"`
+
+	// Shared indicates whether the range is available to all projects.
+	Shared bool `json:"shared"`
+
+	// TenantID is the project the range is reserved for.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project the range is reserved for.
+	ProjectID string `json:"project_id"`
+
+	// NetworkType is the type of the segments.
+	NetworkType string `json:"network_type"`
+
+	// PhysicalNetwork is the physical network of a vlan range.
+	PhysicalNetwork string `json:"physical_network"`
+
+	// Minimum is the first segmentation ID of the range.
+	Minimum int `json:"minimum"`
+
+	// Maximum is the last segmentation ID of the range.
+	Maximum int `json:"maximum"`
+
+	// Available lists the segmentation IDs of the range that are not
+	// allocated.
+	Available []int `json:"available"`
+
+	// Used maps the allocated segmentation IDs of the range to the project
+	// they are allocated to.
+	Used map[string]string `json:"used"`
+
+	// Tags optionally set via extensions/attributestags
+	Tags []string `json:"tags"`
+
+	// RevisionNumber is the revision number of the range.
+	RevisionNumber int `json:"revision_number"`
+
+	// CreatedAt is the time the range was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the time the range was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a
+// NetworkSegmentRange.
+func (r commonResult) Extract() (*NetworkSegmentRange, error) {
+	var s NetworkSegmentRange
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func (r commonResult) ExtractInto(v any) error {
+	return r.ExtractIntoStructPtr(v, "network_segment_range")
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a NetworkSegmentRange.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a NetworkSegmentRange.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a NetworkSegmentRange.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// NetworkSegmentRangePage is the page returned by a pager when traversing
+// over a collection of network segment ranges.
+type NetworkSegmentRangePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of network segment
+// ranges has reached the end of a page and the pager seeks to traverse over a
+// new one. In order to do this, it needs to construct the next page's URL.
+func (r NetworkSegmentRangePage) NextPageURL(endpointURL string) (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"network_segment_ranges_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a NetworkSegmentRangePage struct is empty.
+func (r NetworkSegmentRangePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractNetworkSegmentRanges(r)
+	return len(is) == 0, err
+}
+
+// ExtractNetworkSegmentRanges accepts a Page struct, specifically a
+// NetworkSegmentRangePage struct, and extracts the elements into a slice of
+// NetworkSegmentRange structs.
+func ExtractNetworkSegmentRanges(r pagination.Page) ([]NetworkSegmentRange, error) {
+	var s []NetworkSegmentRange
+	err := ExtractNetworkSegmentRangesInto(r, &s)
+	return s, err
+}
+
+// ExtractNetworkSegmentRangesInto extracts the elements into a slice of
+// NetworkSegmentRange structs.
+func ExtractNetworkSegmentRangesInto(r pagination.Page, v any) error {
+	return r.(NetworkSegmentRangePage).ExtractIntoSlicePtr(v, "network_segment_ranges")
+}
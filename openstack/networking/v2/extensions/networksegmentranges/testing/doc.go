@@ -0,0 +1,2 @@
+// networksegmentranges unit tests
+package testing
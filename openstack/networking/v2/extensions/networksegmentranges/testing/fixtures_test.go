@@ -0,0 +1,168 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/networksegmentranges"
+)
+
+const RangeID = "1ab0bee5-8b4d-4bde-8a0c-18b4bd0ed1a7"
+
+const ListResponse = `
+{
+    "network_segment_ranges": [
+        {
+            "id": "1ab0bee5-8b4d-4bde-8a0c-18b4bd0ed1a7",
+            "name": "physnet1-tenant",
+            "description": "",
+            "default": false,
+            "shared": false,
+            "tenant_id": "7011dc7fccac4efda89dc3b7f0d0975a",
+            "project_id": "7011dc7fccac4efda89dc3b7f0d0975a",
+            "network_type": "vlan",
+            "physical_network": "physnet1",
+            "minimum": 100,
+            "maximum": 103,
+            "available": [102, 103],
+            "used": {
+                "100": "7011dc7fccac4efda89dc3b7f0d0975a",
+                "101": "7011dc7fccac4efda89dc3b7f0d0975a"
+            },
+            "tags": [],
+            "revision_number": 1,
+            "created_at": "2024-03-01T12:00:00Z",
+            "updated_at": "2024-03-01T12:00:00Z"
+        },
+        {
+            "id": "c3a8e1c4-5c4e-4e6c-9d3e-0f2a44b4b5a1",
+            "name": "",
+            "description": "",
+            "default": true,
+            "shared": true,
+            "tenant_id": "",
+            "project_id": "",
+            "network_type": "vxlan",
+            "physical_network": "",
+            "minimum": 1,
+            "maximum": 2,
+            "available": [1, 2],
+            "used": {},
+            "tags": [],
+            "revision_number": 0,
+            "created_at": "2024-01-15T08:00:00Z",
+            "updated_at": "2024-01-15T08:00:00Z"
+        }
+    ]
+}
+`
+
+const GetResponse = `
+{
+    "network_segment_range": {
+        "id": "1ab0bee5-8b4d-4bde-8a0c-18b4bd0ed1a7",
+        "name": "physnet1-tenant",
+        "description": "",
+        "default": false,
+        "shared": false,
+        "tenant_id": "7011dc7fccac4efda89dc3b7f0d0975a",
+        "project_id": "7011dc7fccac4efda89dc3b7f0d0975a",
+        "network_type": "vlan",
+        "physical_network": "physnet1",
+        "minimum": 100,
+        "maximum": 103,
+        "available": [102, 103],
+        "used": {
+            "100": "7011dc7fccac4efda89dc3b7f0d0975a",
+            "101": "7011dc7fccac4efda89dc3b7f0d0975a"
+        },
+        "tags": [],
+        "revision_number": 1,
+        "created_at": "2024-03-01T12:00:00Z",
+        "updated_at": "2024-03-01T12:00:00Z"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "network_segment_range": {
+        "name": "physnet1-tenant",
+        "shared": false,
+        "project_id": "7011dc7fccac4efda89dc3b7f0d0975a",
+        "network_type": "vlan",
+        "physical_network": "physnet1",
+        "minimum": 100,
+        "maximum": 103
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "network_segment_range": {
+        "description": "Tenant VLANs",
+        "maximum": 104
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "network_segment_range": {
+        "id": "1ab0bee5-8b4d-4bde-8a0c-18b4bd0ed1a7",
+        "name": "physnet1-tenant",
+        "description": "Tenant VLANs",
+        "default": false,
+        "shared": false,
+        "tenant_id": "7011dc7fccac4efda89dc3b7f0d0975a",
+        "project_id": "7011dc7fccac4efda89dc3b7f0d0975a",
+        "network_type": "vlan",
+        "physical_network": "physnet1",
+        "minimum": 100,
+        "maximum": 104,
+        "available": [102, 103, 104],
+        "used": {
+            "100": "7011dc7fccac4efda89dc3b7f0d0975a",
+            "101": "7011dc7fccac4efda89dc3b7f0d0975a"
+        },
+        "tags": [],
+        "revision_number": 2,
+        "created_at": "2024-03-01T12:00:00Z",
+        "updated_at": "2024-03-02T09:00:00Z"
+    }
+}
+`
+
+var Range1 = networksegmentranges.NetworkSegmentRange{
+	ID:              "1ab0bee5-8b4d-4bde-8a0c-18b4bd0ed1a7",
+	Name:            "physnet1-tenant",
+	TenantID:        "7011dc7fccac4efda89dc3b7f0d0975a",
+	ProjectID:       "7011dc7fccac4efda89dc3b7f0d0975a",
+	NetworkType:     "vlan",
+	PhysicalNetwork: "physnet1",
+	Minimum:         100,
+	Maximum:         103,
+	Available:       []int{102, 103},
+	Used: map[string]string{
+		"100": "7011dc7fccac4efda89dc3b7f0d0975a",
+		"101": "7011dc7fccac4efda89dc3b7f0d0975a",
+	},
+	Tags:           []string{},
+	RevisionNumber: 1,
+	CreatedAt:      time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+	UpdatedAt:      time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+}
+
+var Range2 = networksegmentranges.NetworkSegmentRange{
+	ID:          "c3a8e1c4-5c4e-4e6c-9d3e-0f2a44b4b5a1",
+	Default:     true,
+	Shared:      true,
+	NetworkType: "vxlan",
+	Minimum:     1,
+	Maximum:     2,
+	Available:   []int{1, 2},
+	Used:        map[string]string{},
+	Tags:        []string{},
+	CreatedAt:   time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC),
+	UpdatedAt:   time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC),
+}
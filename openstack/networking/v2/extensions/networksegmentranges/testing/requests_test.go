@@ -0,0 +1,158 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/networksegmentranges"
+	"github.com/gophercloud/gophercloud/v2/pagination"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func TestList(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/network_segment_ranges", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"network_type": "vlan",
+			"shared":       "false",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListResponse)
+	})
+
+	shared := false
+	listOpts := networksegmentranges.ListOpts{
+		NetworkType: "vlan",
+		Shared:      &shared,
+	}
+
+	count := 0
+	err := networksegmentranges.List(fake.ServiceClient(fakeServer), listOpts).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := networksegmentranges.ExtractNetworkSegmentRanges(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []networksegmentranges.NetworkSegmentRange{Range1, Range2}, actual)
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/network_segment_ranges/"+RangeID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetResponse)
+	})
+
+	actual, err := networksegmentranges.Get(context.TODO(), fake.ServiceClient(fakeServer), RangeID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Range1, *actual)
+}
+
+func TestCreate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/network_segment_ranges", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, GetResponse)
+	})
+
+	shared := false
+	createOpts := networksegmentranges.CreateOpts{
+		Name:            "physnet1-tenant",
+		Shared:          &shared,
+		ProjectID:       "7011dc7fccac4efda89dc3b7f0d0975a",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "physnet1",
+		Minimum:         100,
+		Maximum:         103,
+	}
+
+	actual, err := networksegmentranges.Create(context.TODO(), fake.ServiceClient(fakeServer), createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, Range1, *actual)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	res := networksegmentranges.Create(context.TODO(), fake.ServiceClient(fakeServer), networksegmentranges.CreateOpts{Minimum: 1, Maximum: 10})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/network_segment_ranges/"+RangeID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, UpdateResponse)
+	})
+
+	description := "Tenant VLANs"
+	maximum := 104
+	updateOpts := networksegmentranges.UpdateOpts{
+		Description: &description,
+		Maximum:     &maximum,
+	}
+
+	actual, err := networksegmentranges.Update(context.TODO(), fake.ServiceClient(fakeServer), RangeID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := Range1
+	expected.Description = "Tenant VLANs"
+	expected.Maximum = 104
+	expected.Available = []int{102, 103, 104}
+	expected.RevisionNumber = 2
+	expected.UpdatedAt = time.Date(2024, 3, 2, 9, 0, 0, 0, time.UTC)
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDelete(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/network_segment_ranges/"+RangeID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := networksegmentranges.Delete(context.TODO(), fake.ServiceClient(fakeServer), RangeID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,13 @@
+package networksegmentranges
+
+import "github.com/gophercloud/gophercloud/v2"
+
+const resourcePath = "network_segment_ranges"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
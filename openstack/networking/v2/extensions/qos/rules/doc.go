@@ -232,5 +232,49 @@ Example of Deleting a single MinimumBandwidthRule
 	if err != nil {
 	    panic(err)
 	}
+
+Example of Creating a single PacketRateLimitRule
+
+	opts := rules.CreatePacketRateLimitRuleOpts{
+	    MaxKPps:      2000,
+	    MaxBurstKPps: 200,
+	    Direction:    rules.DirectionIngress,
+	}
+
+	policyID := "501005fa-3b56-4061-aaca-3f24995112e1"
+
+	rule, err := rules.CreatePacketRateLimitRule(context.TODO(), networkClient, policyID, opts).ExtractPacketRateLimitRule()
+	if err != nil {
+	    panic(err)
+	}
+
+	fmt.Printf("Rule: %+v\n", rule)
+
+Example of Creating a single MinimumPacketRateRule
+
+	opts := rules.CreateMinimumPacketRateRuleOpts{
+	    MinKPps:   1000,
+	    Direction: rules.DirectionAny,
+	}
+
+	policyID := "501005fa-3b56-4061-aaca-3f24995112e1"
+
+	rule, err := rules.CreateMinimumPacketRateRule(context.TODO(), networkClient, policyID, opts).ExtractMinimumPacketRateRule()
+	if err != nil {
+	    panic(err)
+	}
+
+	fmt.Printf("Rule: %+v\n", rule)
+
+Example of Getting a single rule without its policy ID
+
+	ruleID := "30a57f4a-336b-4382-8275-d708babd2241"
+
+	rule, err := rules.GetMinimumPacketRateRuleAlias(context.TODO(), networkClient, ruleID).ExtractMinimumPacketRateRule()
+	if err != nil {
+	    panic(err)
+	}
+
+	fmt.Printf("Rule: %+v\n", rule)
 */
 package rules
@@ -7,6 +7,18 @@ import (
 	"github.com/gophercloud/gophercloud/v2/pagination"
 )
 
+const (
+	// DirectionIngress applies a rule to the traffic entering the port.
+	DirectionIngress = "ingress"
+
+	// DirectionEgress applies a rule to the traffic leaving the port.
+	DirectionEgress = "egress"
+
+	// DirectionAny applies a minimum packet rate rule to the traffic in both
+	// directions.
+	DirectionAny = "any"
+)
+
 // ListOptsBuilder allows extensions to add additional parameters to the
 // List request.
 type BandwidthLimitRulesListOptsBuilder interface {
@@ -405,3 +417,450 @@ func DeleteMinimumBandwidthRule(ctx context.Context, c *gophercloud.ServiceClien
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// PacketRateLimitRulesListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type PacketRateLimitRulesListOptsBuilder interface {
+	ToPacketRateLimitRulesListQuery() (string, error)
+}
+
+// PacketRateLimitRulesListOpts allows the filtering and sorting of paginated collections through
+// the Neutron API. Filtering is achieved by passing in struct field values
+// that map to the PacketRateLimitRules attributes you want to see returned.
+// SortKey allows you to sort by a particular PacketRateLimitRule attribute.
+// SortDir sets the direction, and is either `asc' or `desc'.
+// Marker and Limit are used for the pagination.
+type PacketRateLimitRulesListOpts struct {
+	ID           string `q:"id"`
+	TenantID     string `q:"tenant_id"`
+	MaxKPps      int    `q:"max_kpps"`
+	MaxBurstKPps int    `q:"max_burst_kpps"`
+	Direction    string `q:"direction"`
+	Limit        int    `q:"limit"`
+	Marker       string `q:"marker"`
+	SortKey      string `q:"sort_key"`
+	SortDir      string `q:"sort_dir"`
+	Tags         string `q:"tags"`
+	TagsAny      string `q:"tags-any"`
+	NotTags      string `q:"not-tags"`
+	NotTagsAny   string `q:"not-tags-any"`
+}
+
+// ToPacketRateLimitRulesListQuery formats a ListOpts into a query string.
+func (opts PacketRateLimitRulesListOpts) ToPacketRateLimitRulesListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// ListPacketRateLimitRules returns a Pager which allows you to iterate over a collection of
+// PacketRateLimitRules. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func ListPacketRateLimitRules(c *gophercloud.ServiceClient, policyID string, opts PacketRateLimitRulesListOptsBuilder) pagination.Pager {
+	url := listPacketRateLimitRulesURL(c, policyID)
+	if opts != nil {
+		query, err := opts.ToPacketRateLimitRulesListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return PacketRateLimitRulePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetPacketRateLimitRule retrieves a specific PacketRateLimitRule based on its ID.
+func GetPacketRateLimitRule(ctx context.Context, c *gophercloud.ServiceClient, policyID, ruleID string) (r GetPacketRateLimitRuleResult) {
+	resp, err := c.Get(ctx, getPacketRateLimitRuleURL(c, policyID, ruleID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreatePacketRateLimitRuleOptsBuilder allows to add additional parameters to the
+// CreatePacketRateLimitRule request.
+type CreatePacketRateLimitRuleOptsBuilder interface {
+	ToPacketRateLimitRuleCreateMap() (map[string]any, error)
+}
+
+// CreatePacketRateLimitRuleOpts specifies parameters of a new PacketRateLimitRule.
+type CreatePacketRateLimitRuleOpts struct {
+	// MaxKPps is a maximum kilo (1000) packets per second. It's a required parameter.
+	MaxKPps int `json:"max_kpps"`
+
+	// MaxBurstKPps is a maximum burst size in kilo (1000) packets.
+	MaxBurstKPps int `json:"max_burst_kpps,omitempty"`
+
+	// Direction represents the direction of traffic, DirectionIngress or
+	// DirectionEgress.
+	Direction string `json:"direction,omitempty"`
+}
+
+// ToPacketRateLimitRuleCreateMap constructs a request body from CreatePacketRateLimitRuleOpts.
+func (opts CreatePacketRateLimitRuleOpts) ToPacketRateLimitRuleCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "packet_rate_limit_rule")
+}
+
+// CreatePacketRateLimitRule requests the creation of a new PacketRateLimitRule on the server.
+func CreatePacketRateLimitRule(ctx context.Context, client *gophercloud.ServiceClient, policyID string, opts CreatePacketRateLimitRuleOptsBuilder) (r CreatePacketRateLimitRuleResult) {
+	b, err := opts.ToPacketRateLimitRuleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, createPacketRateLimitRuleURL(client, policyID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdatePacketRateLimitRuleOptsBuilder allows to add additional parameters to the
+// UpdatePacketRateLimitRule request.
+type UpdatePacketRateLimitRuleOptsBuilder interface {
+	ToPacketRateLimitRuleUpdateMap() (map[string]any, error)
+}
+
+// UpdatePacketRateLimitRuleOpts specifies parameters for the Update call.
+type UpdatePacketRateLimitRuleOpts struct {
+	// MaxKPps is a maximum kilo (1000) packets per second.
+	MaxKPps *int `json:"max_kpps,omitempty"`
+
+	// MaxBurstKPps is a maximum burst size in kilo (1000) packets.
+	MaxBurstKPps *int `json:"max_burst_kpps,omitempty"`
+
+	// Direction represents the direction of traffic, DirectionIngress or
+	// DirectionEgress.
+	Direction string `json:"direction,omitempty"`
+}
+
+// ToPacketRateLimitRuleUpdateMap constructs a request body from UpdatePacketRateLimitRuleOpts.
+func (opts UpdatePacketRateLimitRuleOpts) ToPacketRateLimitRuleUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "packet_rate_limit_rule")
+}
+
+// UpdatePacketRateLimitRule requests the update of a PacketRateLimitRule on the server.
+func UpdatePacketRateLimitRule(ctx context.Context, client *gophercloud.ServiceClient, policyID, ruleID string, opts UpdatePacketRateLimitRuleOptsBuilder) (r UpdatePacketRateLimitRuleResult) {
+	b, err := opts.ToPacketRateLimitRuleUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, updatePacketRateLimitRuleURL(client, policyID, ruleID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeletePacketRateLimitRule accepts policy and rule ID and deletes the PacketRateLimitRule associated with them.
+func DeletePacketRateLimitRule(ctx context.Context, c *gophercloud.ServiceClient, policyID, ruleID string) (r DeletePacketRateLimitRuleResult) {
+	resp, err := c.Delete(ctx, deletePacketRateLimitRuleURL(c, policyID, ruleID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// MinimumPacketRateRulesListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type MinimumPacketRateRulesListOptsBuilder interface {
+	ToMinimumPacketRateRulesListQuery() (string, error)
+}
+
+// MinimumPacketRateRulesListOpts allows the filtering and sorting of paginated collections through
+// the Neutron API. Filtering is achieved by passing in struct field values
+// that map to the MinimumPacketRateRules attributes you want to see returned.
+// SortKey allows you to sort by a particular MinimumPacketRateRule attribute.
+// SortDir sets the direction, and is either `asc' or `desc'.
+// Marker and Limit are used for the pagination.
+type MinimumPacketRateRulesListOpts struct {
+	ID         string `q:"id"`
+	TenantID   string `q:"tenant_id"`
+	MinKPps    int    `q:"min_kpps"`
+	Direction  string `q:"direction"`
+	Limit      int    `q:"limit"`
+	Marker     string `q:"marker"`
+	SortKey    string `q:"sort_key"`
+	SortDir    string `q:"sort_dir"`
+	Tags       string `q:"tags"`
+	TagsAny    string `q:"tags-any"`
+	NotTags    string `q:"not-tags"`
+	NotTagsAny string `q:"not-tags-any"`
+}
+
+// ToMinimumPacketRateRulesListQuery formats a ListOpts into a query string.
+func (opts MinimumPacketRateRulesListOpts) ToMinimumPacketRateRulesListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// ListMinimumPacketRateRules returns a Pager which allows you to iterate over a collection of
+// MinimumPacketRateRules. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func ListMinimumPacketRateRules(c *gophercloud.ServiceClient, policyID string, opts MinimumPacketRateRulesListOptsBuilder) pagination.Pager {
+	url := listMinimumPacketRateRulesURL(c, policyID)
+	if opts != nil {
+		query, err := opts.ToMinimumPacketRateRulesListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return MinimumPacketRateRulePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// GetMinimumPacketRateRule retrieves a specific MinimumPacketRateRule based on its ID.
+func GetMinimumPacketRateRule(ctx context.Context, c *gophercloud.ServiceClient, policyID, ruleID string) (r GetMinimumPacketRateRuleResult) {
+	resp, err := c.Get(ctx, getMinimumPacketRateRuleURL(c, policyID, ruleID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateMinimumPacketRateRuleOptsBuilder allows to add additional parameters to the
+// CreateMinimumPacketRateRule request.
+type CreateMinimumPacketRateRuleOptsBuilder interface {
+	ToMinimumPacketRateRuleCreateMap() (map[string]any, error)
+}
+
+// CreateMinimumPacketRateRuleOpts specifies parameters of a new MinimumPacketRateRule.
+type CreateMinimumPacketRateRuleOpts struct {
+	// MinKPps is a minimum kilo (1000) packets per second. It's a required parameter.
+	MinKPps int `json:"min_kpps"`
+
+	// Direction represents the direction of traffic, DirectionAny,
+	// DirectionIngress or DirectionEgress. Neutron defaults to DirectionEgress.
+	Direction string `json:"direction,omitempty"`
+}
+
+// ToMinimumPacketRateRuleCreateMap constructs a request body from CreateMinimumPacketRateRuleOpts.
+func (opts CreateMinimumPacketRateRuleOpts) ToMinimumPacketRateRuleCreateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "minimum_packet_rate_rule")
+}
+
+// CreateMinimumPacketRateRule requests the creation of a new MinimumPacketRateRule on the server.
+func CreateMinimumPacketRateRule(ctx context.Context, client *gophercloud.ServiceClient, policyID string, opts CreateMinimumPacketRateRuleOptsBuilder) (r CreateMinimumPacketRateRuleResult) {
+	b, err := opts.ToMinimumPacketRateRuleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(ctx, createMinimumPacketRateRuleURL(client, policyID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateMinimumPacketRateRuleOptsBuilder allows to add additional parameters to the
+// UpdateMinimumPacketRateRule request.
+type UpdateMinimumPacketRateRuleOptsBuilder interface {
+	ToMinimumPacketRateRuleUpdateMap() (map[string]any, error)
+}
+
+// UpdateMinimumPacketRateRuleOpts specifies parameters for the Update call.
+type UpdateMinimumPacketRateRuleOpts struct {
+	// MinKPps is a minimum kilo (1000) packets per second.
+	MinKPps *int `json:"min_kpps,omitempty"`
+
+	// Direction represents the direction of traffic, DirectionAny,
+	// DirectionIngress or DirectionEgress.
+	Direction string `json:"direction,omitempty"`
+}
+
+// ToMinimumPacketRateRuleUpdateMap constructs a request body from UpdateMinimumPacketRateRuleOpts.
+func (opts UpdateMinimumPacketRateRuleOpts) ToMinimumPacketRateRuleUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "minimum_packet_rate_rule")
+}
+
+// UpdateMinimumPacketRateRule requests the update of a MinimumPacketRateRule on the server.
+func UpdateMinimumPacketRateRule(ctx context.Context, client *gophercloud.ServiceClient, policyID, ruleID string, opts UpdateMinimumPacketRateRuleOptsBuilder) (r UpdateMinimumPacketRateRuleResult) {
+	b, err := opts.ToMinimumPacketRateRuleUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Put(ctx, updateMinimumPacketRateRuleURL(client, policyID, ruleID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteMinimumPacketRateRule accepts policy and rule ID and deletes the MinimumPacketRateRule associated with them.
+func DeleteMinimumPacketRateRule(ctx context.Context, c *gophercloud.ServiceClient, policyID, ruleID string) (r DeleteMinimumPacketRateRuleResult) {
+	resp, err := c.Delete(ctx, deleteMinimumPacketRateRuleURL(c, policyID, ruleID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetBandwidthLimitRuleAlias retrieves a specific BandwidthLimitRule based on its ID only,
+// using the qos-rules-alias extension.
+func GetBandwidthLimitRuleAlias(ctx context.Context, c *gophercloud.ServiceClient, ruleID string) (r GetBandwidthLimitRuleAliasResult) {
+	resp, err := c.Get(ctx, aliasURL(c, bandwidthLimitRulesResourcePath, ruleID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateBandwidthLimitRuleAliasOptsBuilder allows to add additional parameters to the
+// UpdateBandwidthLimitRuleAlias request.
+type UpdateBandwidthLimitRuleAliasOptsBuilder interface {
+	ToBandwidthLimitRuleAliasUpdateMap() (map[string]any, error)
+}
+
+// ToBandwidthLimitRuleAliasUpdateMap constructs a request body for UpdateBandwidthLimitRuleAlias
+// from UpdateBandwidthLimitRuleOpts.
+func (opts UpdateBandwidthLimitRuleOpts) ToBandwidthLimitRuleAliasUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "alias_bandwidth_limit_rule")
+}
+
+// UpdateBandwidthLimitRuleAlias updates a specific BandwidthLimitRule based on its ID only,
+// using the qos-rules-alias extension.
+func UpdateBandwidthLimitRuleAlias(ctx context.Context, c *gophercloud.ServiceClient, ruleID string, opts UpdateBandwidthLimitRuleAliasOptsBuilder) (r UpdateBandwidthLimitRuleAliasResult) {
+	b, err := opts.ToBandwidthLimitRuleAliasUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, aliasURL(c, bandwidthLimitRulesResourcePath, ruleID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteBandwidthLimitRuleAlias deletes a specific BandwidthLimitRule based on its ID only,
+// using the qos-rules-alias extension.
+func DeleteBandwidthLimitRuleAlias(ctx context.Context, c *gophercloud.ServiceClient, ruleID string) (r DeleteBandwidthLimitRuleResult) {
+	resp, err := c.Delete(ctx, aliasURL(c, bandwidthLimitRulesResourcePath, ruleID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetDSCPMarkingRuleAlias retrieves a specific DSCPMarkingRule based on its ID only,
+// using the qos-rules-alias extension.
+func GetDSCPMarkingRuleAlias(ctx context.Context, c *gophercloud.ServiceClient, ruleID string) (r GetDSCPMarkingRuleAliasResult) {
+	resp, err := c.Get(ctx, aliasURL(c, dscpMarkingRulesResourcePath, ruleID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateDSCPMarkingRuleAliasOptsBuilder allows to add additional parameters to the
+// UpdateDSCPMarkingRuleAlias request.
+type UpdateDSCPMarkingRuleAliasOptsBuilder interface {
+	ToDSCPMarkingRuleAliasUpdateMap() (map[string]any, error)
+}
+
+// ToDSCPMarkingRuleAliasUpdateMap constructs a request body for UpdateDSCPMarkingRuleAlias
+// from UpdateDSCPMarkingRuleOpts.
+func (opts UpdateDSCPMarkingRuleOpts) ToDSCPMarkingRuleAliasUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "alias_dscp_marking_rule")
+}
+
+// UpdateDSCPMarkingRuleAlias updates a specific DSCPMarkingRule based on its ID only,
+// using the qos-rules-alias extension.
+func UpdateDSCPMarkingRuleAlias(ctx context.Context, c *gophercloud.ServiceClient, ruleID string, opts UpdateDSCPMarkingRuleAliasOptsBuilder) (r UpdateDSCPMarkingRuleAliasResult) {
+	b, err := opts.ToDSCPMarkingRuleAliasUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, aliasURL(c, dscpMarkingRulesResourcePath, ruleID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteDSCPMarkingRuleAlias deletes a specific DSCPMarkingRule based on its ID only,
+// using the qos-rules-alias extension.
+func DeleteDSCPMarkingRuleAlias(ctx context.Context, c *gophercloud.ServiceClient, ruleID string) (r DeleteDSCPMarkingRuleResult) {
+	resp, err := c.Delete(ctx, aliasURL(c, dscpMarkingRulesResourcePath, ruleID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetMinimumBandwidthRuleAlias retrieves a specific MinimumBandwidthRule based on its ID only,
+// using the qos-rules-alias extension.
+func GetMinimumBandwidthRuleAlias(ctx context.Context, c *gophercloud.ServiceClient, ruleID string) (r GetMinimumBandwidthRuleAliasResult) {
+	resp, err := c.Get(ctx, aliasURL(c, minimumBandwidthRulesResourcePath, ruleID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateMinimumBandwidthRuleAliasOptsBuilder allows to add additional parameters to the
+// UpdateMinimumBandwidthRuleAlias request.
+type UpdateMinimumBandwidthRuleAliasOptsBuilder interface {
+	ToMinimumBandwidthRuleAliasUpdateMap() (map[string]any, error)
+}
+
+// ToMinimumBandwidthRuleAliasUpdateMap constructs a request body for UpdateMinimumBandwidthRuleAlias
+// from UpdateMinimumBandwidthRuleOpts.
+func (opts UpdateMinimumBandwidthRuleOpts) ToMinimumBandwidthRuleAliasUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "alias_minimum_bandwidth_rule")
+}
+
+// UpdateMinimumBandwidthRuleAlias updates a specific MinimumBandwidthRule based on its ID only,
+// using the qos-rules-alias extension.
+func UpdateMinimumBandwidthRuleAlias(ctx context.Context, c *gophercloud.ServiceClient, ruleID string, opts UpdateMinimumBandwidthRuleAliasOptsBuilder) (r UpdateMinimumBandwidthRuleAliasResult) {
+	b, err := opts.ToMinimumBandwidthRuleAliasUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, aliasURL(c, minimumBandwidthRulesResourcePath, ruleID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteMinimumBandwidthRuleAlias deletes a specific MinimumBandwidthRule based on its ID only,
+// using the qos-rules-alias extension.
+func DeleteMinimumBandwidthRuleAlias(ctx context.Context, c *gophercloud.ServiceClient, ruleID string) (r DeleteMinimumBandwidthRuleResult) {
+	resp, err := c.Delete(ctx, aliasURL(c, minimumBandwidthRulesResourcePath, ruleID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// GetMinimumPacketRateRuleAlias retrieves a specific MinimumPacketRateRule based on its ID only,
+// using the qos-rules-alias extension.
+func GetMinimumPacketRateRuleAlias(ctx context.Context, c *gophercloud.ServiceClient, ruleID string) (r GetMinimumPacketRateRuleAliasResult) {
+	resp, err := c.Get(ctx, aliasURL(c, minimumPacketRateRulesResourcePath, ruleID), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// UpdateMinimumPacketRateRuleAliasOptsBuilder allows to add additional parameters to the
+// UpdateMinimumPacketRateRuleAlias request.
+type UpdateMinimumPacketRateRuleAliasOptsBuilder interface {
+	ToMinimumPacketRateRuleAliasUpdateMap() (map[string]any, error)
+}
+
+// ToMinimumPacketRateRuleAliasUpdateMap constructs a request body for UpdateMinimumPacketRateRuleAlias
+// from UpdateMinimumPacketRateRuleOpts.
+func (opts UpdateMinimumPacketRateRuleOpts) ToMinimumPacketRateRuleAliasUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "alias_minimum_packet_rate_rule")
+}
+
+// UpdateMinimumPacketRateRuleAlias updates a specific MinimumPacketRateRule based on its ID only,
+// using the qos-rules-alias extension.
+func UpdateMinimumPacketRateRuleAlias(ctx context.Context, c *gophercloud.ServiceClient, ruleID string, opts UpdateMinimumPacketRateRuleAliasOptsBuilder) (r UpdateMinimumPacketRateRuleAliasResult) {
+	b, err := opts.ToMinimumPacketRateRuleAliasUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := c.Put(ctx, aliasURL(c, minimumPacketRateRulesResourcePath, ruleID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// DeleteMinimumPacketRateRuleAlias deletes a specific MinimumPacketRateRule based on its ID only,
+// using the qos-rules-alias extension.
+func DeleteMinimumPacketRateRuleAlias(ctx context.Context, c *gophercloud.ServiceClient, ruleID string) (r DeleteMinimumPacketRateRuleResult) {
+	resp, err := c.Delete(ctx, aliasURL(c, minimumPacketRateRulesResourcePath, ruleID), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -245,3 +245,254 @@ func ExtractMinimumBandwidthRules(r pagination.Page) ([]MinimumBandwidthRule, er
 func ExtractMinimumBandwidthRulesInto(r pagination.Page, v any) error {
 	return r.(MinimumBandwidthRulePage).ExtractIntoSlicePtr(v, "minimum_bandwidth_rules")
 }
+
+// ExtractPacketRateLimitRule is a function that accepts a result and extracts a PacketRateLimitRule.
+func (r commonResult) ExtractPacketRateLimitRule() (*PacketRateLimitRule, error) {
+	var s struct {
+		PacketRateLimitRule *PacketRateLimitRule `json:"packet_rate_limit_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.PacketRateLimitRule, err
+}
+
+// GetPacketRateLimitRuleResult represents the result of a Get operation. Call its ExtractPacketRateLimitRule
+// method to interpret it as a PacketRateLimitRule.
+type GetPacketRateLimitRuleResult struct {
+	commonResult
+}
+
+// CreatePacketRateLimitRuleResult represents the result of a Create operation. Call its ExtractPacketRateLimitRule
+// method to interpret it as a PacketRateLimitRule.
+type CreatePacketRateLimitRuleResult struct {
+	commonResult
+}
+
+// UpdatePacketRateLimitRuleResult represents the result of a Update operation. Call its ExtractPacketRateLimitRule
+// method to interpret it as a PacketRateLimitRule.
+type UpdatePacketRateLimitRuleResult struct {
+	commonResult
+}
+
+// DeletePacketRateLimitRuleResult represents the result of a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeletePacketRateLimitRuleResult struct {
+	gophercloud.ErrResult
+}
+
+// PacketRateLimitRule represents a QoS policy rule to limit the packet rate.
+type PacketRateLimitRule struct {
+	// ID is a unique ID of the rule.
+	ID string `json:"id"`
+
+	// TenantID is the ID of the Identity project.
+	TenantID string `json:"tenant_id"`
+
+	// MaxKPps is a maximum kilo (1000) packets per second.
+	MaxKPps int `json:"max_kpps"`
+
+	// MaxBurstKPps is a maximum burst size in kilo (1000) packets.
+	MaxBurstKPps int `json:"max_burst_kpps"`
+
+	// Direction represents the direction of traffic.
+	Direction string `json:"direction"`
+
+	// Tags optionally set via extensions/attributestags.
+	Tags []string `json:"tags"`
+}
+
+// PacketRateLimitRulePage stores a single page of PacketRateLimitRules from a List() API call.
+type PacketRateLimitRulePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty checks whether a PacketRateLimitRulePage is empty.
+func (r PacketRateLimitRulePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractPacketRateLimitRules(r)
+	return len(is) == 0, err
+}
+
+// ExtractPacketRateLimitRules accepts a PacketRateLimitRulePage, and extracts the elements into a slice of
+// PacketRateLimitRules.
+func ExtractPacketRateLimitRules(r pagination.Page) ([]PacketRateLimitRule, error) {
+	var s []PacketRateLimitRule
+	err := ExtractPacketRateLimitRulesInto(r, &s)
+	return s, err
+}
+
+// ExtractPacketRateLimitRulesInto extracts the elements into a slice of PacketRateLimitRule structs.
+func ExtractPacketRateLimitRulesInto(r pagination.Page, v any) error {
+	return r.(PacketRateLimitRulePage).ExtractIntoSlicePtr(v, "packet_rate_limit_rules")
+}
+
+// ExtractMinimumPacketRateRule is a function that accepts a result and extracts a MinimumPacketRateRule.
+func (r commonResult) ExtractMinimumPacketRateRule() (*MinimumPacketRateRule, error) {
+	var s struct {
+		MinimumPacketRateRule *MinimumPacketRateRule `json:"minimum_packet_rate_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.MinimumPacketRateRule, err
+}
+
+// GetMinimumPacketRateRuleResult represents the result of a Get operation. Call its ExtractMinimumPacketRateRule
+// method to interpret it as a MinimumPacketRateRule.
+type GetMinimumPacketRateRuleResult struct {
+	commonResult
+}
+
+// CreateMinimumPacketRateRuleResult represents the result of a Create operation. Call its ExtractMinimumPacketRateRule
+// method to interpret it as a MinimumPacketRateRule.
+type CreateMinimumPacketRateRuleResult struct {
+	commonResult
+}
+
+// UpdateMinimumPacketRateRuleResult represents the result of a Update operation. Call its ExtractMinimumPacketRateRule
+// method to interpret it as a MinimumPacketRateRule.
+type UpdateMinimumPacketRateRuleResult struct {
+	commonResult
+}
+
+// DeleteMinimumPacketRateRuleResult represents the result of a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteMinimumPacketRateRuleResult struct {
+	gophercloud.ErrResult
+}
+
+// MinimumPacketRateRule represents a QoS policy rule to guarantee a minimum packet rate.
+type MinimumPacketRateRule struct {
+	// ID is a unique ID of the rule.
+	ID string `json:"id"`
+
+	// TenantID is the ID of the Identity project.
+	TenantID string `json:"tenant_id"`
+
+	// MinKPps is a minimum kilo (1000) packets per second.
+	MinKPps int `json:"min_kpps"`
+
+	// Direction represents the direction of traffic.
+	Direction string `json:"direction"`
+
+	// Tags optionally set via extensions/attributestags.
+	Tags []string `json:"tags"`
+}
+
+// MinimumPacketRateRulePage stores a single page of MinimumPacketRateRules from a List() API call.
+type MinimumPacketRateRulePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty checks whether a MinimumPacketRateRulePage is empty.
+func (r MinimumPacketRateRulePage) IsEmpty() (bool, error) {
+	if r.StatusCode == 204 {
+		return true, nil
+	}
+
+	is, err := ExtractMinimumPacketRateRules(r)
+	return len(is) == 0, err
+}
+
+// ExtractMinimumPacketRateRules accepts a MinimumPacketRateRulePage, and extracts the elements into a slice of
+// MinimumPacketRateRules.
+func ExtractMinimumPacketRateRules(r pagination.Page) ([]MinimumPacketRateRule, error) {
+	var s []MinimumPacketRateRule
+	err := ExtractMinimumPacketRateRulesInto(r, &s)
+	return s, err
+}
+
+// ExtractMinimumPacketRateRulesInto extracts the elements into a slice of MinimumPacketRateRule structs.
+func ExtractMinimumPacketRateRulesInto(r pagination.Page, v any) error {
+	return r.(MinimumPacketRateRulePage).ExtractIntoSlicePtr(v, "minimum_packet_rate_rules")
+}
+
+// aliasResult is the result of an operation on a rule reached through the
+// qos-rules-alias extension, which wraps the rule in an "alias_" prefixed key.
+type aliasResult struct {
+	gophercloud.Result
+}
+
+// ExtractBandwidthLimitRule is a function that accepts a result and extracts a BandwidthLimitRule.
+func (r aliasResult) ExtractBandwidthLimitRule() (*BandwidthLimitRule, error) {
+	var s struct {
+		BandwidthLimitRule *BandwidthLimitRule `json:"alias_bandwidth_limit_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.BandwidthLimitRule, err
+}
+
+// GetBandwidthLimitRuleAliasResult represents the result of a GetBandwidthLimitRuleAlias operation.
+// Call its ExtractBandwidthLimitRule method to interpret it as a BandwidthLimitRule.
+type GetBandwidthLimitRuleAliasResult struct {
+	aliasResult
+}
+
+// UpdateBandwidthLimitRuleAliasResult represents the result of an UpdateBandwidthLimitRuleAlias
+// operation. Call its ExtractBandwidthLimitRule method to interpret it as a BandwidthLimitRule.
+type UpdateBandwidthLimitRuleAliasResult struct {
+	aliasResult
+}
+
+// ExtractDSCPMarkingRule is a function that accepts a result and extracts a DSCPMarkingRule.
+func (r aliasResult) ExtractDSCPMarkingRule() (*DSCPMarkingRule, error) {
+	var s struct {
+		DSCPMarkingRule *DSCPMarkingRule `json:"alias_dscp_marking_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.DSCPMarkingRule, err
+}
+
+// GetDSCPMarkingRuleAliasResult represents the result of a GetDSCPMarkingRuleAlias operation.
+// Call its ExtractDSCPMarkingRule method to interpret it as a DSCPMarkingRule.
+type GetDSCPMarkingRuleAliasResult struct {
+	aliasResult
+}
+
+// UpdateDSCPMarkingRuleAliasResult represents the result of an UpdateDSCPMarkingRuleAlias
+// operation. Call its ExtractDSCPMarkingRule method to interpret it as a DSCPMarkingRule.
+type UpdateDSCPMarkingRuleAliasResult struct {
+	aliasResult
+}
+
+// ExtractMinimumBandwidthRule is a function that accepts a result and extracts a MinimumBandwidthRule.
+func (r aliasResult) ExtractMinimumBandwidthRule() (*MinimumBandwidthRule, error) {
+	var s struct {
+		MinimumBandwidthRule *MinimumBandwidthRule `json:"alias_minimum_bandwidth_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.MinimumBandwidthRule, err
+}
+
+// GetMinimumBandwidthRuleAliasResult represents the result of a GetMinimumBandwidthRuleAlias operation.
+// Call its ExtractMinimumBandwidthRule method to interpret it as a MinimumBandwidthRule.
+type GetMinimumBandwidthRuleAliasResult struct {
+	aliasResult
+}
+
+// UpdateMinimumBandwidthRuleAliasResult represents the result of an UpdateMinimumBandwidthRuleAlias
+// operation. Call its ExtractMinimumBandwidthRule method to interpret it as a MinimumBandwidthRule.
+type UpdateMinimumBandwidthRuleAliasResult struct {
+	aliasResult
+}
+
+// ExtractMinimumPacketRateRule is a function that accepts a result and extracts a MinimumPacketRateRule.
+func (r aliasResult) ExtractMinimumPacketRateRule() (*MinimumPacketRateRule, error) {
+	var s struct {
+		MinimumPacketRateRule *MinimumPacketRateRule `json:"alias_minimum_packet_rate_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.MinimumPacketRateRule, err
+}
+
+// GetMinimumPacketRateRuleAliasResult represents the result of a GetMinimumPacketRateRuleAlias operation.
+// Call its ExtractMinimumPacketRateRule method to interpret it as a MinimumPacketRateRule.
+type GetMinimumPacketRateRuleAliasResult struct {
+	aliasResult
+}
+
+// UpdateMinimumPacketRateRuleAliasResult represents the result of an UpdateMinimumPacketRateRuleAlias
+// operation. Call its ExtractMinimumPacketRateRule method to interpret it as a MinimumPacketRateRule.
+type UpdateMinimumPacketRateRuleAliasResult struct {
+	aliasResult
+}
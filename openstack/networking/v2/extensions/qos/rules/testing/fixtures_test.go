@@ -189,3 +189,266 @@ const MinimumBandwidthRulesUpdateResult = `
     }
 }
 `
+
+// PacketRateLimitRulesListResult represents a raw result of a List call to PacketRateLimitRules.
+const PacketRateLimitRulesListResult = `
+{
+    "packet_rate_limit_rules": [
+        {
+            "max_kpps": 3000,
+            "max_burst_kpps": 300,
+            "direction": "egress",
+            "id": "30a57f4a-336b-4382-8275-d708babd2241"
+        }
+    ]
+}
+`
+
+// PacketRateLimitRulesGetResult represents a raw result of a Get call to a specific PacketRateLimitRule.
+const PacketRateLimitRulesGetResult = `
+{
+    "packet_rate_limit_rule": {
+        "max_kpps": 3000,
+        "max_burst_kpps": 300,
+        "direction": "egress",
+        "id": "30a57f4a-336b-4382-8275-d708babd2241"
+    }
+}
+`
+
+// PacketRateLimitRulesCreateRequest represents a raw body of a Create PacketRateLimitRule call.
+const PacketRateLimitRulesCreateRequest = `
+{
+    "packet_rate_limit_rule": {
+        "max_kpps": 2000,
+        "max_burst_kpps": 200,
+        "direction": "ingress"
+    }
+}
+`
+
+// PacketRateLimitRulesCreateResult represents a raw result of a Create PacketRateLimitRule call.
+const PacketRateLimitRulesCreateResult = `
+{
+    "packet_rate_limit_rule": {
+        "max_kpps": 2000,
+        "max_burst_kpps": 200,
+        "direction": "ingress",
+        "id": "30a57f4a-336b-4382-8275-d708babd2241"
+    }
+}
+`
+
+// PacketRateLimitRulesUpdateRequest represents a raw body of a Update PacketRateLimitRule call.
+const PacketRateLimitRulesUpdateRequest = `
+{
+    "packet_rate_limit_rule": {
+        "max_kpps": 500,
+        "max_burst_kpps": 0
+    }
+}
+`
+
+// PacketRateLimitRulesUpdateResult represents a raw result of a Update PacketRateLimitRule call.
+const PacketRateLimitRulesUpdateResult = `
+{
+    "packet_rate_limit_rule": {
+        "max_kpps": 500,
+        "max_burst_kpps": 0,
+        "direction": "ingress",
+        "id": "30a57f4a-336b-4382-8275-d708babd2241"
+    }
+}
+`
+
+// MinimumPacketRateRulesListResult represents a raw result of a List call to MinimumPacketRateRules.
+const MinimumPacketRateRulesListResult = `
+{
+    "minimum_packet_rate_rules": [
+        {
+            "min_kpps": 1000,
+            "direction": "any",
+            "id": "46d6e4d3-1f3a-4f5d-a9e1-3a6cb40e4b8c"
+        }
+    ]
+}
+`
+
+// MinimumPacketRateRulesGetResult represents a raw result of a Get call to a specific MinimumPacketRateRule.
+const MinimumPacketRateRulesGetResult = `
+{
+    "minimum_packet_rate_rule": {
+        "min_kpps": 1000,
+        "direction": "any",
+        "id": "46d6e4d3-1f3a-4f5d-a9e1-3a6cb40e4b8c"
+    }
+}
+`
+
+// MinimumPacketRateRulesCreateRequest represents a raw body of a Create MinimumPacketRateRule call.
+const MinimumPacketRateRulesCreateRequest = `
+{
+    "minimum_packet_rate_rule": {
+        "min_kpps": 1000,
+        "direction": "any"
+    }
+}
+`
+
+// MinimumPacketRateRulesCreateResult represents a raw result of a Create MinimumPacketRateRule call.
+const MinimumPacketRateRulesCreateResult = MinimumPacketRateRulesGetResult
+
+// MinimumPacketRateRulesUpdateRequest represents a raw body of a Update MinimumPacketRateRule call.
+const MinimumPacketRateRulesUpdateRequest = `
+{
+    "minimum_packet_rate_rule": {
+        "min_kpps": 500
+    }
+}
+`
+
+// MinimumPacketRateRulesUpdateResult represents a raw result of a Update MinimumPacketRateRule call.
+const MinimumPacketRateRulesUpdateResult = `
+{
+    "minimum_packet_rate_rule": {
+        "min_kpps": 500,
+        "direction": "any",
+        "id": "46d6e4d3-1f3a-4f5d-a9e1-3a6cb40e4b8c"
+    }
+}
+`
+
+// BandwidthLimitRuleAliasGetResult represents a raw result of a Get call to a
+// BandwidthLimitRule through the qos-rules-alias extension.
+const BandwidthLimitRuleAliasGetResult = `
+{
+    "alias_bandwidth_limit_rule": {
+        "max_kbps": 3000,
+        "direction": "egress",
+        "id": "30a57f4a-336b-4382-8275-d708babd2241",
+        "max_burst_kbps": 300
+    }
+}
+`
+
+// BandwidthLimitRuleAliasUpdateRequest represents a raw body of an Update call
+// to a BandwidthLimitRule through the qos-rules-alias extension.
+const BandwidthLimitRuleAliasUpdateRequest = `
+{
+    "alias_bandwidth_limit_rule": {
+        "max_kbps": 500,
+        "max_burst_kbps": 0
+    }
+}
+`
+
+// BandwidthLimitRuleAliasUpdateResult represents a raw result of an Update call
+// to a BandwidthLimitRule through the qos-rules-alias extension.
+const BandwidthLimitRuleAliasUpdateResult = `
+{
+    "alias_bandwidth_limit_rule": {
+        "max_kbps": 500,
+        "id": "30a57f4a-336b-4382-8275-d708babd2241",
+        "max_burst_kbps": 0
+    }
+}
+`
+
+// DSCPMarkingRuleAliasGetResult represents a raw result of a Get call to a
+// DSCPMarkingRule through the qos-rules-alias extension.
+const DSCPMarkingRuleAliasGetResult = `
+{
+    "alias_dscp_marking_rule": {
+        "id": "30a57f4a-336b-4382-8275-d708babd2241",
+        "dscp_mark": 20
+    }
+}
+`
+
+// DSCPMarkingRuleAliasUpdateRequest represents a raw body of an Update call to
+// a DSCPMarkingRule through the qos-rules-alias extension.
+const DSCPMarkingRuleAliasUpdateRequest = `
+{
+    "alias_dscp_marking_rule": {
+        "dscp_mark": 26
+    }
+}
+`
+
+// DSCPMarkingRuleAliasUpdateResult represents a raw result of an Update call to
+// a DSCPMarkingRule through the qos-rules-alias extension.
+const DSCPMarkingRuleAliasUpdateResult = `
+{
+    "alias_dscp_marking_rule": {
+        "id": "30a57f4a-336b-4382-8275-d708babd2241",
+        "dscp_mark": 26
+    }
+}
+`
+
+// MinimumBandwidthRuleAliasGetResult represents a raw result of a Get call to a
+// MinimumBandwidthRule through the qos-rules-alias extension.
+const MinimumBandwidthRuleAliasGetResult = `
+{
+    "alias_minimum_bandwidth_rule": {
+        "min_kbps": 3000,
+        "direction": "egress",
+        "id": "30a57f4a-336b-4382-8275-d708babd2241"
+    }
+}
+`
+
+// MinimumBandwidthRuleAliasUpdateRequest represents a raw body of an Update
+// call to a MinimumBandwidthRule through the qos-rules-alias extension.
+const MinimumBandwidthRuleAliasUpdateRequest = `
+{
+    "alias_minimum_bandwidth_rule": {
+        "min_kbps": 500
+    }
+}
+`
+
+// MinimumBandwidthRuleAliasUpdateResult represents a raw result of an Update
+// call to a MinimumBandwidthRule through the qos-rules-alias extension.
+const MinimumBandwidthRuleAliasUpdateResult = `
+{
+    "alias_minimum_bandwidth_rule": {
+        "min_kbps": 500,
+        "id": "30a57f4a-336b-4382-8275-d708babd2241"
+    }
+}
+`
+
+// MinimumPacketRateRuleAliasGetResult represents a raw result of a Get call to a
+// MinimumPacketRateRule through the qos-rules-alias extension.
+const MinimumPacketRateRuleAliasGetResult = `
+{
+    "alias_minimum_packet_rate_rule": {
+        "min_kpps": 1000,
+        "direction": "any",
+        "id": "46d6e4d3-1f3a-4f5d-a9e1-3a6cb40e4b8c"
+    }
+}
+`
+
+// MinimumPacketRateRuleAliasUpdateRequest represents a raw body of an Update
+// call to a MinimumPacketRateRule through the qos-rules-alias extension.
+const MinimumPacketRateRuleAliasUpdateRequest = `
+{
+    "alias_minimum_packet_rate_rule": {
+        "min_kpps": 500
+    }
+}
+`
+
+// MinimumPacketRateRuleAliasUpdateResult represents a raw result of an Update
+// call to a MinimumPacketRateRule through the qos-rules-alias extension.
+const MinimumPacketRateRuleAliasUpdateResult = `
+{
+    "alias_minimum_packet_rate_rule": {
+        "min_kpps": 500,
+        "direction": "any",
+        "id": "46d6e4d3-1f3a-4f5d-a9e1-3a6cb40e4b8c"
+    }
+}
+`
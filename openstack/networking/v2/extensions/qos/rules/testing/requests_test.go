@@ -426,3 +426,444 @@ func TestDeleteMinimumBandwidthRule(t *testing.T) {
 	res := rules.DeleteMinimumBandwidthRule(context.TODO(), fake.ServiceClient(fakeServer), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestListPacketRateLimitRule(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/packet_rate_limit_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"direction": "egress"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, PacketRateLimitRulesListResult)
+	})
+
+	count := 0
+
+	err := rules.ListPacketRateLimitRules(
+		fake.ServiceClient(fakeServer),
+		"501005fa-3b56-4061-aaca-3f24995112e1",
+		rules.PacketRateLimitRulesListOpts{Direction: rules.DirectionEgress},
+	).EachPage(context.TODO(), func(_ context.Context, page pagination.Page) (bool, error) {
+		count++
+		actual, err := rules.ExtractPacketRateLimitRules(page)
+		if err != nil {
+			t.Errorf("Failed to extract packet rate limit rules: %v", err)
+			return false, nil
+		}
+
+		expected := []rules.PacketRateLimitRule{
+			{
+				ID:           "30a57f4a-336b-4382-8275-d708babd2241",
+				Direction:    "egress",
+				MaxKPps:      3000,
+				MaxBurstKPps: 300,
+			},
+		}
+
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGetPacketRateLimitRule(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/packet_rate_limit_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, PacketRateLimitRulesGetResult)
+	})
+
+	r, err := rules.GetPacketRateLimitRule(context.TODO(), fake.ServiceClient(fakeServer), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241").ExtractPacketRateLimitRule()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, r.ID, "30a57f4a-336b-4382-8275-d708babd2241")
+	th.AssertEquals(t, r.Direction, "egress")
+	th.AssertEquals(t, r.MaxKPps, 3000)
+	th.AssertEquals(t, r.MaxBurstKPps, 300)
+}
+
+func TestCreatePacketRateLimitRule(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/packet_rate_limit_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, PacketRateLimitRulesCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, PacketRateLimitRulesCreateResult)
+	})
+
+	opts := rules.CreatePacketRateLimitRuleOpts{
+		MaxKPps:      2000,
+		MaxBurstKPps: 200,
+		Direction:    rules.DirectionIngress,
+	}
+	r, err := rules.CreatePacketRateLimitRule(context.TODO(), fake.ServiceClient(fakeServer), "501005fa-3b56-4061-aaca-3f24995112e1", opts).ExtractPacketRateLimitRule()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2000, r.MaxKPps)
+	th.AssertEquals(t, 200, r.MaxBurstKPps)
+	th.AssertEquals(t, "ingress", r.Direction)
+}
+
+func TestUpdatePacketRateLimitRule(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/packet_rate_limit_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, PacketRateLimitRulesUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, PacketRateLimitRulesUpdateResult)
+	})
+
+	maxKPps := 500
+	maxBurstKPps := 0
+	opts := rules.UpdatePacketRateLimitRuleOpts{
+		MaxKPps:      &maxKPps,
+		MaxBurstKPps: &maxBurstKPps,
+	}
+	r, err := rules.UpdatePacketRateLimitRule(context.TODO(), fake.ServiceClient(fakeServer), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241", opts).ExtractPacketRateLimitRule()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 500, r.MaxKPps)
+	th.AssertEquals(t, 0, r.MaxBurstKPps)
+}
+
+func TestDeletePacketRateLimitRule(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/packet_rate_limit_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := rules.DeletePacketRateLimitRule(context.TODO(), fake.ServiceClient(fakeServer), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestListMinimumPacketRateRule(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_packet_rate_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, MinimumPacketRateRulesListResult)
+	})
+
+	allPages, err := rules.ListMinimumPacketRateRules(fake.ServiceClient(fakeServer), "501005fa-3b56-4061-aaca-3f24995112e1", nil).AllPages(context.TODO())
+	th.AssertNoErr(t, err)
+
+	actual, err := rules.ExtractMinimumPacketRateRules(allPages)
+	th.AssertNoErr(t, err)
+
+	expected := []rules.MinimumPacketRateRule{
+		{
+			ID:        "46d6e4d3-1f3a-4f5d-a9e1-3a6cb40e4b8c",
+			Direction: "any",
+			MinKPps:   1000,
+		},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestGetMinimumPacketRateRule(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_packet_rate_rules/46d6e4d3-1f3a-4f5d-a9e1-3a6cb40e4b8c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, MinimumPacketRateRulesGetResult)
+	})
+
+	r, err := rules.GetMinimumPacketRateRule(context.TODO(), fake.ServiceClient(fakeServer), "501005fa-3b56-4061-aaca-3f24995112e1", "46d6e4d3-1f3a-4f5d-a9e1-3a6cb40e4b8c").ExtractMinimumPacketRateRule()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, r.ID, "46d6e4d3-1f3a-4f5d-a9e1-3a6cb40e4b8c")
+	th.AssertEquals(t, r.Direction, "any")
+	th.AssertEquals(t, r.MinKPps, 1000)
+}
+
+func TestCreateMinimumPacketRateRule(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_packet_rate_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, MinimumPacketRateRulesCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, MinimumPacketRateRulesCreateResult)
+	})
+
+	opts := rules.CreateMinimumPacketRateRuleOpts{
+		MinKPps:   1000,
+		Direction: rules.DirectionAny,
+	}
+	r, err := rules.CreateMinimumPacketRateRule(context.TODO(), fake.ServiceClient(fakeServer), "501005fa-3b56-4061-aaca-3f24995112e1", opts).ExtractMinimumPacketRateRule()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1000, r.MinKPps)
+	th.AssertEquals(t, "any", r.Direction)
+}
+
+func TestUpdateMinimumPacketRateRule(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_packet_rate_rules/46d6e4d3-1f3a-4f5d-a9e1-3a6cb40e4b8c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, MinimumPacketRateRulesUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, MinimumPacketRateRulesUpdateResult)
+	})
+
+	minKPps := 500
+	opts := rules.UpdateMinimumPacketRateRuleOpts{
+		MinKPps: &minKPps,
+	}
+	r, err := rules.UpdateMinimumPacketRateRule(context.TODO(), fake.ServiceClient(fakeServer), "501005fa-3b56-4061-aaca-3f24995112e1", "46d6e4d3-1f3a-4f5d-a9e1-3a6cb40e4b8c", opts).ExtractMinimumPacketRateRule()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 500, r.MinKPps)
+}
+
+func TestDeleteMinimumPacketRateRule(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_packet_rate_rules/46d6e4d3-1f3a-4f5d-a9e1-3a6cb40e4b8c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := rules.DeleteMinimumPacketRateRule(context.TODO(), fake.ServiceClient(fakeServer), "501005fa-3b56-4061-aaca-3f24995112e1", "46d6e4d3-1f3a-4f5d-a9e1-3a6cb40e4b8c")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestBandwidthLimitRuleAlias(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/qos/alias_bandwidth_limit_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, BandwidthLimitRuleAliasGetResult)
+		case "PUT":
+			th.TestJSONRequest(t, r, BandwidthLimitRuleAliasUpdateRequest)
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, BandwidthLimitRuleAliasUpdateResult)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	client := fake.ServiceClient(fakeServer)
+	ruleID := "30a57f4a-336b-4382-8275-d708babd2241"
+
+	r, err := rules.GetBandwidthLimitRuleAlias(context.TODO(), client, ruleID).ExtractBandwidthLimitRule()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3000, r.MaxKBps)
+
+	maxKBps := 500
+	maxBurstKBps := 0
+	opts := rules.UpdateBandwidthLimitRuleOpts{
+		MaxKBps:      &maxKBps,
+		MaxBurstKBps: &maxBurstKBps,
+	}
+	r, err = rules.UpdateBandwidthLimitRuleAlias(context.TODO(), client, ruleID, opts).ExtractBandwidthLimitRule()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 500, r.MaxKBps)
+
+	err = rules.DeleteBandwidthLimitRuleAlias(context.TODO(), client, ruleID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestMinimumPacketRateRuleAlias(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/qos/alias_minimum_packet_rate_rules/46d6e4d3-1f3a-4f5d-a9e1-3a6cb40e4b8c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, MinimumPacketRateRuleAliasGetResult)
+		case "PUT":
+			th.TestJSONRequest(t, r, MinimumPacketRateRuleAliasUpdateRequest)
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, MinimumPacketRateRuleAliasUpdateResult)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	client := fake.ServiceClient(fakeServer)
+	ruleID := "46d6e4d3-1f3a-4f5d-a9e1-3a6cb40e4b8c"
+
+	r, err := rules.GetMinimumPacketRateRuleAlias(context.TODO(), client, ruleID).ExtractMinimumPacketRateRule()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1000, r.MinKPps)
+
+	minKPps := 500
+	opts := rules.UpdateMinimumPacketRateRuleOpts{
+		MinKPps: &minKPps,
+	}
+	r, err = rules.UpdateMinimumPacketRateRuleAlias(context.TODO(), client, ruleID, opts).ExtractMinimumPacketRateRule()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 500, r.MinKPps)
+
+	err = rules.DeleteMinimumPacketRateRuleAlias(context.TODO(), client, ruleID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDSCPMarkingRuleAlias(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/qos/alias_dscp_marking_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, DSCPMarkingRuleAliasGetResult)
+		case "PUT":
+			th.TestJSONRequest(t, r, DSCPMarkingRuleAliasUpdateRequest)
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, DSCPMarkingRuleAliasUpdateResult)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	client := fake.ServiceClient(fakeServer)
+	ruleID := "30a57f4a-336b-4382-8275-d708babd2241"
+
+	r, err := rules.GetDSCPMarkingRuleAlias(context.TODO(), client, ruleID).ExtractDSCPMarkingRule()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 20, r.DSCPMark)
+
+	dscpMark := 26
+	opts := rules.UpdateDSCPMarkingRuleOpts{
+		DSCPMark: &dscpMark,
+	}
+	r, err = rules.UpdateDSCPMarkingRuleAlias(context.TODO(), client, ruleID, opts).ExtractDSCPMarkingRule()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 26, r.DSCPMark)
+
+	err = rules.DeleteDSCPMarkingRuleAlias(context.TODO(), client, ruleID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestMinimumBandwidthRuleAlias(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/qos/alias_minimum_bandwidth_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, MinimumBandwidthRuleAliasGetResult)
+		case "PUT":
+			th.TestJSONRequest(t, r, MinimumBandwidthRuleAliasUpdateRequest)
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, MinimumBandwidthRuleAliasUpdateResult)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	client := fake.ServiceClient(fakeServer)
+	ruleID := "30a57f4a-336b-4382-8275-d708babd2241"
+
+	r, err := rules.GetMinimumBandwidthRuleAlias(context.TODO(), client, ruleID).ExtractMinimumBandwidthRule()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3000, r.MinKBps)
+
+	minKBps := 500
+	opts := rules.UpdateMinimumBandwidthRuleOpts{
+		MinKBps: &minKBps,
+	}
+	r, err = rules.UpdateMinimumBandwidthRuleAlias(context.TODO(), client, ruleID, opts).ExtractMinimumBandwidthRule()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 500, r.MinKBps)
+
+	err = rules.DeleteMinimumBandwidthRuleAlias(context.TODO(), client, ruleID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -46,5 +46,29 @@ Example to Delete a Security Group Rule
 	if err != nil {
 		panic(err)
 	}
+
+Example to Sync the Rules of a Security Group
+
+	desired := []rules.CreateOpts{
+		{
+			Direction:    rules.DirIngress,
+			EtherType:    rules.EtherType4,
+			Protocol:     rules.ProtocolTCP,
+			PortRangeMin: 443,
+			PortRangeMax: 443,
+		},
+		{
+			Direction: rules.DirEgress,
+			EtherType: rules.EtherType4,
+		},
+	}
+
+	groupID := "a7734e61-b545-452d-a3cd-0189cbd9747a"
+	result, err := rules.Sync(context.TODO(), networkClient, groupID, desired)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("created %d rules, deleted %d rules\n", len(result.Created), len(result.Deleted))
 */
 package rules
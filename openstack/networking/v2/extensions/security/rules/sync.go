@@ -0,0 +1,235 @@
+package rules
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// RuleDiff is the difference between the desired and the current rules of a
+// security group, as computed by Diff.
+type RuleDiff struct {
+	// Create are the desired rules missing from the group.
+	Create []CreateOpts
+
+	// Delete are the current rules that are not desired.
+	Delete []SecGroupRule
+
+	// Unchanged are the current rules that are desired.
+	Unchanged []SecGroupRule
+}
+
+// Empty reports whether the group already has the desired rules.
+func (d RuleDiff) Empty() bool {
+	return len(d.Create) == 0 && len(d.Delete) == 0
+}
+
+// SyncResult is the outcome of a Sync call.
+type SyncResult struct {
+	// Created are the rules that were created.
+	Created []SecGroupRule
+
+	// Deleted are the rules that were deleted.
+	Deleted []SecGroupRule
+
+	// Unchanged are the rules that were kept.
+	Unchanged []SecGroupRule
+}
+
+// ruleKey holds the attributes identifying a rule, once normalized.
+type ruleKey struct {
+	direction            string
+	etherType            string
+	protocol             string
+	portRangeMin         int
+	portRangeMax         int
+	remoteIPPrefix       string
+	remoteGroupID        string
+	remoteAddressGroupID string
+}
+
+// protocolNames maps the protocol numbers accepted by Neutron, and the
+// legacy "icmpv6" and "any" names, to the names Neutron uses.
+var protocolNames = map[string]string{
+	"any":    "",
+	"icmpv6": string(ProtocolIPv6ICMP),
+	"1":      string(ProtocolICMP),
+	"2":      string(ProtocolIGMP),
+	"4":      string(ProtocolIPIP),
+	"6":      string(ProtocolTCP),
+	"8":      string(ProtocolEGP),
+	"17":     string(ProtocolUDP),
+	"33":     string(ProtocolDCCP),
+	"41":     string(ProtocolIPv6Encap),
+	"43":     string(ProtocolIPv6Route),
+	"44":     string(ProtocolIPv6Frag),
+	"46":     string(ProtocolRSVP),
+	"47":     string(ProtocolGRE),
+	"50":     string(ProtocolESP),
+	"51":     string(ProtocolAH),
+	"58":     string(ProtocolIPv6ICMP),
+	"59":     string(ProtocolIPv6NoNxt),
+	"60":     string(ProtocolIPv6Opts),
+	"89":     string(ProtocolOSPF),
+	"112":    string(ProtocolVRRP),
+	"113":    string(ProtocolPGM),
+	"132":    string(ProtocolSCTP),
+	"136":    string(ProtocolUDPLite),
+}
+
+func normalizeRule(direction, etherType, protocol string, portRangeMin, portRangeMax int, remoteIPPrefix, remoteGroupID, remoteAddressGroupID string) ruleKey {
+	protocol = strings.ToLower(protocol)
+	if name, ok := protocolNames[protocol]; ok {
+		protocol = name
+	}
+	if protocol == string(ProtocolICMP) && etherType == string(EtherType6) {
+		protocol = string(ProtocolIPv6ICMP)
+	}
+
+	switch RuleProtocol(protocol) {
+	case ProtocolTCP, ProtocolUDP, ProtocolSCTP, ProtocolDCCP, ProtocolUDPLite:
+		// The full port range is the same as no port range.
+		if portRangeMin <= 1 && portRangeMax == 65535 {
+			portRangeMin, portRangeMax = 0, 0
+		}
+	case ProtocolICMP, ProtocolIPv6ICMP:
+		// Port ranges hold the ICMP type and code.
+	default:
+		// Other protocols have no ports.
+		portRangeMin, portRangeMax = 0, 0
+	}
+
+	// Remote prefixes are compared as masked CIDRs, and the prefixes
+	// matching every address are the same as no prefix.
+	if prefix, err := netip.ParsePrefix(remoteIPPrefix); err == nil {
+		prefix = prefix.Masked()
+		remoteIPPrefix = prefix.String()
+		if prefix.Bits() == 0 {
+			remoteIPPrefix = ""
+		}
+	} else if addr, err := netip.ParseAddr(remoteIPPrefix); err == nil {
+		remoteIPPrefix = netip.PrefixFrom(addr, addr.BitLen()).String()
+	}
+
+	return ruleKey{
+		direction:            direction,
+		etherType:            etherType,
+		protocol:             protocol,
+		portRangeMin:         portRangeMin,
+		portRangeMax:         portRangeMax,
+		remoteIPPrefix:       remoteIPPrefix,
+		remoteGroupID:        remoteGroupID,
+		remoteAddressGroupID: remoteAddressGroupID,
+	}
+}
+
+func (opts CreateOpts) key() ruleKey {
+	return normalizeRule(string(opts.Direction), string(opts.EtherType), string(opts.Protocol), opts.PortRangeMin, opts.PortRangeMax, opts.RemoteIPPrefix, opts.RemoteGroupID, opts.RemoteAddressGroupID)
+}
+
+// Normalize returns a copy of opts in which the attributes Neutron accepts
+// in several spellings are spelled the way Diff compares them: protocol
+// numbers are replaced by their names, the full port range of a protocol and
+// the remote prefixes matching every address are cleared, and other remote
+// prefixes are masked.
+func (opts CreateOpts) Normalize() CreateOpts {
+	k := opts.key()
+	opts.Protocol = RuleProtocol(k.protocol)
+	opts.PortRangeMin = k.portRangeMin
+	opts.PortRangeMax = k.portRangeMax
+	opts.RemoteIPPrefix = k.remoteIPPrefix
+	return opts
+}
+
+func (r SecGroupRule) key() ruleKey {
+	return normalizeRule(r.Direction, r.EtherType, r.Protocol, r.PortRangeMin, r.PortRangeMax, r.RemoteIPPrefix, r.RemoteGroupID, r.RemoteAddressGroupID)
+}
+
+// Diff computes the rules to create and to delete for the current rules of
+// security group groupID to match the desired rules.
+//
+// Rules are compared once normalized: protocol numbers match their names,
+// remote prefixes matching every address such as "0.0.0.0/0" or "::/0"
+// match an empty prefix, and the full port range of a protocol matches an
+// empty range. Descriptions are not compared. Duplicate desired rules are
+// created once, and duplicate current rules are deleted.
+//
+// The SecGroupID of the desired rules to create is set to groupID.
+func Diff(groupID string, desired []CreateOpts, current []SecGroupRule) RuleDiff {
+	var diff RuleDiff
+
+	wanted := make(map[ruleKey]bool, len(desired))
+	for _, opts := range desired {
+		key := opts.key()
+		if _, ok := wanted[key]; ok {
+			continue
+		}
+		wanted[key] = false
+
+		found := false
+		for _, rule := range current {
+			if rule.key() == key {
+				found = true
+				break
+			}
+		}
+		if !found {
+			opts.SecGroupID = groupID
+			diff.Create = append(diff.Create, opts)
+		}
+	}
+
+	for _, rule := range current {
+		key := rule.key()
+		kept, ok := wanted[key]
+		if !ok || kept {
+			diff.Delete = append(diff.Delete, rule)
+			continue
+		}
+		wanted[key] = true
+		diff.Unchanged = append(diff.Unchanged, rule)
+	}
+
+	return diff
+}
+
+// Sync lists the rules of security group groupID and makes them match the
+// desired rules, as computed by Diff. Missing rules are created with
+// CreateBulk before the rules that are not desired are deleted, so that
+// allowed traffic is not interrupted.
+//
+// On error, the returned SyncResult holds the changes made so far.
+func Sync(ctx context.Context, c *gophercloud.ServiceClient, groupID string, desired []CreateOpts) (*SyncResult, error) {
+	allPages, err := List(c, ListOpts{SecGroupID: groupID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := ExtractRules(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := Diff(groupID, desired, current)
+	result := &SyncResult{Unchanged: diff.Unchanged}
+
+	if len(diff.Create) > 0 {
+		created, err := CreateBulk(ctx, c, diff.Create).Extract()
+		if err != nil {
+			return result, err
+		}
+		result.Created = created
+	}
+
+	for _, rule := range diff.Delete {
+		err := Delete(ctx, c, rule.ID).ExtractErr()
+		if err != nil && !gophercloud.ResponseCodeIs(err, 404) {
+			return result, err
+		}
+		result.Deleted = append(result.Deleted, rule)
+	}
+
+	return result, nil
+}
@@ -420,3 +420,138 @@ func TestDelete(t *testing.T) {
 	res := rules.Delete(context.TODO(), fake.ServiceClient(fakeServer), "4ec89087-d057-4e2c-911f-60a3b47ee304")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestDiff(t *testing.T) {
+	groupID := "a7734e61-b545-452d-a3cd-0189cbd9747a"
+	current := []rules.SecGroupRule{
+		{ID: "ssh", Direction: "ingress", EtherType: "IPv4", Protocol: "6", PortRangeMin: 22, PortRangeMax: 22, RemoteIPPrefix: "10.0.0.1/8"},
+		{ID: "egress4", Direction: "egress", EtherType: "IPv4", RemoteIPPrefix: "0.0.0.0/0"},
+		{ID: "egress4-dup", Direction: "egress", EtherType: "IPv4"},
+		{ID: "udp-all", Direction: "ingress", EtherType: "IPv6", Protocol: "udp", PortRangeMin: 1, PortRangeMax: 65535, RemoteIPPrefix: "::/0"},
+		{ID: "ping", Direction: "ingress", EtherType: "IPv4", Protocol: "icmp", PortRangeMin: 8, PortRangeMax: 0},
+	}
+	desired := []rules.CreateOpts{
+		{Direction: rules.DirIngress, EtherType: rules.EtherType4, Protocol: rules.ProtocolTCP, PortRangeMin: 22, PortRangeMax: 22, RemoteIPPrefix: "10.0.0.0/8"},
+		{Direction: rules.DirEgress, EtherType: rules.EtherType4, Protocol: "any"},
+		{Direction: rules.DirIngress, EtherType: rules.EtherType6, Protocol: "17"},
+		{Direction: rules.DirIngress, EtherType: rules.EtherType6, Protocol: "icmp"},
+		{Direction: rules.DirIngress, EtherType: rules.EtherType6, Protocol: "58"},
+	}
+
+	diff := rules.Diff(groupID, desired, current)
+	th.CheckEquals(t, false, diff.Empty())
+	th.CheckDeepEquals(t, []rules.CreateOpts{
+		{Direction: rules.DirIngress, EtherType: rules.EtherType6, Protocol: "icmp", SecGroupID: groupID},
+	}, diff.Create)
+
+	var deleted, unchanged []string
+	for _, rule := range diff.Delete {
+		deleted = append(deleted, rule.ID)
+	}
+	for _, rule := range diff.Unchanged {
+		unchanged = append(unchanged, rule.ID)
+	}
+	th.CheckDeepEquals(t, []string{"egress4-dup", "ping"}, deleted)
+	th.CheckDeepEquals(t, []string{"ssh", "egress4", "udp-all"}, unchanged)
+
+	th.CheckEquals(t, true, rules.Diff(groupID, nil, nil).Empty())
+}
+
+func TestNormalize(t *testing.T) {
+	opts := rules.CreateOpts{Direction: rules.DirIngress, EtherType: rules.EtherType4, Protocol: "6", PortRangeMin: 1, PortRangeMax: 65535, RemoteIPPrefix: "0.0.0.0/0"}
+	th.CheckDeepEquals(t, rules.CreateOpts{Direction: rules.DirIngress, EtherType: rules.EtherType4, Protocol: rules.ProtocolTCP}, opts.Normalize())
+
+	opts = rules.CreateOpts{Direction: rules.DirEgress, EtherType: rules.EtherType6, Protocol: "icmp", PortRangeMin: 128, RemoteIPPrefix: "2001:db8::1/32"}
+	th.CheckDeepEquals(t, rules.CreateOpts{Direction: rules.DirEgress, EtherType: rules.EtherType6, Protocol: rules.ProtocolIPv6ICMP, PortRangeMin: 128, RemoteIPPrefix: "2001:db8::/32"}, opts.Normalize())
+}
+
+func TestSync(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/security-group-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"})
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `
+{
+    "security_group_rules": [
+        {
+            "id": "3c0e45ff-adaf-4124-b083-bf390e5482ff",
+            "direction": "egress",
+            "ethertype": "IPv4",
+            "protocol": null,
+            "remote_ip_prefix": null,
+            "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"
+        },
+        {
+            "id": "93aa42e5-80db-4581-9391-3a608bd0e448",
+            "direction": "egress",
+            "ethertype": "IPv6",
+            "protocol": null,
+            "remote_ip_prefix": null,
+            "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"
+        }
+    ]
+}
+      `)
+		case "POST":
+			th.TestJSONRequest(t, r, `
+{
+    "security_group_rules": [
+        {
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "port_range_min": 443,
+            "port_range_max": 443,
+            "protocol": "tcp",
+            "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"
+        }
+    ]
+}
+      `)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `
+{
+    "security_group_rules": [
+        {
+            "id": "f1d2b0f4-7f0e-4d4b-9e4e-1e0d1f5f1d2b",
+            "direction": "ingress",
+            "ethertype": "IPv4",
+            "port_range_min": 443,
+            "port_range_max": 443,
+            "protocol": "tcp",
+            "security_group_id": "a7734e61-b545-452d-a3cd-0189cbd9747a"
+        }
+    ]
+}
+      `)
+		default:
+			t.Errorf("Unexpected %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	fakeServer.Mux.HandleFunc("/v2.0/security-group-rules/93aa42e5-80db-4581-9391-3a608bd0e448", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	desired := []rules.CreateOpts{
+		{Direction: rules.DirIngress, EtherType: rules.EtherType4, Protocol: rules.ProtocolTCP, PortRangeMin: 443, PortRangeMax: 443},
+		{Direction: rules.DirEgress, EtherType: rules.EtherType4},
+	}
+
+	result, err := rules.Sync(context.TODO(), fake.ServiceClient(fakeServer), "a7734e61-b545-452d-a3cd-0189cbd9747a", desired)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(result.Created))
+	th.CheckEquals(t, "f1d2b0f4-7f0e-4d4b-9e4e-1e0d1f5f1d2b", result.Created[0].ID)
+	th.AssertEquals(t, 1, len(result.Deleted))
+	th.CheckEquals(t, "93aa42e5-80db-4581-9391-3a608bd0e448", result.Deleted[0].ID)
+	th.AssertEquals(t, 1, len(result.Unchanged))
+	th.CheckEquals(t, "3c0e45ff-adaf-4124-b083-bf390e5482ff", result.Unchanged[0].ID)
+}
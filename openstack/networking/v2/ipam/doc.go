@@ -0,0 +1,49 @@
+/*
+Package ipam computes the address usage of Networking subnets and finds free
+addresses in them.
+
+Unlike the networkipavailabilities extension, which reports aggregate
+numbers, GetUsage lists the addresses in use from the fixed IPs of the ports
+of a subnet and the free ranges left in its allocation pools. It works with
+IPv4 and IPv6 subnets. NextFree returns the lowest free addresses, so that
+fixed IPs can be pre-assigned deterministically instead of letting Neutron
+pick them.
+
+Example to Get the Usage of a Subnet
+
+	usage, err := ipam.GetUsage(context.TODO(), networkClient, "08eae331-0402-425a-923c-34f7cfe39c1b")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%d of %d addresses free in %d ranges (fragmentation %.2f)\n",
+		usage.FreeCount(), usage.Total(), len(usage.Free), usage.Fragmentation())
+
+Example to Pre-assign Fixed IPs
+
+	reserved := ipam.Range{
+		Start: netip.MustParseAddr("10.0.0.2"),
+		End:   netip.MustParseAddr("10.0.0.9"),
+	}
+
+	addrs, err := usage.NextFree(3, reserved)
+	if err != nil {
+		panic(err)
+	}
+
+	for i, addr := range addrs {
+		createOpts := ports.CreateOpts{
+			Name:      fmt.Sprintf("node-%d", i),
+			NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+			FixedIPs: []ports.IP{
+				{SubnetID: usage.SubnetID, IPAddress: addr.String()},
+			},
+		}
+
+		_, err := ports.Create(context.TODO(), networkClient, createOpts).Extract()
+		if err != nil {
+			panic(err)
+		}
+	}
+*/
+package ipam
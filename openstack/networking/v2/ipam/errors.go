@@ -0,0 +1,20 @@
+package ipam
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// ErrNotEnoughFreeIPs is the error when a subnet has fewer free addresses
+// than requested.
+type ErrNotEnoughFreeIPs struct {
+	gophercloud.BaseError
+	SubnetID  string
+	Requested int
+	Available int
+}
+
+func (e ErrNotEnoughFreeIPs) Error() string {
+	return fmt.Sprintf("subnet %s has %d free addresses, %d requested", e.SubnetID, e.Available, e.Requested)
+}
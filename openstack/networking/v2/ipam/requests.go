@@ -0,0 +1,117 @@
+package ipam
+
+import (
+	"context"
+	"net/netip"
+	"slices"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+)
+
+// GetUsage retrieves a subnet and the ports having an address in it, and
+// returns its address usage.
+func GetUsage(ctx context.Context, client *gophercloud.ServiceClient, subnetID string) (*Usage, error) {
+	subnet, err := subnets.Get(ctx, client, subnetID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	listOpts := ports.ListOpts{
+		FixedIPs: []ports.FixedIPOpts{{SubnetID: subnetID}},
+	}
+	allPages, err := ports.List(client, listOpts).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	return ComputeUsage(*subnet, allPorts)
+}
+
+// ComputeUsage returns the address usage of a subnet given the ports having
+// an address in it. Ports without an address in the subnet are ignored.
+//
+// Subnets without allocation pools are considered to allocate their whole
+// CIDR, except for the subnet address and, for IPv4, the broadcast address.
+func ComputeUsage(subnet subnets.Subnet, allPorts []ports.Port) (*Usage, error) {
+	cidr, err := netip.ParsePrefix(subnet.CIDR)
+	if err != nil {
+		return nil, err
+	}
+	cidr = cidr.Masked()
+
+	usage := &Usage{
+		SubnetID: subnet.ID,
+		CIDR:     cidr,
+	}
+
+	for _, pool := range subnet.AllocationPools {
+		start, err := netip.ParseAddr(pool.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := netip.ParseAddr(pool.End)
+		if err != nil {
+			return nil, err
+		}
+		usage.Pools = append(usage.Pools, Range{Start: start, End: end})
+	}
+	if len(usage.Pools) == 0 {
+		usage.Pools = defaultPool(cidr)
+	}
+	slices.SortFunc(usage.Pools, func(a, b Range) int { return a.Start.Compare(b.Start) })
+
+	if subnet.GatewayIP != "" {
+		gateway, err := netip.ParseAddr(subnet.GatewayIP)
+		if err != nil {
+			return nil, err
+		}
+		usage.Used = append(usage.Used, gateway)
+	}
+	for _, port := range allPorts {
+		for _, ip := range port.FixedIPs {
+			if ip.SubnetID != subnet.ID {
+				continue
+			}
+			addr, err := netip.ParseAddr(ip.IPAddress)
+			if err != nil {
+				return nil, err
+			}
+			usage.Used = append(usage.Used, addr)
+		}
+	}
+	slices.SortFunc(usage.Used, netip.Addr.Compare)
+	usage.Used = slices.Compact(usage.Used)
+
+	used := make([]Range, len(usage.Used))
+	for i, addr := range usage.Used {
+		used[i] = Range{Start: addr, End: addr}
+	}
+	usage.Free = subtract(usage.Pools, used)
+
+	return usage, nil
+}
+
+// defaultPool returns the addresses of a CIDR that can be assigned to ports.
+func defaultPool(cidr netip.Prefix) []Range {
+	b := cidr.Addr().AsSlice()
+	for i := cidr.Bits(); i < len(b)*8; i++ {
+		b[i/8] |= 0x80 >> (i % 8)
+	}
+	last, _ := netip.AddrFromSlice(b)
+
+	first := cidr.Addr().Next()
+	if cidr.Addr().Is4() {
+		last = last.Prev()
+	}
+	if !first.IsValid() || !last.IsValid() || last.Less(first) {
+		return nil
+	}
+	return []Range{{Start: first, End: last}}
+}
@@ -0,0 +1,154 @@
+package ipam
+
+import (
+	"encoding/binary"
+	"math"
+	"net/netip"
+	"slices"
+)
+
+// Range is an inclusive range of IP addresses.
+type Range struct {
+	Start netip.Addr
+	End   netip.Addr
+}
+
+// Contains reports whether addr is part of the range.
+func (r Range) Contains(addr netip.Addr) bool {
+	return r.Start.Compare(addr) <= 0 && addr.Compare(r.End) <= 0
+}
+
+// Size returns the number of addresses of the range. It is capped at
+// math.MaxUint64, which only large IPv6 ranges reach.
+func (r Range) Size() uint64 {
+	start, end := r.Start.As16(), r.End.As16()
+	startHi, startLo := binary.BigEndian.Uint64(start[:8]), binary.BigEndian.Uint64(start[8:])
+	endHi, endLo := binary.BigEndian.Uint64(end[:8]), binary.BigEndian.Uint64(end[8:])
+
+	hi := endHi - startHi
+	if endLo < startLo {
+		hi--
+	}
+	lo := endLo - startLo
+	if hi != 0 || lo == math.MaxUint64 {
+		return math.MaxUint64
+	}
+	return lo + 1
+}
+
+func (r Range) String() string {
+	if r.Start == r.End {
+		return r.Start.String()
+	}
+	return r.Start.String() + "-" + r.End.String()
+}
+
+// Usage is the address usage of a subnet.
+type Usage struct {
+	// SubnetID is the ID of the subnet.
+	SubnetID string
+
+	// CIDR is the address range of the subnet.
+	CIDR netip.Prefix
+
+	// Pools are the allocation pools of the subnet, sorted.
+	Pools []Range
+
+	// Used are the addresses of the subnet assigned to ports, and its
+	// gateway, sorted. They may lie outside of the allocation pools.
+	Used []netip.Addr
+
+	// Free are the ranges of the allocation pools not assigned to any port,
+	// sorted.
+	Free []Range
+}
+
+// Total returns the number of addresses of the allocation pools.
+func (u Usage) Total() uint64 {
+	return sumSizes(u.Pools)
+}
+
+// FreeCount returns the number of free addresses.
+func (u Usage) FreeCount() uint64 {
+	return sumSizes(u.Free)
+}
+
+// Fragmentation measures how scattered the free addresses are, from 0 when
+// they form a single range to close to 1 when they are isolated addresses.
+// It is the share of free addresses outside of the largest free range.
+func (u Usage) Fragmentation() float64 {
+	free := u.FreeCount()
+	if free == 0 {
+		return 0
+	}
+
+	var largest uint64
+	for _, r := range u.Free {
+		largest = max(largest, r.Size())
+	}
+	return 1 - float64(largest)/float64(free)
+}
+
+// NextFree returns the lowest count free addresses of the subnet, skipping
+// the reserved ranges. The same addresses are returned until they are
+// assigned, so that callers can pre-assign fixed IPs deterministically.
+func (u Usage) NextFree(count int, reserved ...Range) ([]netip.Addr, error) {
+	var addrs []netip.Addr
+	for _, r := range subtract(u.Free, reserved) {
+		for addr := r.Start; len(addrs) < count; addr = addr.Next() {
+			addrs = append(addrs, addr)
+			if addr == r.End {
+				break
+			}
+		}
+		if len(addrs) == count {
+			return addrs, nil
+		}
+	}
+
+	return nil, ErrNotEnoughFreeIPs{SubnetID: u.SubnetID, Requested: count, Available: len(addrs)}
+}
+
+func sumSizes(ranges []Range) uint64 {
+	var total uint64
+	for _, r := range ranges {
+		size := r.Size()
+		if total > math.MaxUint64-size {
+			return math.MaxUint64
+		}
+		total += size
+	}
+	return total
+}
+
+// subtract returns the parts of the sorted, disjoint ranges that are not
+// part of any of the removed ranges.
+func subtract(ranges []Range, remove []Range) []Range {
+	remove = slices.Clone(remove)
+	slices.SortFunc(remove, func(a, b Range) int { return a.Start.Compare(b.Start) })
+
+	var result []Range
+	for _, r := range ranges {
+		current, kept := r, true
+		for _, x := range remove {
+			if x.End.Less(current.Start) {
+				continue
+			}
+			if current.End.Less(x.Start) {
+				break
+			}
+			if current.Start.Less(x.Start) {
+				result = append(result, Range{Start: current.Start, End: x.Start.Prev()})
+			}
+			if !x.End.Less(current.End) {
+				kept = false
+				break
+			}
+			current.Start = x.End.Next()
+		}
+		if kept {
+			result = append(result, current)
+		}
+	}
+	return result
+}
@@ -0,0 +1,2 @@
+// ipam unit tests
+package testing
@@ -0,0 +1,91 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const SubnetID = "08eae331-0402-425a-923c-34f7cfe39c1b"
+
+const SubnetGetResponse = `
+{
+    "subnet": {
+        "id": "08eae331-0402-425a-923c-34f7cfe39c1b",
+        "network_id": "db193ab3-96e3-4cb3-8fc5-05f4296d0324",
+        "name": "private",
+        "ip_version": 4,
+        "cidr": "10.0.0.0/24",
+        "gateway_ip": "10.0.0.1",
+        "allocation_pools": [
+            {
+                "start": "10.0.0.100",
+                "end": "10.0.0.120"
+            },
+            {
+                "start": "10.0.0.2",
+                "end": "10.0.0.10"
+            }
+        ]
+    }
+}
+`
+
+const PortListResponse = `
+{
+    "ports": [
+        {
+            "id": "port-1",
+            "fixed_ips": [
+                {
+                    "subnet_id": "08eae331-0402-425a-923c-34f7cfe39c1b",
+                    "ip_address": "10.0.0.2"
+                },
+                {
+                    "subnet_id": "a0304c3a-4f08-4c43-88af-d796509c97d2",
+                    "ip_address": "2001:db8::10"
+                }
+            ]
+        },
+        {
+            "id": "port-2",
+            "fixed_ips": [
+                {
+                    "subnet_id": "08eae331-0402-425a-923c-34f7cfe39c1b",
+                    "ip_address": "10.0.0.5"
+                },
+                {
+                    "subnet_id": "08eae331-0402-425a-923c-34f7cfe39c1b",
+                    "ip_address": "10.0.0.100"
+                }
+            ]
+        }
+    ]
+}
+`
+
+func HandleGetUsageSuccessfully(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v2.0/subnets/"+SubnetID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, SubnetGetResponse)
+	})
+
+	fakeServer.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"fixed_ips": "subnet_id=" + SubnetID})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, PortListResponse)
+	})
+}
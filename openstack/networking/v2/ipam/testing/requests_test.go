@@ -0,0 +1,126 @@
+package testing
+
+import (
+	"context"
+	"math"
+	"net/netip"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ipam"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func addr(s string) netip.Addr {
+	return netip.MustParseAddr(s)
+}
+
+func addrRange(start, end string) ipam.Range {
+	return ipam.Range{Start: addr(start), End: addr(end)}
+}
+
+func TestGetUsage(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	HandleGetUsageSuccessfully(t, fakeServer)
+
+	usage, err := ipam.GetUsage(context.TODO(), fake.ServiceClient(fakeServer), SubnetID)
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, SubnetID, usage.SubnetID)
+	th.CheckEquals(t, netip.MustParsePrefix("10.0.0.0/24"), usage.CIDR)
+	th.CheckDeepEquals(t, []ipam.Range{
+		addrRange("10.0.0.2", "10.0.0.10"),
+		addrRange("10.0.0.100", "10.0.0.120"),
+	}, usage.Pools)
+	th.CheckDeepEquals(t, []netip.Addr{
+		addr("10.0.0.1"),
+		addr("10.0.0.2"),
+		addr("10.0.0.5"),
+		addr("10.0.0.100"),
+	}, usage.Used)
+	th.CheckDeepEquals(t, []ipam.Range{
+		addrRange("10.0.0.3", "10.0.0.4"),
+		addrRange("10.0.0.6", "10.0.0.10"),
+		addrRange("10.0.0.101", "10.0.0.120"),
+	}, usage.Free)
+
+	th.CheckEquals(t, uint64(30), usage.Total())
+	th.CheckEquals(t, uint64(27), usage.FreeCount())
+	th.CheckEquals(t, true, math.Abs(usage.Fragmentation()-7.0/27.0) < 1e-9)
+}
+
+func TestNextFree(t *testing.T) {
+	usage := ipam.Usage{
+		SubnetID: SubnetID,
+		Free: []ipam.Range{
+			addrRange("10.0.0.3", "10.0.0.4"),
+			addrRange("10.0.0.6", "10.0.0.10"),
+		},
+	}
+
+	addrs, err := usage.NextFree(3)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []netip.Addr{addr("10.0.0.3"), addr("10.0.0.4"), addr("10.0.0.6")}, addrs)
+
+	addrs, err = usage.NextFree(3, addrRange("10.0.0.4", "10.0.0.7"), addrRange("10.0.0.9", "10.0.0.9"))
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []netip.Addr{addr("10.0.0.3"), addr("10.0.0.8"), addr("10.0.0.10")}, addrs)
+
+	_, err = usage.NextFree(8)
+	var notEnoughErr ipam.ErrNotEnoughFreeIPs
+	th.CheckErr(t, err, &notEnoughErr)
+	th.CheckEquals(t, 7, notEnoughErr.Available)
+}
+
+func TestComputeUsageIPv6(t *testing.T) {
+	subnet := subnets.Subnet{
+		ID:        "a0304c3a-4f08-4c43-88af-d796509c97d2",
+		IPVersion: 6,
+		CIDR:      "2001:db8::/64",
+		GatewayIP: "2001:db8::1",
+	}
+	allPorts := []ports.Port{
+		{FixedIPs: []ports.IP{{SubnetID: subnet.ID, IPAddress: "2001:db8::3"}}},
+	}
+
+	usage, err := ipam.ComputeUsage(subnet, allPorts)
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, []ipam.Range{
+		addrRange("2001:db8::1", "2001:db8::ffff:ffff:ffff:ffff"),
+	}, usage.Pools)
+	th.CheckDeepEquals(t, []ipam.Range{
+		addrRange("2001:db8::2", "2001:db8::2"),
+		addrRange("2001:db8::4", "2001:db8::ffff:ffff:ffff:ffff"),
+	}, usage.Free)
+	th.CheckEquals(t, uint64(math.MaxUint64), usage.Total())
+
+	addrs, err := usage.NextFree(2)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []netip.Addr{addr("2001:db8::2"), addr("2001:db8::4")}, addrs)
+}
+
+func TestComputeUsageDefaultIPv4Pool(t *testing.T) {
+	subnet := subnets.Subnet{
+		ID:        SubnetID,
+		IPVersion: 4,
+		CIDR:      "192.168.1.0/29",
+	}
+
+	usage, err := ipam.ComputeUsage(subnet, nil)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []ipam.Range{addrRange("192.168.1.1", "192.168.1.6")}, usage.Pools)
+	th.CheckEquals(t, uint64(6), usage.FreeCount())
+	th.CheckEquals(t, 0.0, usage.Fragmentation())
+}
+
+func TestRangeSize(t *testing.T) {
+	th.CheckEquals(t, uint64(1), addrRange("10.0.0.1", "10.0.0.1").Size())
+	th.CheckEquals(t, uint64(256), addrRange("10.0.0.0", "10.0.0.255").Size())
+	th.CheckEquals(t, uint64(1<<32), addrRange("2001:db8::", "2001:db8::ffff:ffff").Size())
+	th.CheckEquals(t, uint64(math.MaxUint64), addrRange("2001:db8::", "2001:db8:0:1::").Size())
+}
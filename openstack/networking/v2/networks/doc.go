@@ -41,6 +41,18 @@ Example to Create a Network
 		panic(err)
 	}
 
+Example to Create Networks in a Single Request
+
+	createOpts := []networks.CreateOpts{
+		{Name: "network_1"},
+		{Name: "network_2"},
+	}
+
+	allNetworks, err := networks.CreateBulk(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Update a Network
 
 	networkID := "484cda0e-106f-4f4b-bb3f-d413710bbe78"
@@ -227,3 +227,44 @@ var (
 )
 
 var ExpectedNetworkSlice = []networks.Network{Network1, Network2}
+
+const CreateBulkRequest = `
+{
+    "networks": [
+        {
+            "name": "private",
+            "admin_state_up": true
+        },
+        {
+            "name": "public",
+            "admin_state_up": true,
+            "port_security_enabled": false
+        }
+    ]
+}`
+
+const CreateBulkResponse = `
+{
+    "networks": [
+        {
+            "status": "ACTIVE",
+            "subnets": [],
+            "name": "private",
+            "admin_state_up": true,
+            "tenant_id": "26a7980765d0414dbc1fc1f88cdb7e6e",
+            "shared": false,
+            "id": "db193ab3-96e3-4cb3-8fc5-05f4296d0324",
+            "port_security_enabled": true
+        },
+        {
+            "status": "ACTIVE",
+            "subnets": [],
+            "name": "public",
+            "admin_state_up": true,
+            "tenant_id": "26a7980765d0414dbc1fc1f88cdb7e6e",
+            "shared": false,
+            "id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c",
+            "port_security_enabled": false
+        }
+    ]
+}`
@@ -2,11 +2,13 @@ package testing
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
 	"time"
 
+	"github.com/gophercloud/gophercloud/v2"
 	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/portsecurity"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
@@ -164,6 +166,76 @@ func TestCreate(t *testing.T) {
 	th.AssertEquals(t, n.UpdatedAt.Format(time.RFC3339), "2019-06-30T05:18:49Z")
 }
 
+func TestCreateBulk(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateBulkRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, CreateBulkResponse)
+	})
+
+	iTrue := true
+	iFalse := false
+	options := []networks.CreateOptsBuilder{
+		networks.CreateOpts{Name: "private", AdminStateUp: &iTrue},
+		portsecurity.NetworkCreateOptsExt{
+			CreateOptsBuilder:   networks.CreateOpts{Name: "public", AdminStateUp: &iTrue},
+			PortSecurityEnabled: &iFalse,
+		},
+	}
+
+	var actual []struct {
+		networks.Network
+		portsecurity.PortSecurityExt
+	}
+	err := networks.CreateBulk(context.TODO(), fake.ServiceClient(fakeServer), options).ExtractInto(&actual)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(actual))
+	th.AssertEquals(t, "db193ab3-96e3-4cb3-8fc5-05f4296d0324", actual[0].ID)
+	th.AssertEquals(t, "private", actual[0].Name)
+	th.AssertEquals(t, true, actual[0].PortSecurityEnabled)
+	th.AssertEquals(t, "4e8e5957-649f-477b-9e5b-f1f75b21c03c", actual[1].ID)
+	th.AssertEquals(t, "public", actual[1].Name)
+	th.AssertEquals(t, false, actual[1].PortSecurityEnabled)
+}
+
+func TestCreateBulkFailure(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusConflict)
+	})
+
+	options := []networks.CreateOpts{{Name: "private"}, {Name: "public"}}
+	_, err := networks.CreateBulk(context.TODO(), fake.ServiceClient(fakeServer), options).Extract()
+
+	var bulkErr gophercloud.ErrBulkCreateFailed
+	th.AssertEquals(t, true, errors.As(err, &bulkErr))
+	th.AssertEquals(t, "networks", bulkErr.Resource)
+	th.AssertEquals(t, 2, bulkErr.Count)
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusConflict))
+
+	// Errors that don't come from the service are returned unchanged.
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	_, err = networks.CreateBulk(ctx, fake.ServiceClient(fakeServer), options).Extract()
+	th.AssertEquals(t, false, errors.As(err, &bulkErr))
+	th.AssertEquals(t, true, errors.Is(err, context.Canceled))
+}
+
 func TestCreateWithOptionalFields(t *testing.T) {
 	fakeServer := th.SetupHTTP()
 	defer fakeServer.Teardown()
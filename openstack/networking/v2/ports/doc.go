@@ -48,6 +48,23 @@ Example to Create a Port
 		panic(err)
 	}
 
+Example to Create Ports in a Single Request
+
+	createOpts := make([]ports.CreateOpts, 0, 100)
+	for i := range 100 {
+		createOpts = append(createOpts, ports.CreateOpts{
+			Name:      fmt.Sprintf("port_%d", i),
+			NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+		})
+	}
+
+	// If the request is rejected, none of the ports is created and the
+	// error is a gophercloud.ErrBulkCreateFailed.
+	allPorts, err := ports.CreateBulk(context.TODO(), networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Update a Port
 
 	portID := "c34bae2b-7641-49b6-bf6d-d8e473620ed8"
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"slices"
@@ -179,6 +180,32 @@ func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBu
 	return
 }
 
+// CreateBulk accepts a slice of CreateOpts and creates all the ports in a
+// single request. The Networking service creates them atomically: if it
+// rejects the request, none of the ports is created and the result holds a
+// gophercloud.ErrBulkCreateFailed. Other errors, such as timeouts, are
+// returned unchanged and the ports may have been created.
+func CreateBulk[createOpts CreateOptsBuilder](ctx context.Context, c *gophercloud.ServiceClient, opts []createOpts) (r CreateBulkResult) {
+	ports := make([]any, len(opts))
+	for i, opt := range opts {
+		b, err := opt.ToPortCreateMap()
+		if err != nil {
+			r.Err = fmt.Errorf("port %d: %w", i, err)
+			return
+		}
+		ports[i] = b["port"]
+	}
+
+	b := map[string]any{"ports": ports}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	var codeErr gophercloud.ErrUnexpectedResponseCode
+	if errors.As(r.Err, &codeErr) {
+		r.Err = gophercloud.ErrBulkCreateFailed{Resource: "ports", Count: len(opts), Err: r.Err}
+	}
+	return
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
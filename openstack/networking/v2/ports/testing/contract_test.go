@@ -0,0 +1,83 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/internal/ptr"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/dns"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/extradhcpopts"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/portsbinding"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/portsecurity"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/v2/testhelper/contract"
+)
+
+// portWithExtensions composes the port result with the extensions enabled
+// in the sample responses.
+type portWithExtensions struct {
+	ports.Port
+	dns.PortDNSExt
+	extradhcpopts.ExtraDHCPOptsExt
+	portsbinding.PortsBindingExt
+	portsecurity.PortSecurityExt
+}
+
+func TestContract(t *testing.T) {
+	contract.Suite{
+		Package: "networking/v2/ports",
+		Requests: []contract.RequestCase{
+			{
+				Name:   "create",
+				Schema: "networking/v2/ports/create",
+				Build: func() (map[string]any, error) {
+					return ports.CreateOpts{
+						Name:         "private-port",
+						AdminStateUp: ptr.To(true),
+						NetworkID:    "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+						FixedIPs: []ports.IP{
+							{SubnetID: "a0304c3a-4f08-4c43-88af-d796509c97d2", IPAddress: "10.0.0.2"},
+						},
+						SecurityGroups: &[]string{"foo"},
+						AllowedAddressPairs: []ports.AddressPair{
+							{IPAddress: "10.0.0.4", MACAddress: "fa:16:3e:c9:cb:f0"},
+						},
+						PropagateUplinkStatus: ptr.To(true),
+					}.ToPortCreateMap()
+				},
+			},
+			{
+				Name:   "update",
+				Schema: "networking/v2/ports/update",
+				Build: func() (map[string]any, error) {
+					return ports.UpdateOpts{
+						Name: ptr.To("new_port_name"),
+						FixedIPs: []ports.IP{
+							{SubnetID: "a0304c3a-4f08-4c43-88af-d796509c97d2", IPAddress: "10.0.0.3"},
+						},
+						SecurityGroups: &[]string{"f0ac4394-7e4a-4409-9701-ba8be283dbc3"},
+					}.ToPortUpdateMap()
+				},
+			},
+		},
+		Responses: []contract.ResponseCase{
+			{
+				Name:   "list",
+				Body:   ListResponse,
+				Key:    "ports",
+				Target: &[]portWithExtensions{},
+			},
+			{
+				Name:   "get",
+				Body:   GetResponse,
+				Key:    "port",
+				Target: &portWithExtensions{},
+			},
+			{
+				Name:   "create",
+				Body:   CreateResponse,
+				Key:    "port",
+				Target: &ports.Port{},
+			},
+		},
+	}.Run(t)
+}
@@ -136,6 +136,61 @@ const CreateResponse = `
 }
 `
 
+const CreateBulkRequest = `
+{
+    "ports": [
+        {
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "name": "port-1",
+            "admin_state_up": true
+        },
+        {
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "name": "port-2",
+            "admin_state_up": true,
+            "key": "value"
+        }
+    ]
+}
+`
+
+const CreateBulkResponse = `
+{
+    "ports": [
+        {
+            "status": "DOWN",
+            "name": "port-1",
+            "admin_state_up": true,
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "tenant_id": "d6700c0c9ffa4f1cb322cd4a1f3906fa",
+            "mac_address": "fa:16:3e:c9:cb:f0",
+            "fixed_ips": [
+                {
+                    "subnet_id": "a0304c3a-4f08-4c43-88af-d796509c97d2",
+                    "ip_address": "10.0.0.2"
+                }
+            ],
+            "id": "65c0ee9f-d634-4522-8954-51021b570b0d"
+        },
+        {
+            "status": "DOWN",
+            "name": "port-2",
+            "admin_state_up": true,
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "tenant_id": "d6700c0c9ffa4f1cb322cd4a1f3906fa",
+            "mac_address": "fa:16:3e:6f:2a:11",
+            "fixed_ips": [
+                {
+                    "subnet_id": "a0304c3a-4f08-4c43-88af-d796509c97d2",
+                    "ip_address": "10.0.0.3"
+                }
+            ],
+            "id": "8c2f4f8e-7b1a-4e1d-9a52-3d4c6b7a8e90"
+        }
+    ]
+}
+`
+
 const CreateOmitSecurityGroupsRequest = `
 {
     "port": {
@@ -217,6 +217,68 @@ func TestCreate(t *testing.T) {
 	})
 }
 
+func TestCreateBulk(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateBulkRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, CreateBulkResponse)
+	})
+
+	asu := true
+	options := []ports.CreateOpts{
+		{
+			Name:         "port-1",
+			AdminStateUp: &asu,
+			NetworkID:    "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+		},
+		{
+			Name:         "port-2",
+			AdminStateUp: &asu,
+			NetworkID:    "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+			ValueSpecs:   &map[string]string{"key": "value"},
+		},
+	}
+	actual, err := ports.CreateBulk(context.TODO(), fake.ServiceClient(fakeServer), options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(actual))
+	th.AssertEquals(t, "65c0ee9f-d634-4522-8954-51021b570b0d", actual[0].ID)
+	th.AssertEquals(t, "port-1", actual[0].Name)
+	th.AssertDeepEquals(t, []ports.IP{
+		{SubnetID: "a0304c3a-4f08-4c43-88af-d796509c97d2", IPAddress: "10.0.0.2"},
+	}, actual[0].FixedIPs)
+	th.AssertEquals(t, "8c2f4f8e-7b1a-4e1d-9a52-3d4c6b7a8e90", actual[1].ID)
+	th.AssertEquals(t, "port-2", actual[1].Name)
+	th.AssertDeepEquals(t, []ports.IP{
+		{SubnetID: "a0304c3a-4f08-4c43-88af-d796509c97d2", IPAddress: "10.0.0.3"},
+	}, actual[1].FixedIPs)
+}
+
+func TestCreateBulkInvalidOpts(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	options := []ports.CreateOpts{
+		{NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7"},
+		{
+			NetworkID:  "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+			ValueSpecs: &map[string]string{"tenant_id": "d6700c0c9ffa4f1cb322cd4a1f3906fa"},
+		},
+	}
+	_, err := ports.CreateBulk(context.TODO(), fake.ServiceClient(fakeServer), options).Extract()
+	th.AssertEquals(t, "port 1: forbidden key in value_specs: tenant_id", err.Error())
+}
+
 func TestCreateOmitSecurityGroups(t *testing.T) {
 	fakeServer := th.SetupHTTP()
 	defer fakeServer.Teardown()
@@ -2,6 +2,7 @@ package subnets
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/gophercloud/gophercloud/v2"
@@ -182,6 +183,32 @@ func Create(ctx context.Context, c *gophercloud.ServiceClient, opts CreateOptsBu
 	return
 }
 
+// CreateBulk accepts a slice of CreateOpts and creates all the subnets in a
+// single request. The Networking service creates them atomically: if it
+// rejects the request, none of the subnets is created and the result holds a
+// gophercloud.ErrBulkCreateFailed. Other errors, such as timeouts, are
+// returned unchanged and the subnets may have been created.
+func CreateBulk[createOpts CreateOptsBuilder](ctx context.Context, c *gophercloud.ServiceClient, opts []createOpts) (r CreateBulkResult) {
+	subnets := make([]any, len(opts))
+	for i, opt := range opts {
+		b, err := opt.ToSubnetCreateMap()
+		if err != nil {
+			r.Err = fmt.Errorf("subnet %d: %w", i, err)
+			return
+		}
+		subnets[i] = b["subnet"]
+	}
+
+	b := map[string]any{"subnets": subnets}
+	resp, err := c.Post(ctx, createURL(c), b, &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	var codeErr gophercloud.ErrUnexpectedResponseCode
+	if errors.As(r.Err, &codeErr) {
+		r.Err = gophercloud.ErrBulkCreateFailed{Resource: "subnets", Count: len(opts), Err: r.Err}
+	}
+	return
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
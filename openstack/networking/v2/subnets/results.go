@@ -27,6 +27,23 @@ type CreateResult struct {
 	commonResult
 }
 
+// CreateBulkResult represents the result of a bulk create operation. Call its
+// Extract method to interpret it as a slice of Subnets.
+type CreateBulkResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts subnet resources.
+func (r CreateBulkResult) Extract() ([]Subnet, error) {
+	var s []Subnet
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func (r CreateBulkResult) ExtractInto(v any) error {
+	return r.ExtractIntoSlicePtr(v, "subnets")
+}
+
 // GetResult represents the result of a get operation. Call its Extract
 // method to interpret it as a Subnet.
 type GetResult struct {
@@ -152,6 +152,50 @@ func TestCreate(t *testing.T) {
 	th.AssertEquals(t, s.SubnetPoolID, "b80340c7-9960-4f67-a99c-02501656284b")
 }
 
+func TestCreateBulk(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	fakeServer.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, SubnetCreateBulkRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, SubnetCreateBulkResult)
+	})
+
+	var noGateway = ""
+	var gatewayIP = "fdf8:f53b:82e4::1"
+	opts := []subnets.CreateOpts{
+		{
+			NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			IPVersion: 4,
+			CIDR:      "192.168.199.0/24",
+			GatewayIP: &noGateway,
+		},
+		{
+			NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			IPVersion: 6,
+			CIDR:      "fdf8:f53b:82e4::/64",
+			GatewayIP: &gatewayIP,
+		},
+	}
+	s, err := subnets.CreateBulk(context.TODO(), fake.ServiceClient(fakeServer), opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(s))
+	th.AssertEquals(t, "3b80198d-4f7b-4f77-9ef5-774d54e17126", s[0].ID)
+	th.AssertEquals(t, "", s[0].GatewayIP)
+	th.AssertEquals(t, "0cb4a3a8-6c2e-4a2e-9b4e-7f1f4b3f6c21", s[1].ID)
+	th.AssertEquals(t, "fdf8:f53b:82e4::1", s[1].GatewayIP)
+	th.AssertEquals(t, 6, s[1].IPVersion)
+}
+
 func TestCreateNoGateway(t *testing.T) {
 	fakeServer := th.SetupHTTP()
 	defer fakeServer.Teardown()
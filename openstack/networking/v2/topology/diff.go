@@ -0,0 +1,707 @@
+package topology
+
+import (
+	"context"
+	"maps"
+	"net/netip"
+	"slices"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+)
+
+// state maps the names of the resources of a topology to their IDs. It is
+// seeded with the existing resources and completed by Apply as resources
+// are created.
+type state struct {
+	tag      string
+	networks map[string]string
+	subnets  map[string]string
+	groups   map[string]string
+	routers  map[string]string
+}
+
+func newState(tag string, e *existing) *state {
+	s := &state{
+		tag:      tag,
+		networks: make(map[string]string),
+		subnets:  make(map[string]string),
+		groups:   make(map[string]string),
+		routers:  make(map[string]string),
+	}
+	for name, n := range e.networks {
+		s.networks[name] = n.ID
+	}
+	for name, sn := range e.subnets {
+		s.subnets[name] = sn.ID
+	}
+	for name, g := range e.groups {
+		s.groups[name] = g.ID
+	}
+	for name, r := range e.routers {
+		s.routers[name] = r.ID
+	}
+	return s
+}
+
+// tag adds the tag of the topology to a created resource. The returned undo
+// deletes the resource, even when tagging fails, so that no untagged
+// resource is left behind.
+func tag(ctx context.Context, client *gophercloud.ServiceClient, s *state, resourceType, id string, del undo) (undo, error) {
+	return del, attributestags.Add(ctx, client, resourceType, id, s.tag).ExtractErr()
+}
+
+func diff(t Topology, e *existing) (*Plan, error) {
+	var creates, deletes []Action
+
+	desiredNetworks := make(map[string]bool)
+	desiredSubnets := make(map[string]bool)
+	for _, n := range t.Networks {
+		desiredNetworks[n.Name] = true
+		creates = append(creates, diffNetwork(n, e)...)
+	}
+	for _, n := range t.Networks {
+		for _, sn := range n.Subnets {
+			ref := SubnetRef{Network: n.Name, Subnet: sn.Name}.String()
+			desiredSubnets[ref] = true
+			actions, err := diffSubnet(ref, n.Name, sn, e)
+			if err != nil {
+				return nil, err
+			}
+			creates = append(creates, actions...)
+		}
+	}
+
+	desiredGroups := make(map[string]bool)
+	for _, g := range t.SecurityGroups {
+		desiredGroups[g.Name] = true
+		creates = append(creates, diffSecurityGroup(g, e)...)
+	}
+	for _, g := range t.SecurityGroups {
+		ruleCreates, ruleDeletes := diffRules(g, e)
+		creates = append(creates, ruleCreates...)
+		deletes = append(deletes, ruleDeletes...)
+	}
+
+	desiredRouters := make(map[string]bool)
+	for _, r := range t.Routers {
+		desiredRouters[r.Name] = true
+		creates = append(creates, diffRouter(r, e)...)
+	}
+	var interfaceDeletes []Action
+	for _, r := range t.Routers {
+		adds, removes := diffInterfaces(r, e)
+		creates = append(creates, adds...)
+		interfaceDeletes = append(interfaceDeletes, removes...)
+	}
+
+	desiredFIPs := make(map[string]bool)
+	for _, f := range t.FloatingIPs {
+		desiredFIPs[f.Name] = true
+		actions, err := diffFloatingIP(f, e)
+		if err != nil {
+			return nil, err
+		}
+		creates = append(creates, actions...)
+	}
+
+	// Deletions are made in reverse dependency order: a resource is
+	// deleted once nothing uses it anymore.
+	var ordered []Action
+	for _, name := range slices.Sorted(maps.Keys(e.fips)) {
+		if !desiredFIPs[name] {
+			ordered = append(ordered, deleteFloatingIP(name, e.fips[name].ID))
+		}
+	}
+	ordered = append(ordered, interfaceDeletes...)
+	for _, name := range slices.Sorted(maps.Keys(e.routers)) {
+		if !desiredRouters[name] {
+			ordered = append(ordered, deleteRouter(name, e.routers[name].ID, e.interfaces[name]))
+		}
+	}
+	ordered = append(ordered, deletes...)
+	for _, name := range slices.Sorted(maps.Keys(e.groups)) {
+		if !desiredGroups[name] {
+			ordered = append(ordered, deleteSecurityGroup(name, e.groups[name].ID))
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(e.subnets)) {
+		if !desiredSubnets[name] {
+			ordered = append(ordered, deleteSubnet(name, e.subnets[name].ID))
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(e.networks)) {
+		if !desiredNetworks[name] {
+			ordered = append(ordered, deleteNetwork(name, e.networks[name].ID))
+		}
+	}
+
+	return &Plan{
+		Actions: append(creates, ordered...),
+		state:   newState(t.Tag, e),
+	}, nil
+}
+
+func diffNetwork(n Network, e *existing) []Action {
+	current, ok := e.networks[n.Name]
+	if !ok {
+		return []Action{{
+			Operation: OperationCreate,
+			Resource:  ResourceNetwork,
+			Name:      n.Name,
+			run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+				network, err := networks.Create(ctx, client, networks.CreateOpts{
+					Name:         n.Name,
+					Description:  n.Description,
+					AdminStateUp: n.AdminStateUp,
+				}).Extract()
+				if err != nil {
+					return nil, err
+				}
+				s.networks[n.Name] = network.ID
+				return tag(ctx, client, s, "networks", network.ID, func(ctx context.Context, client *gophercloud.ServiceClient) error {
+					return networks.Delete(ctx, client, network.ID).ExtractErr()
+				})
+			},
+		}}
+	}
+
+	var opts, revert networks.UpdateOpts
+	changed := false
+	if n.Description != "" && n.Description != current.Description {
+		opts.Description, revert.Description = &n.Description, &current.Description
+		changed = true
+	}
+	if n.AdminStateUp != nil && *n.AdminStateUp != current.AdminStateUp {
+		opts.AdminStateUp, revert.AdminStateUp = n.AdminStateUp, &current.AdminStateUp
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return []Action{{
+		Operation: OperationUpdate,
+		Resource:  ResourceNetwork,
+		Name:      n.Name,
+		ID:        current.ID,
+		run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+			if _, err := networks.Update(ctx, client, current.ID, opts).Extract(); err != nil {
+				return nil, err
+			}
+			return func(ctx context.Context, client *gophercloud.ServiceClient) error {
+				_, err := networks.Update(ctx, client, current.ID, revert).Extract()
+				return err
+			}, nil
+		},
+	}}
+}
+
+func diffSubnet(ref, network string, sn Subnet, e *existing) ([]Action, error) {
+	ipVersion := sn.IPVersion
+	if ipVersion == 0 {
+		ipVersion = gophercloud.IPv4
+	}
+
+	current, ok := e.subnets[ref]
+	if !ok {
+		return []Action{{
+			Operation: OperationCreate,
+			Resource:  ResourceSubnet,
+			Name:      ref,
+			run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+				subnet, err := subnets.Create(ctx, client, subnets.CreateOpts{
+					NetworkID:       s.networks[network],
+					CIDR:            sn.CIDR,
+					Name:            sn.Name,
+					Description:     sn.Description,
+					IPVersion:       ipVersion,
+					GatewayIP:       sn.GatewayIP,
+					EnableDHCP:      sn.EnableDHCP,
+					DNSNameservers:  sn.DNSNameservers,
+					AllocationPools: sn.AllocationPools,
+				}).Extract()
+				if err != nil {
+					return nil, err
+				}
+				s.subnets[ref] = subnet.ID
+				return tag(ctx, client, s, "subnets", subnet.ID, func(ctx context.Context, client *gophercloud.ServiceClient) error {
+					return subnets.Delete(ctx, client, subnet.ID).ExtractErr()
+				})
+			},
+		}}, nil
+	}
+
+	if !sameCIDR(sn.CIDR, current.CIDR) {
+		return nil, ErrImmutableChange{Resource: ResourceSubnet, Name: ref, Field: "CIDR", Existing: current.CIDR, Desired: sn.CIDR}
+	}
+	if int(ipVersion) != current.IPVersion {
+		return nil, ErrImmutableChange{Resource: ResourceSubnet, Name: ref, Field: "IPVersion", Existing: strconv.Itoa(current.IPVersion), Desired: strconv.Itoa(int(ipVersion))}
+	}
+
+	var opts, revert subnets.UpdateOpts
+	changed := false
+	if sn.Description != "" && sn.Description != current.Description {
+		opts.Description, revert.Description = &sn.Description, &current.Description
+		changed = true
+	}
+	if sn.GatewayIP != nil && *sn.GatewayIP != current.GatewayIP {
+		opts.GatewayIP, revert.GatewayIP = sn.GatewayIP, &current.GatewayIP
+		changed = true
+	}
+	if sn.EnableDHCP != nil && *sn.EnableDHCP != current.EnableDHCP {
+		opts.EnableDHCP, revert.EnableDHCP = sn.EnableDHCP, &current.EnableDHCP
+		changed = true
+	}
+	if len(sn.DNSNameservers) > 0 && !slices.Equal(sn.DNSNameservers, current.DNSNameservers) {
+		opts.DNSNameservers, revert.DNSNameservers = &sn.DNSNameservers, &current.DNSNameservers
+		changed = true
+	}
+	if len(sn.AllocationPools) > 0 && !slices.Equal(sn.AllocationPools, current.AllocationPools) {
+		opts.AllocationPools, revert.AllocationPools = sn.AllocationPools, current.AllocationPools
+		changed = true
+	}
+	if !changed {
+		return nil, nil
+	}
+	return []Action{{
+		Operation: OperationUpdate,
+		Resource:  ResourceSubnet,
+		Name:      ref,
+		ID:        current.ID,
+		run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+			if _, err := subnets.Update(ctx, client, current.ID, opts).Extract(); err != nil {
+				return nil, err
+			}
+			return func(ctx context.Context, client *gophercloud.ServiceClient) error {
+				_, err := subnets.Update(ctx, client, current.ID, revert).Extract()
+				return err
+			}, nil
+		},
+	}}, nil
+}
+
+// sameCIDR reports whether two CIDRs denote the same address range, such
+// as "10.0.0.1/24" and "10.0.0.0/24".
+func sameCIDR(a, b string) bool {
+	pa, errA := netip.ParsePrefix(a)
+	pb, errB := netip.ParsePrefix(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return pa.Masked() == pb.Masked()
+}
+
+func diffSecurityGroup(g SecurityGroup, e *existing) []Action {
+	current, ok := e.groups[g.Name]
+	if !ok {
+		return []Action{{
+			Operation: OperationCreate,
+			Resource:  ResourceSecurityGroup,
+			Name:      g.Name,
+			run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+				group, err := groups.Create(ctx, client, groups.CreateOpts{
+					Name:        g.Name,
+					Description: g.Description,
+				}).Extract()
+				if err != nil {
+					return nil, err
+				}
+				s.groups[g.Name] = group.ID
+				del := func(ctx context.Context, client *gophercloud.ServiceClient) error {
+					return groups.Delete(ctx, client, group.ID).ExtractErr()
+				}
+
+				// Neutron adds default egress rules to new groups. They
+				// are removed as the group must only have its own rules.
+				for _, rule := range group.Rules {
+					if err := rules.Delete(ctx, client, rule.ID).ExtractErr(); err != nil {
+						return del, err
+					}
+				}
+				return tag(ctx, client, s, "security-groups", group.ID, del)
+			},
+		}}
+	}
+
+	if g.Description == "" || g.Description == current.Description {
+		return nil
+	}
+	opts := groups.UpdateOpts{Description: &g.Description}
+	revert := groups.UpdateOpts{Description: &current.Description}
+	return []Action{{
+		Operation: OperationUpdate,
+		Resource:  ResourceSecurityGroup,
+		Name:      g.Name,
+		ID:        current.ID,
+		run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+			if _, err := groups.Update(ctx, client, current.ID, opts).Extract(); err != nil {
+				return nil, err
+			}
+			return func(ctx context.Context, client *gophercloud.ServiceClient) error {
+				_, err := groups.Update(ctx, client, current.ID, revert).Extract()
+				return err
+			}, nil
+		},
+	}}
+}
+
+// ruleKey identifies a security group rule by its attributes, normalized
+// like rules.Diff does, so that equivalent spellings such as "6" and "tcp"
+// match.
+func ruleKey(direction rules.RuleDirection, etherType rules.RuleEtherType, protocol rules.RuleProtocol, min, max int, remoteIPPrefix, remoteGroup string) string {
+	opts := rules.CreateOpts{
+		Direction:      direction,
+		EtherType:      etherType,
+		Protocol:       protocol,
+		PortRangeMin:   min,
+		PortRangeMax:   max,
+		RemoteIPPrefix: remoteIPPrefix,
+	}.Normalize()
+	return ruleName(string(opts.Direction), string(opts.EtherType), string(opts.Protocol), opts.PortRangeMin, opts.PortRangeMax, opts.RemoteIPPrefix, remoteGroup)
+}
+
+// diffRules returns the creations and deletions of the rules of a security
+// group. Rules have no name: they are matched by their normalized attributes.
+func diffRules(g SecurityGroup, e *existing) (creates, deletes []Action) {
+	existingRules := make(map[string]rules.SecGroupRule)
+	existingNames := make(map[string]string)
+	if current, ok := e.groups[g.Name]; ok {
+		for _, r := range current.Rules {
+			remoteGroup := ""
+			if r.RemoteGroupID != "" {
+				remoteGroup = r.RemoteGroupID
+				if name, ok := e.groupNames[r.RemoteGroupID]; ok {
+					remoteGroup = name
+				}
+			}
+			key := ruleKey(rules.RuleDirection(r.Direction), rules.RuleEtherType(r.EtherType), rules.RuleProtocol(r.Protocol), r.PortRangeMin, r.PortRangeMax, r.RemoteIPPrefix, remoteGroup)
+			existingRules[key] = r
+			existingNames[key] = ruleName(r.Direction, r.EtherType, r.Protocol, r.PortRangeMin, r.PortRangeMax, r.RemoteIPPrefix, remoteGroup)
+		}
+	}
+
+	desired := make(map[string]bool)
+	for _, r := range g.Rules {
+		etherType := r.EtherType
+		if etherType == "" {
+			etherType = rules.EtherType4
+		}
+		key := ruleKey(r.Direction, etherType, r.Protocol, r.PortRangeMin, r.PortRangeMax, r.RemoteIPPrefix, r.RemoteGroup)
+		if desired[key] {
+			continue
+		}
+		desired[key] = true
+		if _, ok := existingRules[key]; ok {
+			continue
+		}
+
+		creates = append(creates, Action{
+			Operation: OperationCreate,
+			Resource:  ResourceSecurityGroupRule,
+			Name:      g.Name + ":" + ruleName(string(r.Direction), string(etherType), string(r.Protocol), r.PortRangeMin, r.PortRangeMax, r.RemoteIPPrefix, r.RemoteGroup),
+			run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+				opts := rules.CreateOpts{
+					Direction:      r.Direction,
+					EtherType:      etherType,
+					SecGroupID:     s.groups[g.Name],
+					Protocol:       r.Protocol,
+					PortRangeMin:   r.PortRangeMin,
+					PortRangeMax:   r.PortRangeMax,
+					RemoteIPPrefix: r.RemoteIPPrefix,
+				}
+				if r.RemoteGroup != "" {
+					opts.RemoteGroupID = s.groups[r.RemoteGroup]
+				}
+				rule, err := rules.Create(ctx, client, opts).Extract()
+				if err != nil {
+					return nil, err
+				}
+				return func(ctx context.Context, client *gophercloud.ServiceClient) error {
+					return rules.Delete(ctx, client, rule.ID).ExtractErr()
+				}, nil
+			},
+		})
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(existingRules)) {
+		if desired[key] {
+			continue
+		}
+		id := existingRules[key].ID
+		deletes = append(deletes, Action{
+			Operation: OperationDelete,
+			Resource:  ResourceSecurityGroupRule,
+			Name:      g.Name + ":" + existingNames[key],
+			ID:        id,
+			run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+				return nil, rules.Delete(ctx, client, id).ExtractErr()
+			},
+		})
+	}
+
+	return creates, deletes
+}
+
+func diffRouter(r Router, e *existing) []Action {
+	current, ok := e.routers[r.Name]
+	if !ok {
+		return []Action{{
+			Operation: OperationCreate,
+			Resource:  ResourceRouter,
+			Name:      r.Name,
+			run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+				opts := routers.CreateOpts{
+					Name:         r.Name,
+					Description:  r.Description,
+					AdminStateUp: r.AdminStateUp,
+				}
+				if r.ExternalNetworkID != "" {
+					opts.GatewayInfo = &routers.GatewayInfo{
+						NetworkID:  r.ExternalNetworkID,
+						EnableSNAT: r.EnableSNAT,
+					}
+				}
+				router, err := routers.Create(ctx, client, opts).Extract()
+				if err != nil {
+					return nil, err
+				}
+				s.routers[r.Name] = router.ID
+				return tag(ctx, client, s, "routers", router.ID, func(ctx context.Context, client *gophercloud.ServiceClient) error {
+					return routers.Delete(ctx, client, router.ID).ExtractErr()
+				})
+			},
+		}}
+	}
+
+	var opts, revert routers.UpdateOpts
+	changed := false
+	if r.Description != "" && r.Description != current.Description {
+		opts.Description, revert.Description = &r.Description, &current.Description
+		changed = true
+	}
+	if r.AdminStateUp != nil && *r.AdminStateUp != current.AdminStateUp {
+		opts.AdminStateUp, revert.AdminStateUp = r.AdminStateUp, &current.AdminStateUp
+		changed = true
+	}
+	gatewayChanged := r.ExternalNetworkID != current.GatewayInfo.NetworkID
+	if r.ExternalNetworkID != "" && r.EnableSNAT != nil &&
+		(current.GatewayInfo.EnableSNAT == nil || *r.EnableSNAT != *current.GatewayInfo.EnableSNAT) {
+		gatewayChanged = true
+	}
+	if gatewayChanged {
+		// An empty gateway removes the gateway of the router.
+		opts.GatewayInfo = &routers.GatewayInfo{}
+		if r.ExternalNetworkID != "" {
+			opts.GatewayInfo = &routers.GatewayInfo{NetworkID: r.ExternalNetworkID, EnableSNAT: r.EnableSNAT}
+		}
+		revert.GatewayInfo = &routers.GatewayInfo{}
+		if current.GatewayInfo.NetworkID != "" {
+			revert.GatewayInfo = &routers.GatewayInfo{NetworkID: current.GatewayInfo.NetworkID, EnableSNAT: current.GatewayInfo.EnableSNAT}
+		}
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return []Action{{
+		Operation: OperationUpdate,
+		Resource:  ResourceRouter,
+		Name:      r.Name,
+		ID:        current.ID,
+		run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+			if _, err := routers.Update(ctx, client, current.ID, opts).Extract(); err != nil {
+				return nil, err
+			}
+			return func(ctx context.Context, client *gophercloud.ServiceClient) error {
+				_, err := routers.Update(ctx, client, current.ID, revert).Extract()
+				return err
+			}, nil
+		},
+	}}
+}
+
+// diffInterfaces returns the additions of interfaces of a router, and the
+// removals of the interfaces of an existing router that are no longer part
+// of the topology.
+func diffInterfaces(r Router, e *existing) (adds, removes []Action) {
+	attached := make(map[string]string)
+	for _, subnetID := range e.interfaces[r.Name] {
+		name, ok := e.subnetNames[subnetID]
+		if !ok {
+			name = subnetID
+		}
+		attached[name] = subnetID
+	}
+
+	desired := make(map[string]bool)
+	for _, i := range r.Interfaces {
+		ref := i.String()
+		if desired[ref] {
+			continue
+		}
+		desired[ref] = true
+		if _, ok := attached[ref]; ok {
+			continue
+		}
+		adds = append(adds, Action{
+			Operation: OperationCreate,
+			Resource:  ResourceRouterInterface,
+			Name:      r.Name + ":" + ref,
+			run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+				routerID, subnetID := s.routers[r.Name], s.subnets[ref]
+				_, err := routers.AddInterface(ctx, client, routerID, routers.AddInterfaceOpts{SubnetID: subnetID}).Extract()
+				if err != nil {
+					return nil, err
+				}
+				return func(ctx context.Context, client *gophercloud.ServiceClient) error {
+					_, err := routers.RemoveInterface(ctx, client, routerID, routers.RemoveInterfaceOpts{SubnetID: subnetID}).Extract()
+					return err
+				}, nil
+			},
+		})
+	}
+
+	current, ok := e.routers[r.Name]
+	if !ok {
+		return adds, nil
+	}
+	for _, ref := range slices.Sorted(maps.Keys(attached)) {
+		if desired[ref] {
+			continue
+		}
+		subnetID := attached[ref]
+		removes = append(removes, Action{
+			Operation: OperationDelete,
+			Resource:  ResourceRouterInterface,
+			Name:      r.Name + ":" + ref,
+			ID:        subnetID,
+			run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+				_, err := routers.RemoveInterface(ctx, client, current.ID, routers.RemoveInterfaceOpts{SubnetID: subnetID}).Extract()
+				return nil, err
+			},
+		})
+	}
+	return adds, removes
+}
+
+func diffFloatingIP(f FloatingIP, e *existing) ([]Action, error) {
+	current, ok := e.fips[f.Name]
+	if !ok {
+		return []Action{{
+			Operation: OperationCreate,
+			Resource:  ResourceFloatingIP,
+			Name:      f.Name,
+			run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+				fip, err := floatingips.Create(ctx, client, floatingips.CreateOpts{
+					Description:       f.Name,
+					FloatingNetworkID: f.FloatingNetworkID,
+					PortID:            f.PortID,
+				}).Extract()
+				if err != nil {
+					return nil, err
+				}
+				return tag(ctx, client, s, "floatingips", fip.ID, func(ctx context.Context, client *gophercloud.ServiceClient) error {
+					return floatingips.Delete(ctx, client, fip.ID).ExtractErr()
+				})
+			},
+		}}, nil
+	}
+
+	if f.FloatingNetworkID != current.FloatingNetworkID {
+		return nil, ErrImmutableChange{Resource: ResourceFloatingIP, Name: f.Name, Field: "FloatingNetworkID", Existing: current.FloatingNetworkID, Desired: f.FloatingNetworkID}
+	}
+	if f.PortID == current.PortID {
+		return nil, nil
+	}
+	return []Action{{
+		Operation: OperationUpdate,
+		Resource:  ResourceFloatingIP,
+		Name:      f.Name,
+		ID:        current.ID,
+		run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+			_, err := floatingips.Update(ctx, client, current.ID, floatingips.UpdateOpts{PortID: &f.PortID}).Extract()
+			if err != nil {
+				return nil, err
+			}
+			return func(ctx context.Context, client *gophercloud.ServiceClient) error {
+				_, err := floatingips.Update(ctx, client, current.ID, floatingips.UpdateOpts{PortID: &current.PortID}).Extract()
+				return err
+			}, nil
+		},
+	}}, nil
+}
+
+func deleteFloatingIP(name, id string) Action {
+	return Action{
+		Operation: OperationDelete,
+		Resource:  ResourceFloatingIP,
+		Name:      name,
+		ID:        id,
+		run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+			return nil, floatingips.Delete(ctx, client, id).ExtractErr()
+		},
+	}
+}
+
+// deleteRouter removes the interfaces of a router before deleting it, as
+// Neutron refuses to delete routers with interfaces.
+func deleteRouter(name, id string, subnetIDs []string) Action {
+	return Action{
+		Operation: OperationDelete,
+		Resource:  ResourceRouter,
+		Name:      name,
+		ID:        id,
+		run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+			for _, subnetID := range subnetIDs {
+				_, err := routers.RemoveInterface(ctx, client, id, routers.RemoveInterfaceOpts{SubnetID: subnetID}).Extract()
+				if err != nil {
+					return nil, err
+				}
+			}
+			return nil, routers.Delete(ctx, client, id).ExtractErr()
+		},
+	}
+}
+
+func deleteSecurityGroup(name, id string) Action {
+	return Action{
+		Operation: OperationDelete,
+		Resource:  ResourceSecurityGroup,
+		Name:      name,
+		ID:        id,
+		run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+			return nil, groups.Delete(ctx, client, id).ExtractErr()
+		},
+	}
+}
+
+func deleteSubnet(name, id string) Action {
+	return Action{
+		Operation: OperationDelete,
+		Resource:  ResourceSubnet,
+		Name:      name,
+		ID:        id,
+		run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+			return nil, subnets.Delete(ctx, client, id).ExtractErr()
+		},
+	}
+}
+
+func deleteNetwork(name, id string) Action {
+	return Action{
+		Operation: OperationDelete,
+		Resource:  ResourceNetwork,
+		Name:      name,
+		ID:        id,
+		run: func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error) {
+			return nil, networks.Delete(ctx, client, id).ExtractErr()
+		},
+	}
+}
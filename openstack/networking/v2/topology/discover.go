@@ -0,0 +1,173 @@
+package topology
+
+import (
+	"context"
+	"slices"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+)
+
+// existing holds the resources carrying the tag of a topology, keyed by
+// their name in the topology.
+type existing struct {
+	networks map[string]networks.Network
+	subnets  map[string]subnets.Subnet
+	groups   map[string]groups.SecGroup
+	routers  map[string]routers.Router
+	fips     map[string]floatingips.FloatingIP
+
+	// interfaces holds the IDs of the subnets each router is attached to.
+	interfaces map[string][]string
+
+	// subnetNames and groupNames map IDs back to names.
+	subnetNames map[string]string
+	groupNames  map[string]string
+}
+
+// routerInterfaceOwners are the device owners of the ports attaching a
+// router to its subnets.
+var routerInterfaceOwners = []string{
+	"network:router_interface",
+	"network:router_interface_distributed",
+	"network:ha_router_replicated_interface",
+}
+
+func discover(ctx context.Context, client *gophercloud.ServiceClient, tag string) (*existing, error) {
+	e := &existing{
+		networks:    make(map[string]networks.Network),
+		subnets:     make(map[string]subnets.Subnet),
+		groups:      make(map[string]groups.SecGroup),
+		routers:     make(map[string]routers.Router),
+		fips:        make(map[string]floatingips.FloatingIP),
+		interfaces:  make(map[string][]string),
+		subnetNames: make(map[string]string),
+		groupNames:  make(map[string]string),
+	}
+
+	allPages, err := networks.List(client, networks.ListOpts{Tags: tag}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allNetworks, err := networks.ExtractNetworks(allPages)
+	if err != nil {
+		return nil, err
+	}
+	networkNames := make(map[string]string, len(allNetworks))
+	for _, n := range allNetworks {
+		if _, ok := e.networks[n.Name]; ok {
+			return nil, ErrDuplicateName{Resource: ResourceNetwork, Name: n.Name}
+		}
+		e.networks[n.Name] = n
+		networkNames[n.ID] = n.Name
+	}
+
+	allPages, err = subnets.List(client, subnets.ListOpts{Tags: tag}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allSubnets, err := subnets.ExtractSubnets(allPages)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range allSubnets {
+		// Tagged subnets of networks outside the topology are named after
+		// the network ID so that they are deleted.
+		network, ok := networkNames[s.NetworkID]
+		if !ok {
+			network = s.NetworkID
+		}
+		name := SubnetRef{Network: network, Subnet: s.Name}.String()
+		if _, ok := e.subnets[name]; ok {
+			return nil, ErrDuplicateName{Resource: ResourceSubnet, Name: name}
+		}
+		e.subnets[name] = s
+		e.subnetNames[s.ID] = name
+	}
+
+	allPages, err = groups.List(client, groups.ListOpts{Tags: tag}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allGroups, err := groups.ExtractGroups(allPages)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range allGroups {
+		if _, ok := e.groups[g.Name]; ok {
+			return nil, ErrDuplicateName{Resource: ResourceSecurityGroup, Name: g.Name}
+		}
+		e.groups[g.Name] = g
+		e.groupNames[g.ID] = g.Name
+	}
+
+	allPages, err = routers.List(client, routers.ListOpts{Tags: tag}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allRouters, err := routers.ExtractRouters(allPages)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range allRouters {
+		if _, ok := e.routers[r.Name]; ok {
+			return nil, ErrDuplicateName{Resource: ResourceRouter, Name: r.Name}
+		}
+		e.routers[r.Name] = r
+
+		subnetIDs, err := routerSubnets(ctx, client, r.ID)
+		if err != nil {
+			return nil, err
+		}
+		e.interfaces[r.Name] = subnetIDs
+	}
+
+	allPages, err = floatingips.List(client, floatingips.ListOpts{Tags: tag}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allFIPs, err := floatingips.ExtractFloatingIPs(allPages)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range allFIPs {
+		if _, ok := e.fips[f.Description]; ok {
+			return nil, ErrDuplicateName{Resource: ResourceFloatingIP, Name: f.Description}
+		}
+		e.fips[f.Description] = f
+	}
+
+	return e, nil
+}
+
+// routerSubnets returns the IDs of the subnets a router is attached to.
+func routerSubnets(ctx context.Context, client *gophercloud.ServiceClient, routerID string) ([]string, error) {
+	allPages, err := ports.List(client, ports.ListOpts{DeviceID: routerID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var subnetIDs []string
+	for _, p := range allPorts {
+		if !isRouterInterface(p.DeviceOwner) {
+			continue
+		}
+		for _, ip := range p.FixedIPs {
+			subnetIDs = append(subnetIDs, ip.SubnetID)
+		}
+	}
+	return subnetIDs, nil
+}
+
+func isRouterInterface(deviceOwner string) bool {
+	return slices.Contains(routerInterfaceOwners, deviceOwner)
+}
@@ -0,0 +1,89 @@
+/*
+Package topology reconciles networks, subnets, security groups, routers and
+floating IPs of the OpenStack Networking service with a declarative
+topology.
+
+The resources of a topology are identified by a tag. Diff lists the existing
+resources carrying the tag, matches them to the topology by name and returns
+the plan of creations, updates and deletions bringing them to the topology.
+Security group rules have no name: they are matched by their attributes,
+normalized like rules.Diff does.
+Apply carries out a plan in dependency order, and reverts the changes
+already made if a creation or an update fails.
+
+Example to Reconcile a Topology
+
+	enableSNAT := true
+	desired := topology.Topology{
+		Tag: "web-stack",
+		Networks: []topology.Network{
+			{
+				Name: "web",
+				Subnets: []topology.Subnet{
+					{
+						Name:           "web-v4",
+						CIDR:           "10.0.0.0/24",
+						DNSNameservers: []string{"8.8.8.8"},
+					},
+				},
+			},
+		},
+		SecurityGroups: []topology.SecurityGroup{
+			{
+				Name: "web",
+				Rules: []topology.SecurityGroupRule{
+					{
+						Direction:      rules.DirIngress,
+						Protocol:       rules.ProtocolTCP,
+						PortRangeMin:   443,
+						PortRangeMax:   443,
+						RemoteIPPrefix: "0.0.0.0/0",
+					},
+					{
+						Direction: rules.DirEgress,
+					},
+				},
+			},
+		},
+		Routers: []topology.Router{
+			{
+				Name:              "web",
+				ExternalNetworkID: "8ca37218-28ff-41cb-9b10-039601ea7e6b",
+				EnableSNAT:        &enableSNAT,
+				Interfaces: []topology.SubnetRef{
+					{Network: "web", Subnet: "web-v4"},
+				},
+			},
+		},
+	}
+
+	plan, err := topology.Reconcile(context.TODO(), networkClient, desired)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, action := range plan.Actions {
+		fmt.Println(action)
+	}
+
+Example to Review a Plan Before Applying It
+
+	plan, err := topology.Diff(context.TODO(), networkClient, desired)
+	if err != nil {
+		panic(err)
+	}
+
+	if plan.Empty() {
+		return
+	}
+
+	for _, action := range plan.Actions {
+		fmt.Println(action)
+	}
+
+	err = topology.Apply(context.TODO(), networkClient, plan)
+	if err != nil {
+		panic(err)
+	}
+*/
+package topology
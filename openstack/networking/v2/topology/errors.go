@@ -0,0 +1,69 @@
+package topology
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// ErrDuplicateName is the error when two resources of a topology, or two
+// existing resources carrying its tag, share the same name.
+type ErrDuplicateName struct {
+	gophercloud.BaseError
+	Resource ResourceType
+	Name     string
+}
+
+func (e ErrDuplicateName) Error() string {
+	return fmt.Sprintf("duplicate %s name %q", e.Resource, e.Name)
+}
+
+// ErrUnknownReference is the error when a resource of a topology refers to a
+// subnet or security group that is not part of it.
+type ErrUnknownReference struct {
+	gophercloud.BaseError
+	Resource  ResourceType
+	Name      string
+	Reference string
+}
+
+func (e ErrUnknownReference) Error() string {
+	return fmt.Sprintf("%s %q refers to unknown %q", e.Resource, e.Name, e.Reference)
+}
+
+// ErrImmutableChange is the error when a topology changes an attribute of an
+// existing resource that Neutron cannot update.
+type ErrImmutableChange struct {
+	gophercloud.BaseError
+	Resource ResourceType
+	Name     string
+	Field    string
+	Existing string
+	Desired  string
+}
+
+func (e ErrImmutableChange) Error() string {
+	return fmt.Sprintf("cannot change %s of %s %q from %q to %q", e.Field, e.Resource, e.Name, e.Existing, e.Desired)
+}
+
+// ErrApplyFailed is the error when an action of a plan fails. RollbackErrors
+// holds the errors met while undoing the actions applied before it.
+type ErrApplyFailed struct {
+	gophercloud.BaseError
+	Action         Action
+	Err            error
+	RollbackErrors []error
+}
+
+func (e ErrApplyFailed) Error() string {
+	msg := fmt.Sprintf("failed to %s: %s", e.Action, e.Err)
+	if len(e.RollbackErrors) > 0 {
+		msg += fmt.Sprintf(" (rollback failed: %s)", errors.Join(e.RollbackErrors...))
+	}
+	return msg
+}
+
+func (e ErrApplyFailed) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,317 @@
+package topology
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+)
+
+// Topology is the desired state of a set of networking resources.
+//
+// Resources are identified by their name within the topology. Optional
+// attributes left to their zero value are not managed: Neutron picks their
+// value at creation and existing values are kept.
+type Topology struct {
+	// Tag identifies the resources managed by the topology. It is added to
+	// every resource Apply creates, and the existing resources carrying it
+	// that are no longer part of the topology are deleted.
+	Tag string
+
+	Networks       []Network
+	SecurityGroups []SecurityGroup
+	Routers        []Router
+	FloatingIPs    []FloatingIP
+}
+
+// Network is the desired state of a network and its subnets.
+type Network struct {
+	// Name is the name of the network. It is required.
+	Name string
+
+	Description  string
+	AdminStateUp *bool
+
+	Subnets []Subnet
+}
+
+// Subnet is the desired state of a subnet.
+type Subnet struct {
+	// Name is the name of the subnet, unique within its network. It is
+	// required.
+	Name string
+
+	// CIDR is the address range of the subnet. It is required and cannot be
+	// changed once the subnet exists.
+	CIDR string
+
+	// IPVersion defaults to gophercloud.IPv4.
+	IPVersion gophercloud.IPVersion
+
+	Description     string
+	GatewayIP       *string
+	EnableDHCP      *bool
+	DNSNameservers  []string
+	AllocationPools []subnets.AllocationPool
+}
+
+// SecurityGroup is the desired state of a security group.
+//
+// The rules of a managed group are exactly those of its Rules: the egress
+// rules Neutron adds to new groups are removed, and so are existing rules
+// missing from Rules.
+type SecurityGroup struct {
+	// Name is the name of the group. It is required.
+	Name string
+
+	Description string
+	Rules       []SecurityGroupRule
+}
+
+// SecurityGroupRule is the desired state of a security group rule.
+type SecurityGroupRule struct {
+	// Direction is required.
+	Direction rules.RuleDirection
+
+	// EtherType defaults to rules.EtherType4.
+	EtherType rules.RuleEtherType
+
+	Protocol       rules.RuleProtocol
+	PortRangeMin   int
+	PortRangeMax   int
+	RemoteIPPrefix string
+
+	// RemoteGroup is the name of a security group of the topology whose
+	// members are allowed.
+	RemoteGroup string
+}
+
+// Router is the desired state of a router, its gateway and its interfaces.
+type Router struct {
+	// Name is the name of the router. It is required.
+	Name string
+
+	Description  string
+	AdminStateUp *bool
+
+	// ExternalNetworkID is the network of the router gateway. The gateway
+	// is removed when it is empty.
+	ExternalNetworkID string
+	EnableSNAT        *bool
+
+	// Interfaces are the subnets of the topology the router is attached to.
+	// Interfaces to other subnets are removed.
+	Interfaces []SubnetRef
+}
+
+// SubnetRef refers to a subnet of a topology.
+type SubnetRef struct {
+	Network string
+	Subnet  string
+}
+
+func (r SubnetRef) String() string {
+	return r.Network + "/" + r.Subnet
+}
+
+// FloatingIP is the desired state of a floating IP.
+//
+// Floating IPs have no name in Neutron. Name is stored as their description.
+type FloatingIP struct {
+	// Name is the name of the floating IP. It is required.
+	Name string
+
+	// FloatingNetworkID is the external network the address is allocated
+	// from. It is required and cannot be changed once the floating IP
+	// exists.
+	FloatingNetworkID string
+
+	// PortID is the port the floating IP is associated with. Unlike other
+	// optional attributes it is always managed: the floating IP is
+	// disassociated when it is empty.
+	PortID string
+}
+
+// Diff discovers the resources carrying the tag of the topology and returns
+// the plan bringing them to the topology. Existing resources are matched to
+// the topology by name. Nothing is changed until the plan is applied.
+func Diff(ctx context.Context, client *gophercloud.ServiceClient, topology Topology) (*Plan, error) {
+	if err := validate(topology); err != nil {
+		return nil, err
+	}
+
+	e, err := discover(ctx, client, topology.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return diff(topology, e)
+}
+
+// Apply carries out the actions of a plan in order.
+//
+// Creations and updates are made first, in dependency order. If one of them
+// fails, the actions already applied are reverted in reverse order and an
+// ErrApplyFailed is returned. Deletions are made last, once the topology is
+// in place; a failed deletion stops Apply without rollback, as deleted
+// resources cannot be restored.
+func Apply(ctx context.Context, client *gophercloud.ServiceClient, plan *Plan) error {
+	var undos []undo
+	for _, action := range plan.Actions {
+		u, err := action.run(ctx, client, plan.state)
+		if u != nil {
+			undos = append(undos, u)
+		}
+		if err != nil {
+			applyErr := ErrApplyFailed{Action: action, Err: err}
+			if action.Operation != OperationDelete {
+				for i := len(undos) - 1; i >= 0; i-- {
+					if err := undos[i](ctx, client); err != nil {
+						applyErr.RollbackErrors = append(applyErr.RollbackErrors, err)
+					}
+				}
+			}
+			return applyErr
+		}
+	}
+	return nil
+}
+
+// Reconcile brings the resources carrying the tag of the topology to the
+// topology. It is a shortcut for Diff followed by Apply, and returns the
+// applied plan.
+func Reconcile(ctx context.Context, client *gophercloud.ServiceClient, topology Topology) (*Plan, error) {
+	plan, err := Diff(ctx, client, topology)
+	if err != nil {
+		return nil, err
+	}
+	return plan, Apply(ctx, client, plan)
+}
+
+func validate(t Topology) error {
+	if t.Tag == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "topology.Topology.Tag"
+		return err
+	}
+
+	subnetNames := make(map[string]bool)
+	networkNames := make(map[string]bool)
+	for _, n := range t.Networks {
+		if n.Name == "" {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = "topology.Network.Name"
+			return err
+		}
+		if networkNames[n.Name] {
+			return ErrDuplicateName{Resource: ResourceNetwork, Name: n.Name}
+		}
+		networkNames[n.Name] = true
+
+		for _, s := range n.Subnets {
+			ref := SubnetRef{Network: n.Name, Subnet: s.Name}.String()
+			if s.Name == "" {
+				err := gophercloud.ErrMissingInput{}
+				err.Argument = "topology.Subnet.Name"
+				return err
+			}
+			if s.CIDR == "" {
+				err := gophercloud.ErrMissingInput{}
+				err.Argument = "topology.Subnet.CIDR"
+				return err
+			}
+			if subnetNames[ref] {
+				return ErrDuplicateName{Resource: ResourceSubnet, Name: ref}
+			}
+			subnetNames[ref] = true
+		}
+	}
+
+	groupNames := make(map[string]bool)
+	for _, g := range t.SecurityGroups {
+		if g.Name == "" {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = "topology.SecurityGroup.Name"
+			return err
+		}
+		if groupNames[g.Name] {
+			return ErrDuplicateName{Resource: ResourceSecurityGroup, Name: g.Name}
+		}
+		groupNames[g.Name] = true
+	}
+	for _, g := range t.SecurityGroups {
+		for _, r := range g.Rules {
+			if r.Direction == "" {
+				err := gophercloud.ErrMissingInput{}
+				err.Argument = "topology.SecurityGroupRule.Direction"
+				return err
+			}
+			if r.RemoteGroup != "" && !groupNames[r.RemoteGroup] {
+				return ErrUnknownReference{Resource: ResourceSecurityGroup, Name: g.Name, Reference: r.RemoteGroup}
+			}
+		}
+	}
+
+	routerNames := make(map[string]bool)
+	for _, r := range t.Routers {
+		if r.Name == "" {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = "topology.Router.Name"
+			return err
+		}
+		if routerNames[r.Name] {
+			return ErrDuplicateName{Resource: ResourceRouter, Name: r.Name}
+		}
+		routerNames[r.Name] = true
+
+		for _, i := range r.Interfaces {
+			if !subnetNames[i.String()] {
+				return ErrUnknownReference{Resource: ResourceRouter, Name: r.Name, Reference: i.String()}
+			}
+		}
+	}
+
+	fipNames := make(map[string]bool)
+	for _, f := range t.FloatingIPs {
+		if f.Name == "" {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = "topology.FloatingIP.Name"
+			return err
+		}
+		if f.FloatingNetworkID == "" {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = "topology.FloatingIP.FloatingNetworkID"
+			return err
+		}
+		if fipNames[f.Name] {
+			return ErrDuplicateName{Resource: ResourceFloatingIP, Name: f.Name}
+		}
+		fipNames[f.Name] = true
+	}
+
+	return nil
+}
+
+// ruleName describes a security group rule, such as
+// "ingress IPv4 tcp 22-22 from 0.0.0.0/0".
+func ruleName(direction, etherType, protocol string, min, max int, remoteIPPrefix, remoteGroup string) string {
+	parts := []string{direction, etherType}
+	if protocol == "" {
+		parts = append(parts, "any")
+	} else {
+		parts = append(parts, protocol)
+	}
+	if min != 0 || max != 0 {
+		parts = append(parts, strconv.Itoa(min)+"-"+strconv.Itoa(max))
+	}
+	switch {
+	case remoteGroup != "":
+		parts = append(parts, "from group", remoteGroup)
+	case remoteIPPrefix != "":
+		parts = append(parts, "from", remoteIPPrefix)
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,73 @@
+package topology
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// Operation is the kind of change an action makes.
+type Operation string
+
+const (
+	OperationCreate Operation = "create"
+	OperationUpdate Operation = "update"
+	OperationDelete Operation = "delete"
+)
+
+// ResourceType is the type of resource an action changes.
+type ResourceType string
+
+const (
+	ResourceNetwork           ResourceType = "network"
+	ResourceSubnet            ResourceType = "subnet"
+	ResourceSecurityGroup     ResourceType = "security group"
+	ResourceSecurityGroupRule ResourceType = "security group rule"
+	ResourceRouter            ResourceType = "router"
+	ResourceRouterInterface   ResourceType = "router interface"
+	ResourceFloatingIP        ResourceType = "floating IP"
+)
+
+// Action is a single change of a plan.
+type Action struct {
+	// Operation is the kind of change.
+	Operation Operation
+
+	// Resource is the type of the changed resource.
+	Resource ResourceType
+
+	// Name identifies the resource in the topology. Subnets are named
+	// "<network>/<subnet>", router interfaces "<router>:<network>/<subnet>"
+	// and security group rules "<group>:<rule>".
+	Name string
+
+	// ID is the ID of the existing resource. It is empty for creations.
+	ID string
+
+	run func(ctx context.Context, client *gophercloud.ServiceClient, s *state) (undo, error)
+}
+
+// undo reverts an applied action.
+type undo func(ctx context.Context, client *gophercloud.ServiceClient) error
+
+func (a Action) String() string {
+	if a.ID == "" {
+		return fmt.Sprintf("%s %s %s", a.Operation, a.Resource, a.Name)
+	}
+	return fmt.Sprintf("%s %s %s (%s)", a.Operation, a.Resource, a.Name, a.ID)
+}
+
+// Plan is the ordered list of changes bringing existing resources to a
+// topology. It is returned by Diff and carried out by Apply.
+type Plan struct {
+	// Actions are the changes, in the order Apply makes them.
+	Actions []Action
+
+	state *state
+}
+
+// Empty reports whether the existing resources already match the topology.
+func (p *Plan) Empty() bool {
+	return len(p.Actions) == 0
+}
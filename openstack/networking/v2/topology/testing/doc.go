@@ -0,0 +1,2 @@
+// topology unit tests
+package testing
@@ -0,0 +1,332 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/topology"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+const Tag = "web-stack"
+
+// EmptyResponses are the listings of a project without tagged resources.
+var EmptyResponses = map[string]string{
+	"networks":        `{"networks": []}`,
+	"subnets":         `{"subnets": []}`,
+	"security-groups": `{"security_groups": []}`,
+	"routers":         `{"routers": []}`,
+	"floatingips":     `{"floatingips": []}`,
+}
+
+// ExistingResponses are the listings of a project with tagged resources.
+var ExistingResponses = map[string]string{
+	"networks": `
+{
+    "networks": [
+        {
+            "id": "net-1",
+            "name": "web",
+            "description": "old",
+            "admin_state_up": true,
+            "tags": ["web-stack"]
+        }
+    ]
+}
+`,
+	"subnets": `
+{
+    "subnets": [
+        {
+            "id": "sub-1",
+            "network_id": "net-1",
+            "name": "web-v4",
+            "ip_version": 4,
+            "cidr": "10.0.0.0/24",
+            "gateway_ip": "10.0.0.1",
+            "enable_dhcp": true,
+            "dns_nameservers": [],
+            "tags": ["web-stack"]
+        },
+        {
+            "id": "sub-2",
+            "network_id": "net-1",
+            "name": "old",
+            "ip_version": 4,
+            "cidr": "10.0.1.0/24",
+            "gateway_ip": "10.0.1.1",
+            "enable_dhcp": true,
+            "dns_nameservers": [],
+            "tags": ["web-stack"]
+        }
+    ]
+}
+`,
+	"security-groups": `
+{
+    "security_groups": [
+        {
+            "id": "sg-1",
+            "name": "web",
+            "description": "",
+            "security_group_rules": [
+                {
+                    "id": "rule-1",
+                    "direction": "ingress",
+                    "ethertype": "IPv4",
+                    "protocol": "tcp",
+                    "port_range_min": 443,
+                    "port_range_max": 443,
+                    "remote_ip_prefix": "0.0.0.0/0",
+                    "remote_group_id": null,
+                    "security_group_id": "sg-1"
+                },
+                {
+                    "id": "rule-2",
+                    "direction": "ingress",
+                    "ethertype": "IPv4",
+                    "protocol": "tcp",
+                    "port_range_min": 80,
+                    "port_range_max": 80,
+                    "remote_ip_prefix": "0.0.0.0/0",
+                    "remote_group_id": null,
+                    "security_group_id": "sg-1"
+                }
+            ],
+            "tags": ["web-stack"]
+        },
+        {
+            "id": "sg-2",
+            "name": "legacy",
+            "description": "",
+            "security_group_rules": [],
+            "tags": ["web-stack"]
+        }
+    ]
+}
+`,
+	"routers": `
+{
+    "routers": [
+        {
+            "id": "router-1",
+            "name": "web",
+            "description": "",
+            "admin_state_up": true,
+            "external_gateway_info": {
+                "network_id": "ext-net",
+                "enable_snat": true
+            },
+            "tags": ["web-stack"]
+        }
+    ]
+}
+`,
+	"floatingips": `
+{
+    "floatingips": [
+        {
+            "id": "fip-1",
+            "description": "web",
+            "floating_network_id": "ext-net",
+            "floating_ip_address": "172.24.4.10",
+            "port_id": "port-1",
+            "tags": ["web-stack"]
+        }
+    ]
+}
+`,
+}
+
+// RouterPortsResponse lists the interfaces of router-1.
+const RouterPortsResponse = `
+{
+    "ports": [
+        {
+            "id": "port-2",
+            "device_id": "router-1",
+            "device_owner": "network:router_interface",
+            "fixed_ips": [
+                {
+                    "subnet_id": "sub-1",
+                    "ip_address": "10.0.0.1"
+                }
+            ]
+        },
+        {
+            "id": "port-3",
+            "device_id": "router-1",
+            "device_owner": "network:router_interface",
+            "fixed_ips": [
+                {
+                    "subnet_id": "sub-2",
+                    "ip_address": "10.0.1.1"
+                }
+            ]
+        },
+        {
+            "id": "port-4",
+            "device_id": "router-1",
+            "device_owner": "network:router_gateway",
+            "fixed_ips": [
+                {
+                    "subnet_id": "ext-subnet",
+                    "ip_address": "172.24.4.2"
+                }
+            ]
+        }
+    ]
+}
+`
+
+// CreateNetworkResponse is the network created by Apply.
+const CreateNetworkResponse = `
+{
+    "network": {
+        "id": "net-1",
+        "name": "web",
+        "admin_state_up": true
+    }
+}
+`
+
+// CreateSecurityGroupResponse is the group created by Apply, with the
+// rules Neutron adds to new groups.
+const CreateSecurityGroupResponse = `
+{
+    "security_group": {
+        "id": "sg-1",
+        "name": "web",
+        "description": "",
+        "security_group_rules": [
+            {
+                "id": "default-1",
+                "direction": "egress",
+                "ethertype": "IPv4",
+                "security_group_id": "sg-1"
+            },
+            {
+                "id": "default-2",
+                "direction": "egress",
+                "ethertype": "IPv6",
+                "security_group_id": "sg-1"
+            }
+        ]
+    }
+}
+`
+
+// CreateRuleRequest is the rule created by Apply.
+const CreateRuleRequest = `
+{
+    "security_group_rule": {
+        "direction": "ingress",
+        "ethertype": "IPv4",
+        "security_group_id": "sg-1",
+        "port_range_min": 443,
+        "port_range_max": 443,
+        "protocol": "tcp",
+        "remote_ip_prefix": "0.0.0.0/0"
+    }
+}
+`
+
+const CreateRuleResponse = `
+{
+    "security_group_rule": {
+        "id": "rule-1",
+        "direction": "ingress",
+        "ethertype": "IPv4",
+        "security_group_id": "sg-1",
+        "port_range_min": 443,
+        "port_range_max": 443,
+        "protocol": "tcp",
+        "remote_ip_prefix": "0.0.0.0/0"
+    }
+}
+`
+
+// Existing is the topology matching ExistingResponses.
+var Existing = topology.Topology{
+	Tag: Tag,
+	Networks: []topology.Network{
+		{
+			Name: "web",
+			Subnets: []topology.Subnet{
+				{Name: "web-v4", CIDR: "10.0.0.0/24"},
+				{Name: "old", CIDR: "10.0.1.0/24"},
+			},
+		},
+	},
+	SecurityGroups: []topology.SecurityGroup{
+		{
+			Name: "web",
+			Rules: []topology.SecurityGroupRule{
+				HTTPSRule,
+				{
+					Direction:      rules.DirIngress,
+					Protocol:       rules.ProtocolTCP,
+					PortRangeMin:   80,
+					PortRangeMax:   80,
+					RemoteIPPrefix: "0.0.0.0/0",
+				},
+			},
+		},
+		{Name: "legacy"},
+	},
+	Routers: []topology.Router{
+		{
+			Name:              "web",
+			ExternalNetworkID: "ext-net",
+			Interfaces: []topology.SubnetRef{
+				{Network: "web", Subnet: "web-v4"},
+				{Network: "web", Subnet: "old"},
+			},
+		},
+	},
+	FloatingIPs: []topology.FloatingIP{
+		{Name: "web", FloatingNetworkID: "ext-net", PortID: "port-1"},
+	},
+}
+
+var HTTPSRule = topology.SecurityGroupRule{
+	Direction:      rules.DirIngress,
+	Protocol:       rules.ProtocolTCP,
+	PortRangeMin:   443,
+	PortRangeMax:   443,
+	RemoteIPPrefix: "0.0.0.0/0",
+}
+
+// HandleListSuccessfully serves the listings of the tagged resources and of
+// the ports of router-1.
+func HandleListSuccessfully(t *testing.T, fakeServer th.FakeServer, responses map[string]string) {
+	for resource, response := range responses {
+		fakeServer.Mux.HandleFunc("/v2.0/"+resource, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "GET" {
+				t.Errorf("Unexpected %s %s", r.Method, r.URL.Path)
+				return
+			}
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+			th.TestFormValues(t, r, map[string]string{"tags": Tag})
+
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+
+			fmt.Fprint(w, response)
+		})
+	}
+
+	fakeServer.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"device_id": "router-1"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, RouterPortsResponse)
+	})
+}
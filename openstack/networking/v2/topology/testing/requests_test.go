@@ -0,0 +1,376 @@
+package testing
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"testing"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/topology"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+)
+
+func actions(plan *topology.Plan) []string {
+	var s []string
+	for _, action := range plan.Actions {
+		s = append(s, action.String())
+	}
+	return s
+}
+
+// without returns the listings of responses except those of resources.
+func without(responses map[string]string, resources ...string) map[string]string {
+	m := maps.Clone(responses)
+	for _, resource := range resources {
+		delete(m, resource)
+	}
+	return m
+}
+
+func TestDiffEmptyProject(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	HandleListSuccessfully(t, fakeServer, EmptyResponses)
+
+	plan, err := topology.Diff(context.TODO(), fake.ServiceClient(fakeServer), Existing)
+	th.AssertNoErr(t, err)
+
+	expected := []string{
+		"create network web",
+		"create subnet web/web-v4",
+		"create subnet web/old",
+		"create security group web",
+		"create security group legacy",
+		"create security group rule web:ingress IPv4 tcp 443-443 from 0.0.0.0/0",
+		"create security group rule web:ingress IPv4 tcp 80-80 from 0.0.0.0/0",
+		"create router web",
+		"create router interface web:web/web-v4",
+		"create router interface web:web/old",
+		"create floating IP web",
+	}
+	th.CheckDeepEquals(t, expected, actions(plan))
+}
+
+func TestDiffNoChanges(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	HandleListSuccessfully(t, fakeServer, ExistingResponses)
+
+	plan, err := topology.Diff(context.TODO(), fake.ServiceClient(fakeServer), Existing)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string(nil), actions(plan))
+	th.AssertEquals(t, true, plan.Empty())
+}
+
+func TestDiffEquivalentRules(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	HandleListSuccessfully(t, fakeServer, ExistingResponses)
+
+	// The existing rules are spelled "tcp" and "0.0.0.0/0".
+	desired := Existing
+	desired.SecurityGroups = []topology.SecurityGroup{
+		{
+			Name: "web",
+			Rules: []topology.SecurityGroupRule{
+				{
+					Direction:    rules.DirIngress,
+					Protocol:     "6",
+					PortRangeMin: 443,
+					PortRangeMax: 443,
+				},
+				{
+					Direction:      rules.DirIngress,
+					Protocol:       "TCP",
+					PortRangeMin:   80,
+					PortRangeMax:   80,
+					RemoteIPPrefix: "0.0.0.0/0",
+				},
+			},
+		},
+		{Name: "legacy"},
+	}
+
+	plan, err := topology.Diff(context.TODO(), fake.ServiceClient(fakeServer), desired)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string(nil), actions(plan))
+}
+
+func TestDiffUpdatesAndDeletions(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	HandleListSuccessfully(t, fakeServer, ExistingResponses)
+
+	desired := topology.Topology{
+		Tag: Tag,
+		Networks: []topology.Network{
+			{
+				Name:        "web",
+				Description: "new",
+				Subnets: []topology.Subnet{
+					{Name: "web-v4", CIDR: "10.0.0.0/24"},
+				},
+			},
+		},
+		SecurityGroups: []topology.SecurityGroup{
+			{
+				Name: "web",
+				Rules: []topology.SecurityGroupRule{
+					HTTPSRule,
+					{
+						Direction:      rules.DirIngress,
+						Protocol:       rules.ProtocolTCP,
+						PortRangeMin:   22,
+						PortRangeMax:   22,
+						RemoteIPPrefix: "0.0.0.0/0",
+					},
+				},
+			},
+		},
+		Routers: []topology.Router{
+			{
+				Name:              "web",
+				ExternalNetworkID: "ext-net",
+				Interfaces: []topology.SubnetRef{
+					{Network: "web", Subnet: "web-v4"},
+				},
+			},
+		},
+		FloatingIPs: []topology.FloatingIP{
+			{Name: "web", FloatingNetworkID: "ext-net"},
+		},
+	}
+
+	plan, err := topology.Diff(context.TODO(), fake.ServiceClient(fakeServer), desired)
+	th.AssertNoErr(t, err)
+
+	expected := []string{
+		"update network web (net-1)",
+		"create security group rule web:ingress IPv4 tcp 22-22 from 0.0.0.0/0",
+		"update floating IP web (fip-1)",
+		"delete router interface web:web/old (sub-2)",
+		"delete security group rule web:ingress IPv4 tcp 80-80 from 0.0.0.0/0 (rule-2)",
+		"delete security group legacy (sg-2)",
+		"delete subnet web/old (sub-2)",
+	}
+	th.CheckDeepEquals(t, expected, actions(plan))
+}
+
+func TestDiffImmutableChange(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	HandleListSuccessfully(t, fakeServer, ExistingResponses)
+
+	desired := topology.Topology{
+		Tag: Tag,
+		Networks: []topology.Network{
+			{
+				Name: "web",
+				Subnets: []topology.Subnet{
+					{Name: "web-v4", CIDR: "10.0.2.0/24"},
+				},
+			},
+		},
+	}
+
+	_, err := topology.Diff(context.TODO(), fake.ServiceClient(fakeServer), desired)
+	var immutableErr topology.ErrImmutableChange
+	th.CheckErr(t, err, &immutableErr)
+	th.CheckEquals(t, "CIDR", immutableErr.Field)
+	th.CheckEquals(t, "10.0.0.0/24", immutableErr.Existing)
+}
+
+func TestDiffInvalidTopology(t *testing.T) {
+	testCases := map[string]topology.Topology{
+		"missing tag": {},
+		"duplicate network": {
+			Tag:      Tag,
+			Networks: []topology.Network{{Name: "web"}, {Name: "web"}},
+		},
+		"unknown remote group": {
+			Tag: Tag,
+			SecurityGroups: []topology.SecurityGroup{
+				{
+					Name: "web",
+					Rules: []topology.SecurityGroupRule{
+						{Direction: rules.DirIngress, RemoteGroup: "db"},
+					},
+				},
+			},
+		},
+		"unknown interface": {
+			Tag: Tag,
+			Routers: []topology.Router{
+				{
+					Name:       "web",
+					Interfaces: []topology.SubnetRef{{Network: "web", Subnet: "web-v4"}},
+				},
+			},
+		},
+		"missing floating network": {
+			Tag:         Tag,
+			FloatingIPs: []topology.FloatingIP{{Name: "web"}},
+		},
+	}
+
+	for name, desired := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// Invalid topologies are rejected before any request is made.
+			_, err := topology.Diff(context.TODO(), nil, desired)
+			th.AssertErr(t, err)
+		})
+	}
+}
+
+func TestReconcileSecurityGroup(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	HandleListSuccessfully(t, fakeServer, without(EmptyResponses, "security-groups"))
+
+	fakeServer.Mux.HandleFunc("/v2.0/security-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, EmptyResponses["security-groups"])
+		case "POST":
+			th.TestJSONRequest(t, r, `{"security_group": {"name": "web"}}`)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, CreateSecurityGroupResponse)
+		default:
+			t.Errorf("Unexpected %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	var deletedRules []string
+	fakeServer.Mux.HandleFunc("/v2.0/security-group-rules/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		deletedRules = append(deletedRules, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	tagged := false
+	fakeServer.Mux.HandleFunc("/v2.0/security-groups/sg-1/tags/web-stack", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		tagged = true
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	fakeServer.Mux.HandleFunc("/v2.0/security-group-rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateRuleRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, CreateRuleResponse)
+	})
+
+	desired := topology.Topology{
+		Tag: Tag,
+		SecurityGroups: []topology.SecurityGroup{
+			{Name: "web", Rules: []topology.SecurityGroupRule{HTTPSRule}},
+		},
+	}
+
+	plan, err := topology.Reconcile(context.TODO(), fake.ServiceClient(fakeServer), desired)
+	th.AssertNoErr(t, err)
+
+	expected := []string{
+		"create security group web",
+		"create security group rule web:ingress IPv4 tcp 443-443 from 0.0.0.0/0",
+	}
+	th.CheckDeepEquals(t, expected, actions(plan))
+	th.CheckDeepEquals(t, []string{
+		"/v2.0/security-group-rules/default-1",
+		"/v2.0/security-group-rules/default-2",
+	}, deletedRules)
+	th.CheckEquals(t, true, tagged)
+}
+
+func TestApplyRollback(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	HandleListSuccessfully(t, fakeServer, without(EmptyResponses, "networks", "subnets"))
+
+	fakeServer.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, EmptyResponses["networks"])
+		case "POST":
+			th.TestJSONRequest(t, r, `{"network": {"name": "web"}}`)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, CreateNetworkResponse)
+		default:
+			t.Errorf("Unexpected %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	fakeServer.Mux.HandleFunc("/v2.0/networks/net-1/tags/web-stack", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	fakeServer.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, EmptyResponses["subnets"])
+		case "POST":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			t.Errorf("Unexpected %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	deleted := false
+	fakeServer.Mux.HandleFunc("/v2.0/networks/net-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		deleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	desired := topology.Topology{
+		Tag: Tag,
+		Networks: []topology.Network{
+			{
+				Name: "web",
+				Subnets: []topology.Subnet{
+					{Name: "web-v4", CIDR: "10.0.0.0/24"},
+				},
+			},
+		},
+	}
+
+	_, err := topology.Reconcile(context.TODO(), fake.ServiceClient(fakeServer), desired)
+	var applyErr topology.ErrApplyFailed
+	th.CheckErr(t, err, &applyErr)
+	th.CheckEquals(t, topology.ResourceSubnet, applyErr.Action.Resource)
+	th.CheckEquals(t, "web/web-v4", applyErr.Action.Name)
+	th.CheckEquals(t, 0, len(applyErr.RollbackErrors))
+	th.CheckEquals(t, true, deleted)
+}
@@ -0,0 +1,56 @@
+/*
+Package dirsync syncs local directories with OpenStack Object Storage
+containers.
+
+Upload makes a container, or the objects under a prefix, mirror a local
+directory, and Download does the opposite. Both sides are listed and
+compared: entries are considered identical when they have the same size and
+the source was not modified after the destination or, with
+SyncOpts.Checksum, when the MD5 checksum of the file matches the ETag of the
+object. Only the entries that differ are transferred, concurrently. Files
+larger than SyncOpts.SegmentSize are uploaded as static large objects, and
+downloaded objects are checked against their ETag before replacing local
+files. With SyncOpts.Delete, the entries that only exist at the destination
+are deleted, along with their segments for large objects.
+
+Dynamic large objects are listed with a size of 0, so they are always
+considered different from the local files.
+
+Example to upload a directory to a pseudo-directory of a container
+
+	syncOpts := dirsync.SyncOpts{
+		Prefix:      "builds/1.2.0/",
+		Delete:      true,
+		Concurrency: 8,
+	}
+
+	summary, err := dirsync.Upload(context.TODO(), objectStorageClient, "dist", "artifacts", syncOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Uploaded %d files (%d bytes), deleted %d objects, %d unchanged\n",
+		len(summary.Transferred()), summary.Bytes(), len(summary.Deleted()), len(summary.Unchanged()))
+
+	for _, entry := range summary.Failed() {
+		fmt.Printf("Failed to %s %s: %s\n", entry.Operation, entry.Path, entry.Err)
+	}
+
+Example to preview the download of a container
+
+	syncOpts := dirsync.SyncOpts{
+		Checksum: true,
+		Delete:   true,
+		DryRun:   true,
+	}
+
+	summary, err := dirsync.Download(context.TODO(), objectStorageClient, "artifacts", "mirror", syncOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, entry := range summary.Entries {
+		fmt.Printf("%s %s\n", entry.Operation, entry.Path)
+	}
+*/
+package dirsync
@@ -0,0 +1,33 @@
+package dirsync
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// ErrChecksumMismatch is the error when the content of a downloaded object
+// does not match its ETag.
+type ErrChecksumMismatch struct {
+	gophercloud.BaseError
+	Name     string
+	Expected string
+	Actual   string
+}
+
+func (e ErrChecksumMismatch) Error() string {
+	return fmt.Sprintf("object %s was downloaded with MD5 checksum %q, expected %q", e.Name, e.Actual, e.Expected)
+}
+
+// ErrSizeMismatch is the error when a downloaded large object, whose ETag is
+// not a checksum of its content, does not have the expected size.
+type ErrSizeMismatch struct {
+	gophercloud.BaseError
+	Name     string
+	Expected int64
+	Actual   int64
+}
+
+func (e ErrSizeMismatch) Error() string {
+	return fmt.Sprintf("object %s was downloaded with %d bytes, expected %d", e.Name, e.Actual, e.Expected)
+}
@@ -0,0 +1,120 @@
+package dirsync
+
+import (
+	"cmp"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/objects"
+)
+
+// file is a local file or an object, as found when listing one side of a
+// sync.
+type file struct {
+	size    int64
+	modTime time.Time
+
+	// hash is the ETag of an object. It is empty for local files.
+	hash string
+}
+
+// listLocal returns the regular files found under root, keyed by their
+// slash-separated path relative to root.
+func listLocal(root string) (map[string]file, error) {
+	files := make(map[string]file)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		files[filepath.ToSlash(rel)] = file{
+			size:    info.Size(),
+			modTime: info.ModTime(),
+		}
+		return nil
+	})
+
+	return files, err
+}
+
+// listRemote returns the objects of a container whose names start with
+// prefix, keyed by their name without prefix. Pseudo-directory markers are
+// left out. Static large objects are listed with the checksum of their
+// manifest, so their ETag is taken from slo_etag instead.
+func listRemote(ctx context.Context, client *gophercloud.ServiceClient, containerName, prefix string) (map[string]file, error) {
+	allPages, err := objects.List(client, containerName, objects.ListOpts{Prefix: prefix}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allObjects, err := objects.ExtractInfo(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]file, len(allObjects))
+	for _, object := range allObjects {
+		path := strings.TrimPrefix(object.Name, prefix)
+		if object.Subdir != "" || path == "" || strings.HasSuffix(path, "/") {
+			continue
+		}
+
+		files[path] = file{
+			size:    object.Bytes,
+			modTime: object.LastModified,
+			hash:    cmp.Or(object.SLOETag, object.Hash),
+		}
+	}
+
+	return files, nil
+}
+
+// checksums returns the MD5 checksum of a local file, and the ETag it gets
+// once uploaded as a static large object made of segments of segmentSize
+// bytes: the MD5 checksum of the concatenated checksums of its segments.
+func checksums(path string, segmentSize int64) (string, string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", "", err
+	}
+	defer f.Close()
+
+	whole := md5.New()
+	segments := md5.New()
+	for {
+		segment := md5.New()
+		n, err := io.CopyN(io.MultiWriter(whole, segment), f, segmentSize)
+		if n > 0 {
+			segments.Write([]byte(hex.EncodeToString(segment.Sum(nil))))
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	return hex.EncodeToString(whole.Sum(nil)), hex.EncodeToString(segments.Sum(nil)), nil
+}
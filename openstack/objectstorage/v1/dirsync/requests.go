@@ -0,0 +1,412 @@
+package dirsync
+
+import (
+	"cmp"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/largeobjects"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/objects"
+)
+
+// SyncOpts specifies how a local directory and a container are synced.
+type SyncOpts struct {
+	// Prefix is prepended to the relative path of each file to form the
+	// name of its object, and only the objects starting with Prefix are
+	// considered. End it with a slash to sync with a pseudo-directory.
+	Prefix string
+
+	// Checksum compares the MD5 checksum of the files with the ETag of the
+	// objects when their sizes match. Otherwise, a file and an object of the
+	// same size are considered identical unless the source was modified
+	// after the destination.
+	Checksum bool
+
+	// Delete removes the entries of the destination that do not exist at
+	// the source.
+	Delete bool
+
+	// DryRun reports what would be done without transferring or deleting
+	// anything.
+	DryRun bool
+
+	// Concurrency is the maximum number of files transferred or deleted at
+	// the same time, and of segments uploaded at the same time for each
+	// large object. It defaults to 1.
+	Concurrency int
+
+	// SegmentSize is the size above which files are uploaded as static
+	// large objects, and the size of their segments. It defaults to
+	// largeobjects.DefaultSegmentSize.
+	SegmentSize int64
+
+	// OnEntry, if set, is called every time an entry has been processed.
+	// Calls are serialized.
+	OnEntry func(entry Entry)
+}
+
+// Upload syncs a container with a local directory: files that are missing
+// from the container or different are uploaded. The container is created if
+// needed. The returned error only reports failures to list either side; the
+// outcome of each file is recorded in the Summary.
+func Upload(ctx context.Context, client *gophercloud.ServiceClient, localDir, containerName string, opts SyncOpts) (*Summary, error) {
+	local, err := listLocal(localDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if !opts.DryRun {
+		if _, err := containers.Create(ctx, client, containerName, nil).Extract(); err != nil {
+			return nil, err
+		}
+	}
+
+	remote, err := listRemote(ctx, client, containerName, opts.Prefix)
+	if err != nil {
+		if !opts.DryRun || !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return nil, err
+		}
+		remote = make(map[string]file)
+	}
+
+	s := newSyncer(client, localDir, containerName, opts)
+	return s.run(ctx, local, remote, OperationUpload), nil
+}
+
+// Download syncs a local directory with a container: objects that are
+// missing from the directory or different are downloaded. The directory is
+// created if needed. The returned error only reports failures to list either
+// side; the outcome of each object is recorded in the Summary.
+func Download(ctx context.Context, client *gophercloud.ServiceClient, containerName, localDir string, opts SyncOpts) (*Summary, error) {
+	remote, err := listRemote(ctx, client, containerName, opts.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	local, err := listLocal(localDir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+		local = make(map[string]file)
+	}
+
+	s := newSyncer(client, localDir, containerName, opts)
+	return s.run(ctx, remote, local, OperationDownload), nil
+}
+
+// syncer holds the state shared by the goroutines of a sync.
+type syncer struct {
+	client      *gophercloud.ServiceClient
+	localDir    string
+	container   string
+	opts        SyncOpts
+	segmentSize int64
+
+	mu sync.Mutex
+}
+
+func newSyncer(client *gophercloud.ServiceClient, localDir, containerName string, opts SyncOpts) *syncer {
+	return &syncer{
+		client:      client,
+		localDir:    localDir,
+		container:   containerName,
+		opts:        opts,
+		segmentSize: cmp.Or(opts.SegmentSize, largeobjects.DefaultSegmentSize),
+	}
+}
+
+// run compares the source and destination listings and processes every path
+// found on either side with up to Concurrency goroutines.
+func (s *syncer) run(ctx context.Context, src, dst map[string]file, transfer Operation) *Summary {
+	paths := slices.Sorted(maps.Keys(src))
+	if s.opts.Delete {
+		for path := range dst {
+			if _, ok := src[path]; !ok {
+				paths = append(paths, path)
+			}
+		}
+		slices.Sort(paths)
+	}
+
+	concurrency := s.opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	entries := make([]Entry, len(paths))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, path := range paths {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			source, inSource := src[path]
+			destination, inDestination := dst[path]
+
+			var entry Entry
+			if inSource {
+				entry = s.syncEntry(ctx, path, source, destination, inDestination, transfer)
+			} else {
+				entry = s.deleteEntry(ctx, path, destination, transfer)
+			}
+			entries[i] = entry
+			s.done(entry)
+		}()
+	}
+	wg.Wait()
+
+	return &Summary{Entries: entries}
+}
+
+// done reports an entry to OnEntry.
+func (s *syncer) done(entry Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.opts.OnEntry != nil {
+		s.opts.OnEntry(entry)
+	}
+}
+
+// syncEntry transfers a source entry unless the destination is identical.
+func (s *syncer) syncEntry(ctx context.Context, path string, source, destination file, exists bool, transfer Operation) Entry {
+	entry := Entry{
+		Path:      path,
+		Operation: transfer,
+		Size:      source.size,
+	}
+
+	if exists {
+		changed, err := s.changed(path, source, destination, transfer)
+		if err != nil {
+			entry.Err = err
+			return entry
+		}
+		if !changed {
+			entry.Operation = OperationSkip
+			return entry
+		}
+	}
+
+	if s.opts.DryRun {
+		return entry
+	}
+
+	if err := ctx.Err(); err != nil {
+		entry.Err = err
+		return entry
+	}
+
+	if transfer == OperationUpload {
+		entry.Err = s.upload(ctx, path, source, exists)
+	} else {
+		entry.Err = s.download(ctx, path, source)
+	}
+	return entry
+}
+
+// deleteEntry deletes a destination entry that is missing from the source.
+func (s *syncer) deleteEntry(ctx context.Context, path string, destination file, transfer Operation) Entry {
+	entry := Entry{
+		Path:      path,
+		Operation: OperationDelete,
+		Size:      destination.size,
+	}
+
+	if s.opts.DryRun {
+		return entry
+	}
+
+	if err := ctx.Err(); err != nil {
+		entry.Err = err
+		return entry
+	}
+
+	if transfer == OperationUpload {
+		deleteOpts := largeobjects.DeleteOpts{
+			Concurrency: s.opts.Concurrency,
+		}
+		_, err := largeobjects.Delete(ctx, s.client, s.container, s.opts.Prefix+path, deleteOpts)
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			entry.Err = err
+		}
+	} else {
+		if err := os.Remove(s.localPath(path)); err != nil && !os.IsNotExist(err) {
+			entry.Err = err
+		}
+	}
+	return entry
+}
+
+// changed tells whether the destination of a path differs from its source.
+func (s *syncer) changed(path string, source, destination file, transfer Operation) (bool, error) {
+	if source.size != destination.size {
+		return true, nil
+	}
+
+	if s.opts.Checksum {
+		// largeobjects.Upload raises the segment size of large files to
+		// fit in a single manifest.
+		segmentSize := max(s.segmentSize, (source.size+largeobjects.DefaultMaxSegments-1)/largeobjects.DefaultMaxSegments)
+		whole, segmented, err := checksums(s.localPath(path), segmentSize)
+		if err != nil {
+			return false, err
+		}
+
+		// Only the object has an ETag.
+		hash := destination.hash
+		if transfer == OperationDownload {
+			hash = source.hash
+		}
+		return hash != whole && hash != segmented, nil
+	}
+
+	// Last-Modified headers and some filesystems have a precision of a
+	// second.
+	return source.modTime.Truncate(time.Second).After(destination.modTime.Truncate(time.Second)), nil
+}
+
+// localPath returns the path of the local file of a slash-separated path.
+func (s *syncer) localPath(path string) string {
+	return filepath.Join(s.localDir, filepath.FromSlash(path))
+}
+
+// upload uploads a local file, as a static large object if it is larger
+// than SegmentSize. When it replaces a large object, the segments that are
+// no longer used are deleted.
+func (s *syncer) upload(ctx context.Context, path string, source file, exists bool) error {
+	name := s.opts.Prefix + path
+
+	var previous *largeobjects.Manifest
+	if exists {
+		var err error
+		previous, err = largeobjects.GetManifest(ctx, s.client, s.container, name)
+		if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+			return err
+		}
+	}
+
+	f, err := os.Open(s.localPath(path))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var segments []largeobjects.Segment
+	if source.size > s.segmentSize {
+		uploadOpts := largeobjects.UploadOpts{
+			Content:     f,
+			Size:        source.size,
+			SegmentSize: s.segmentSize,
+			Concurrency: s.opts.Concurrency,
+		}
+		result, err := largeobjects.Upload(ctx, s.client, s.container, name, uploadOpts)
+		if err != nil {
+			return err
+		}
+		segments = result.Segments
+	} else {
+		createOpts := objects.CreateOpts{
+			Content: f,
+		}
+		if _, err := objects.Create(ctx, s.client, s.container, name, createOpts).Extract(); err != nil {
+			return err
+		}
+	}
+
+	if previous == nil {
+		return nil
+	}
+
+	var stale []largeobjects.Segment
+	for _, segment := range previous.Segments {
+		if !slices.ContainsFunc(segments, func(current largeobjects.Segment) bool {
+			return current.Container == segment.Container && current.Name == segment.Name
+		}) {
+			stale = append(stale, segment)
+		}
+	}
+
+	return largeobjects.DeleteSegments(ctx, s.client, stale, s.opts.Concurrency)
+}
+
+// download downloads an object to a temporary file, checks it, then moves it
+// to its local path and sets its modification time to the one of the object.
+func (s *syncer) download(ctx context.Context, path string, source file) error {
+	name := s.opts.Prefix + path
+
+	if !filepath.IsLocal(filepath.FromSlash(path)) {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "objectName"
+		err.Value = name
+		err.Info = "the object name does not map to a path inside the local directory"
+		return err
+	}
+
+	target := s.localPath(path)
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+
+	r := objects.Download(ctx, s.client, s.container, name, nil)
+	if r.Err != nil {
+		return r.Err
+	}
+	defer r.Body.Close()
+
+	header, err := r.Extract()
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), "."+filepath.Base(target)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := md5.New()
+	n, err := io.Copy(io.MultiWriter(tmp, hash), r.Body)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	// The ETag of a large object is not the checksum of its content.
+	if header.StaticLargeObject || header.ObjectManifest != "" {
+		if header.ContentLength > 0 && n != header.ContentLength {
+			return ErrSizeMismatch{Name: name, Expected: header.ContentLength, Actual: n}
+		}
+	} else if etag, sum := strings.Trim(header.ETag, `"`), hex.EncodeToString(hash.Sum(nil)); etag != sum {
+		return ErrChecksumMismatch{Name: name, Expected: etag, Actual: sum}
+	}
+
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return err
+	}
+
+	modTime := source.modTime
+	if modTime.IsZero() {
+		modTime = header.LastModified
+	}
+	return os.Chtimes(target, modTime, modTime)
+}
@@ -0,0 +1,92 @@
+package dirsync
+
+// Operation describes what a sync did with an entry.
+type Operation string
+
+const (
+	// OperationUpload means that the file was uploaded, because the object
+	// was missing or different.
+	OperationUpload Operation = "upload"
+
+	// OperationDownload means that the object was downloaded, because the
+	// file was missing or different.
+	OperationDownload Operation = "download"
+
+	// OperationDelete means that the entry only existed at the destination
+	// and was deleted.
+	OperationDelete Operation = "delete"
+
+	// OperationSkip means that the file and the object were identical.
+	OperationSkip Operation = "skip"
+)
+
+// Entry is the outcome of syncing a single file.
+type Entry struct {
+	// Path is the path of the file relative to the local directory, with
+	// slashes as separators. The name of the object is Path preceded by
+	// SyncOpts.Prefix.
+	Path string
+
+	// Operation is what the sync did, or would have done on a dry run.
+	Operation Operation
+
+	// Size is the size in bytes of the transferred or deleted entry.
+	Size int64
+
+	// Err is the reason of a failed operation.
+	Err error
+}
+
+// Summary is the outcome of an Upload or Download call.
+type Summary struct {
+	// Entries holds the outcome of every file found on either side, sorted
+	// by path.
+	Entries []Entry
+}
+
+// Transferred returns the entries that were uploaded or downloaded.
+func (s Summary) Transferred() []Entry {
+	return s.filter(func(entry Entry) bool {
+		return entry.Err == nil && (entry.Operation == OperationUpload || entry.Operation == OperationDownload)
+	})
+}
+
+// Deleted returns the entries that were deleted from the destination.
+func (s Summary) Deleted() []Entry {
+	return s.filter(func(entry Entry) bool {
+		return entry.Err == nil && entry.Operation == OperationDelete
+	})
+}
+
+// Unchanged returns the entries that were identical on both sides.
+func (s Summary) Unchanged() []Entry {
+	return s.filter(func(entry Entry) bool {
+		return entry.Err == nil && entry.Operation == OperationSkip
+	})
+}
+
+// Failed returns the entries whose operation failed.
+func (s Summary) Failed() []Entry {
+	return s.filter(func(entry Entry) bool {
+		return entry.Err != nil
+	})
+}
+
+// Bytes returns the number of bytes that were transferred.
+func (s Summary) Bytes() int64 {
+	var bytes int64
+	for _, entry := range s.Transferred() {
+		bytes += entry.Size
+	}
+	return bytes
+}
+
+func (s Summary) filter(keep func(Entry) bool) []Entry {
+	var entries []Entry
+	for _, entry := range s.Entries {
+		if keep(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
@@ -0,0 +1,2 @@
+// dirsync unit tests
+package testing
@@ -0,0 +1,296 @@
+package testing
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+var (
+	// oldTime is the modification time of local files that are older than
+	// the objects.
+	oldTime = time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	// uploadTime is the last modification time of the objects created by
+	// the fake server.
+	uploadTime = time.Date(2024, 6, 1, 12, 30, 15, 250000000, time.UTC)
+
+	// newTime is the modification time of local files that are newer than
+	// the objects.
+	newTime = time.Date(2024, 9, 1, 8, 0, 0, 0, time.UTC)
+)
+
+// storedObject is an object held by FakeSwift.
+type storedObject struct {
+	Content      []byte
+	Size         int64
+	ETag         string
+	LastModified time.Time
+	Header       http.Header
+}
+
+// FakeSwift is a minimal in-memory Object Storage API, supporting just what
+// the dirsync package needs.
+type FakeSwift struct {
+	mu         sync.Mutex
+	Containers map[string]bool
+	Objects    map[string]*storedObject
+
+	// Corrupt lists the objects, keyed by "container/object", whose content
+	// is altered when downloaded.
+	Corrupt map[string]bool
+
+	// Puts counts the successful PUT requests on each object.
+	Puts map[string]int
+}
+
+// HandleSwift sets up the test server to act as an Object Storage API.
+func HandleSwift(t *testing.T, fakeServer th.FakeServer) *FakeSwift {
+	swift := &FakeSwift{
+		Containers: make(map[string]bool),
+		Objects:    make(map[string]*storedObject),
+		Corrupt:    make(map[string]bool),
+		Puts:       make(map[string]int),
+	}
+
+	fakeServer.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		swift.mu.Lock()
+		defer swift.mu.Unlock()
+
+		container, object, isObject := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+		if !swift.Containers[container] && (isObject || r.Method != "PUT") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if !isObject {
+			swift.handleContainer(t, w, r, container)
+			return
+		}
+		swift.handleObject(t, w, r, container+"/"+object)
+	})
+
+	return swift
+}
+
+// Put stores an object, creating its container if needed.
+func (s *FakeSwift) Put(key string, content string, lastModified time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	container, _, _ := strings.Cut(key, "/")
+	s.Containers[container] = true
+	s.Objects[key] = &storedObject{
+		Content:      []byte(content),
+		Size:         int64(len(content)),
+		ETag:         md5sum([]byte(content)),
+		LastModified: lastModified,
+		Header:       make(http.Header),
+	}
+}
+
+// Keys returns the keys of the stored objects, sorted.
+func (s *FakeSwift) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.Objects))
+	for key := range s.Objects {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func (s *FakeSwift) handleContainer(t *testing.T, w http.ResponseWriter, r *http.Request, container string) {
+	switch r.Method {
+	case "PUT":
+		if s.Containers[container] {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		s.Containers[container] = true
+		w.WriteHeader(http.StatusCreated)
+	case "GET":
+		prefix := container + "/" + r.URL.Query().Get("prefix")
+		marker := container + "/" + r.URL.Query().Get("marker")
+
+		keys := make([]string, 0, len(s.Objects))
+		for key := range s.Objects {
+			if strings.HasPrefix(key, prefix) && key > marker {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+
+		listing := make([]map[string]any, len(keys))
+		for i, key := range keys {
+			listing[i] = map[string]any{
+				"name":          strings.TrimPrefix(key, container+"/"),
+				"hash":          s.Objects[key].ETag,
+				"bytes":         s.Objects[key].Size,
+				"content_type":  "application/octet-stream",
+				"last_modified": s.Objects[key].LastModified.Format("2006-01-02T15:04:05.000000"),
+			}
+
+			// Static large objects are listed with the checksum of their
+			// manifest, and their ETag as slo_etag.
+			if s.Objects[key].Header.Get("X-Static-Large-Object") == "True" {
+				listing[i]["hash"] = md5sum(s.Objects[key].Content)
+				listing[i]["slo_etag"] = s.Objects[key].ETag
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		th.AssertNoErr(t, json.NewEncoder(w).Encode(listing))
+	default:
+		t.Errorf("unexpected %s on container %s", r.Method, container)
+	}
+}
+
+func (s *FakeSwift) handleObject(t *testing.T, w http.ResponseWriter, r *http.Request, key string) {
+	switch r.Method {
+	case "PUT":
+		content, err := io.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+
+		stored := &storedObject{
+			Content:      content,
+			Size:         int64(len(content)),
+			ETag:         md5sum(content),
+			LastModified: uploadTime,
+			Header:       make(http.Header),
+		}
+
+		if r.URL.Query().Get("multipart-manifest") == "put" {
+			s.putStaticManifest(t, stored)
+		}
+
+		if etag := r.Header.Get("ETag"); etag != "" && etag != stored.ETag {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+
+		s.Objects[key] = stored
+		s.Puts[key]++
+
+		w.Header().Set("Etag", fmt.Sprintf("%q", stored.ETag))
+		w.WriteHeader(http.StatusCreated)
+	case "HEAD", "GET":
+		stored, ok := s.Objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		content := stored.Content
+		if stored.Header.Get("X-Static-Large-Object") == "True" && r.URL.Query().Get("multipart-manifest") != "get" {
+			content = s.readStaticLargeObject(t, stored)
+		}
+		if s.Corrupt[key] {
+			content = append(slices.Clone(content[1:]), '!')
+		}
+
+		for k, v := range stored.Header {
+			w.Header()[k] = v
+		}
+		w.Header().Set("Etag", fmt.Sprintf("%q", stored.ETag))
+		w.Header().Set("Last-Modified", stored.LastModified.Format(http.TimeFormat))
+		w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == "GET" {
+			_, _ = w.Write(content)
+		}
+	case "DELETE":
+		if _, ok := s.Objects[key]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(s.Objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		t.Errorf("unexpected %s on object %s", r.Method, key)
+	}
+}
+
+// putStaticManifest turns stored into a static large object manifest, the
+// way Swift does.
+func (s *FakeSwift) putStaticManifest(t *testing.T, stored *storedObject) {
+	var manifest []struct {
+		Path      string `json:"path"`
+		ETag      string `json:"etag"`
+		SizeBytes int64  `json:"size_bytes"`
+	}
+	th.AssertNoErr(t, json.Unmarshal(stored.Content, &manifest))
+
+	etags := md5.New()
+	listing := make([]map[string]any, len(manifest))
+	stored.Size = 0
+	for i, entry := range manifest {
+		etags.Write([]byte(entry.ETag))
+		stored.Size += entry.SizeBytes
+
+		listing[i] = map[string]any{
+			"name":  entry.Path,
+			"hash":  entry.ETag,
+			"bytes": entry.SizeBytes,
+		}
+	}
+
+	content, err := json.Marshal(listing)
+	th.AssertNoErr(t, err)
+
+	stored.Content = content
+	stored.ETag = hex.EncodeToString(etags.Sum(nil))
+	stored.Header.Set("X-Static-Large-Object", "True")
+}
+
+// readStaticLargeObject returns the concatenated segments of a static large
+// object.
+func (s *FakeSwift) readStaticLargeObject(t *testing.T, stored *storedObject) []byte {
+	var listing []struct {
+		Name string `json:"name"`
+	}
+	th.AssertNoErr(t, json.Unmarshal(stored.Content, &listing))
+
+	var content []byte
+	for _, entry := range listing {
+		content = append(content, s.Objects[strings.TrimPrefix(entry.Name, "/")].Content...)
+	}
+	return content
+}
+
+// writeFile creates a local file with the given modification time.
+func writeFile(t *testing.T, dir, path, content string, modTime time.Time) {
+	name := filepath.Join(dir, filepath.FromSlash(path))
+	th.AssertNoErr(t, os.MkdirAll(filepath.Dir(name), 0o755))
+	th.AssertNoErr(t, os.WriteFile(name, []byte(content), 0o644))
+	th.AssertNoErr(t, os.Chtimes(name, modTime, modTime))
+}
+
+// readFile returns the content of a local file.
+func readFile(t *testing.T, dir, path string) string {
+	content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(path)))
+	th.AssertNoErr(t, err)
+	return string(content)
+}
+
+func md5sum(content []byte) string {
+	sum := md5.Sum(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,266 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/dirsync"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/largeobjects"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestUpload(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Put("artifacts/build/a.txt", "hello", uploadTime)
+	swift.Put("artifacts/build/stale.txt", "stale", uploadTime)
+	swift.Put("artifacts/other.txt", "other", uploadTime)
+
+	dir := t.TempDir()
+	writeFile(t, dir, "a.txt", "hello", oldTime)
+	writeFile(t, dir, "sub/b.txt", "world", oldTime)
+	writeFile(t, dir, "big.bin", "0123456789", oldTime)
+
+	var processed []string
+	syncOpts := dirsync.SyncOpts{
+		Prefix:      "build/",
+		Delete:      true,
+		Concurrency: 3,
+		SegmentSize: 6,
+		OnEntry: func(entry dirsync.Entry) {
+			processed = append(processed, entry.Path)
+		},
+	}
+
+	summary, err := dirsync.Upload(context.TODO(), client.ServiceClient(fakeServer), dir, "artifacts", syncOpts)
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, []dirsync.Entry{
+		{Path: "a.txt", Operation: dirsync.OperationSkip, Size: 5},
+		{Path: "big.bin", Operation: dirsync.OperationUpload, Size: 10},
+		{Path: "stale.txt", Operation: dirsync.OperationDelete, Size: 5},
+		{Path: "sub/b.txt", Operation: dirsync.OperationUpload, Size: 5},
+	}, summary.Entries)
+	th.AssertEquals(t, 4, len(processed))
+	th.AssertEquals(t, 2, len(summary.Transferred()))
+	th.AssertEquals(t, 1, len(summary.Deleted()))
+	th.AssertEquals(t, 1, len(summary.Unchanged()))
+	th.AssertEquals(t, 0, len(summary.Failed()))
+	th.AssertEquals(t, int64(15), summary.Bytes())
+
+	th.CheckDeepEquals(t, []string{
+		"artifacts/build/a.txt",
+		"artifacts/build/big.bin",
+		"artifacts/build/sub/b.txt",
+		"artifacts/other.txt",
+		"artifacts_segments/build/big.bin/slo/10/6/00000000",
+		"artifacts_segments/build/big.bin/slo/10/6/00000001",
+	}, swift.Keys())
+	th.AssertEquals(t, "True", swift.Objects["artifacts/build/big.bin"].Header.Get("X-Static-Large-Object"))
+	th.AssertEquals(t, "world", string(swift.Objects["artifacts/build/sub/b.txt"].Content))
+	th.AssertEquals(t, 0, swift.Puts["artifacts/build/a.txt"])
+}
+
+func TestUploadModified(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Put("artifacts/a.txt", "hello", uploadTime)
+	swift.Put("artifacts/b.txt", "hello", uploadTime)
+
+	dir := t.TempDir()
+	writeFile(t, dir, "a.txt", "HELLO", oldTime)
+	writeFile(t, dir, "b.txt", "HELLO", newTime)
+
+	summary, err := dirsync.Upload(context.TODO(), client.ServiceClient(fakeServer), dir, "artifacts", dirsync.SyncOpts{})
+	th.AssertNoErr(t, err)
+
+	// Without checksums, an older file of the same size is left alone.
+	th.AssertEquals(t, dirsync.OperationSkip, summary.Entries[0].Operation)
+	th.AssertEquals(t, dirsync.OperationUpload, summary.Entries[1].Operation)
+	th.AssertEquals(t, "hello", string(swift.Objects["artifacts/a.txt"].Content))
+	th.AssertEquals(t, "HELLO", string(swift.Objects["artifacts/b.txt"].Content))
+
+	summary, err = dirsync.Upload(context.TODO(), client.ServiceClient(fakeServer), dir, "artifacts", dirsync.SyncOpts{Checksum: true})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, dirsync.OperationUpload, summary.Entries[0].Operation)
+	th.AssertEquals(t, dirsync.OperationSkip, summary.Entries[1].Operation)
+	th.AssertEquals(t, "HELLO", string(swift.Objects["artifacts/a.txt"].Content))
+}
+
+func TestUploadLargeObjectChecksum(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+
+	dir := t.TempDir()
+	writeFile(t, dir, "big.bin", "0123456789", newTime)
+
+	syncOpts := dirsync.SyncOpts{
+		Checksum:    true,
+		SegmentSize: 4,
+	}
+
+	summary, err := dirsync.Upload(context.TODO(), client.ServiceClient(fakeServer), dir, "artifacts", syncOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(summary.Transferred()))
+
+	// The ETag of a static large object is checked against the checksums
+	// of its segments.
+	summary, err = dirsync.Upload(context.TODO(), client.ServiceClient(fakeServer), dir, "artifacts", syncOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(summary.Unchanged()))
+	th.AssertEquals(t, 1, swift.Puts["artifacts/big.bin"])
+}
+
+func TestUploadReplacesLargeObject(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Containers["artifacts"] = true
+
+	uploadOpts := largeobjects.UploadOpts{
+		Content:     strings.NewReader("0123456789"),
+		SegmentSize: 4,
+	}
+	_, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "artifacts", "big.bin", uploadOpts)
+	th.AssertNoErr(t, err)
+
+	dir := t.TempDir()
+	writeFile(t, dir, "big.bin", "abcdef", newTime)
+
+	summary, err := dirsync.Upload(context.TODO(), client.ServiceClient(fakeServer), dir, "artifacts", dirsync.SyncOpts{SegmentSize: 4})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(summary.Transferred()))
+
+	th.CheckDeepEquals(t, []string{
+		"artifacts/big.bin",
+		"artifacts_segments/big.bin/slo/6/4/00000000",
+		"artifacts_segments/big.bin/slo/6/4/00000001",
+	}, swift.Keys())
+}
+
+func TestDownload(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Put("artifacts/build/a.txt", "hello", oldTime)
+	swift.Put("artifacts/build/sub/b.txt", "world", uploadTime)
+	swift.Put("artifacts/build/sub/", "", uploadTime)
+	swift.Put("artifacts/build/../escape.txt", "evil", uploadTime)
+	swift.Put("artifacts/other.txt", "other", uploadTime)
+
+	uploadOpts := largeobjects.UploadOpts{
+		Content:     strings.NewReader("0123456789"),
+		SegmentSize: 4,
+	}
+	_, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "artifacts", "build/big.bin", uploadOpts)
+	th.AssertNoErr(t, err)
+
+	dir := t.TempDir()
+	writeFile(t, dir, "a.txt", "hello", newTime)
+	writeFile(t, dir, "stale.txt", "stale", newTime)
+
+	syncOpts := dirsync.SyncOpts{
+		Prefix:      "build/",
+		Delete:      true,
+		Concurrency: 2,
+	}
+
+	summary, err := dirsync.Download(context.TODO(), client.ServiceClient(fakeServer), "artifacts", dir, syncOpts)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 5, len(summary.Entries))
+	th.AssertEquals(t, "../escape.txt", summary.Entries[0].Path)
+	th.CheckErr(t, summary.Entries[0].Err, &gophercloud.ErrInvalidInput{})
+	th.CheckDeepEquals(t, []dirsync.Entry{
+		{Path: "a.txt", Operation: dirsync.OperationSkip, Size: 5},
+		{Path: "big.bin", Operation: dirsync.OperationDownload, Size: 10},
+		{Path: "stale.txt", Operation: dirsync.OperationDelete, Size: 5},
+		{Path: "sub/b.txt", Operation: dirsync.OperationDownload, Size: 5},
+	}, summary.Entries[1:])
+
+	th.AssertEquals(t, "0123456789", readFile(t, dir, "big.bin"))
+	th.AssertEquals(t, "world", readFile(t, dir, "sub/b.txt"))
+	_, err = os.Stat(filepath.Join(dir, "stale.txt"))
+	th.AssertEquals(t, true, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(filepath.Dir(dir), "escape.txt"))
+	th.AssertEquals(t, true, os.IsNotExist(err))
+
+	info, err := os.Stat(filepath.Join(dir, "sub/b.txt"))
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, info.ModTime().Equal(uploadTime))
+
+	// Downloaded files are up to date on the next run.
+	summary, err = dirsync.Download(context.TODO(), client.ServiceClient(fakeServer), "artifacts", dir, syncOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, len(summary.Unchanged()))
+	th.AssertEquals(t, 0, len(summary.Transferred()))
+}
+
+func TestDownloadChecksumMismatch(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Put("artifacts/a.txt", "hello", uploadTime)
+	swift.Corrupt["artifacts/a.txt"] = true
+
+	dir := t.TempDir()
+
+	summary, err := dirsync.Download(context.TODO(), client.ServiceClient(fakeServer), "artifacts", dir, dirsync.SyncOpts{})
+	th.AssertNoErr(t, err)
+
+	failed := summary.Failed()
+	th.AssertEquals(t, 1, len(failed))
+	var mismatch dirsync.ErrChecksumMismatch
+	th.AssertEquals(t, true, errors.As(failed[0].Err, &mismatch))
+	th.AssertEquals(t, md5sum([]byte("hello")), mismatch.Expected)
+
+	entries, err := os.ReadDir(dir)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(entries))
+}
+
+func TestDryRun(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Put("artifacts/stale.txt", "stale", uploadTime)
+
+	dir := t.TempDir()
+	writeFile(t, dir, "a.txt", "hello", oldTime)
+
+	syncOpts := dirsync.SyncOpts{
+		Delete: true,
+		DryRun: true,
+	}
+
+	summary, err := dirsync.Upload(context.TODO(), client.ServiceClient(fakeServer), dir, "artifacts", syncOpts)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []dirsync.Entry{
+		{Path: "a.txt", Operation: dirsync.OperationUpload, Size: 5},
+		{Path: "stale.txt", Operation: dirsync.OperationDelete, Size: 5},
+	}, summary.Entries)
+	th.CheckDeepEquals(t, []string{"artifacts/stale.txt"}, swift.Keys())
+
+	// A missing container is reported as empty.
+	summary, err = dirsync.Upload(context.TODO(), client.ServiceClient(fakeServer), dir, "missing", syncOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(summary.Entries))
+	th.AssertEquals(t, false, swift.Containers["missing"])
+}
@@ -0,0 +1,82 @@
+/*
+Package largeobjects uploads and deletes large objects of the OpenStack
+Object Storage service.
+
+An object larger than the maximum object size of the cluster (5 GiB by
+default) is stored as segments, which are regular objects, and a manifest
+that ties them together. This package splits content into segments, uploads
+them concurrently with retries while checking their ETags, writes a Static
+(SLO) or Dynamic (DLO) Large Object manifest, resumes interrupted uploads and
+deletes large objects along with their segments.
+
+A static manifest holds at most max_manifest_segments segments (1000 by
+default). When the size of the content is known, the segment size is raised
+so that the content fits in UploadOpts.MaxSegments segments. Otherwise, the
+segments are grouped into intermediate manifests. Before a dynamic manifest
+is written, the segments left under its prefix by a previous upload of more
+segments are deleted, as they would otherwise be appended to the object.
+
+Example to upload a file as a Static Large Object
+
+	f, err := os.Open("backup.tar")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		panic(err)
+	}
+
+	uploadOpts := largeobjects.UploadOpts{
+		Content:     f,
+		Size:        fi.Size(),
+		SegmentSize: 1024 * 1024 * 1024,
+		Concurrency: 4,
+		Retries:     3,
+	}
+
+	result, err := largeobjects.Upload(context.TODO(), objectStorageClient, "backups", "backup.tar", uploadOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Uploaded %d bytes in %d segments\n", result.Size, len(result.Segments))
+
+Example to resume an interrupted upload
+
+	uploadOpts.Resume = true
+
+	result, err := largeobjects.Upload(context.TODO(), objectStorageClient, "backups", "backup.tar", uploadOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Uploaded %d missing segments\n", len(result.Uploaded()))
+
+Example to upload a stream as a Dynamic Large Object
+
+	uploadOpts := largeobjects.UploadOpts{
+		Content:      os.Stdin,
+		ManifestType: largeobjects.DynamicLargeObject,
+		Concurrency:  2,
+	}
+
+	_, err := largeobjects.Upload(context.TODO(), objectStorageClient, "logs", "today.log", uploadOpts)
+	if err != nil {
+		panic(err)
+	}
+
+Example to delete a large object and its segments
+
+	deleteOpts := largeobjects.DeleteOpts{
+		Concurrency: 8,
+	}
+
+	_, err := largeobjects.Delete(context.TODO(), objectStorageClient, "backups", "backup.tar", deleteOpts)
+	if err != nil {
+		panic(err)
+	}
+*/
+package largeobjects
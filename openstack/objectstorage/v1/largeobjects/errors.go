@@ -0,0 +1,39 @@
+package largeobjects
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// ErrETagMismatch is the error when the ETag returned by the server for an
+// uploaded segment is not the MD5 checksum of the content that was sent.
+type ErrETagMismatch struct {
+	gophercloud.BaseError
+	Container string
+	Name      string
+	Expected  string
+	Actual    string
+}
+
+func (e ErrETagMismatch) Error() string {
+	return fmt.Sprintf("segment %s/%s was stored with ETag %q, expected %q", e.Container, e.Name, e.Actual, e.Expected)
+}
+
+// ErrSegmentFailed is the error when a segment could not be uploaded, even
+// after retrying. Err holds the error of the last attempt.
+type ErrSegmentFailed struct {
+	gophercloud.BaseError
+	Index    int
+	Name     string
+	Attempts int
+	Err      error
+}
+
+func (e ErrSegmentFailed) Error() string {
+	return fmt.Sprintf("failed to upload segment %d (%s) after %d attempt(s): %s", e.Index, e.Name, e.Attempts, e.Err)
+}
+
+func (e ErrSegmentFailed) Unwrap() error {
+	return e.Err
+}
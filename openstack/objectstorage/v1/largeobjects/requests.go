@@ -0,0 +1,721 @@
+package largeobjects
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gophercloud/gophercloud/v2"
+	v1 "github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/objects"
+)
+
+// ManifestType is the kind of manifest that ties the segments of a large
+// object together.
+type ManifestType string
+
+const (
+	// StaticLargeObject is a manifest listing every segment with its size
+	// and ETag. The server checks the segments when the manifest is written.
+	// This is the default.
+	StaticLargeObject ManifestType = "slo"
+
+	// DynamicLargeObject is a manifest pointing at a container and a name
+	// prefix. The large object is made of every object matching the prefix,
+	// in lexical order.
+	DynamicLargeObject ManifestType = "dlo"
+)
+
+// DefaultSegmentSize is the size of the segments when UploadOpts.SegmentSize
+// is not set.
+const DefaultSegmentSize int64 = 100 * 1024 * 1024
+
+// DefaultMaxSegments is the maximum number of segments of a static large
+// object manifest when UploadOpts.MaxSegments is not set. It is the default
+// max_manifest_segments of Swift.
+const DefaultMaxSegments = 1000
+
+// UploadOpts specifies how a large object is uploaded.
+type UploadOpts struct {
+	// Content is the content of the large object. It is required. If it is
+	// an io.ReaderAt and its size is known, segments are read concurrently
+	// from it. Otherwise it is read sequentially and up to Concurrency
+	// segments are held in memory.
+	Content io.Reader
+
+	// Size is the size of Content. It is only used when Content is an
+	// io.ReaderAt, and defaults to the result of the Size method of Content
+	// if it has one, like *bytes.Reader and *io.SectionReader do.
+	Size int64
+
+	// SegmentSize is the maximum size of a segment. It defaults to
+	// DefaultSegmentSize. For static large objects of known size, it is
+	// raised so that the content fits in MaxSegments segments.
+	SegmentSize int64
+
+	// MaxSegments is the maximum number of segments in a static large object
+	// manifest, which is the max_manifest_segments setting of the cluster.
+	// It defaults to DefaultMaxSegments. When the size of the content is not
+	// known and it takes more segments, the segments are grouped into
+	// intermediate manifests stored in the segment container.
+	MaxSegments int
+
+	// SegmentContainer is the container the segments are written to. It is
+	// created if needed, and defaults to the name of the container of the
+	// large object followed by "_segments".
+	SegmentContainer string
+
+	// SegmentPrefix is the common prefix of the segment names. It defaults
+	// to "<object>/<manifest type>/<size>/<segment size>/", without the size
+	// when it is not known. Use a prefix that is unique to the content when
+	// an existing large object may be overwritten while being read.
+	SegmentPrefix string
+
+	// ManifestType is the kind of manifest to write. It defaults to
+	// StaticLargeObject.
+	ManifestType ManifestType
+
+	// ContentType is the content type of the large object.
+	ContentType string
+
+	// Metadata is the custom metadata of the large object.
+	Metadata map[string]string
+
+	// Concurrency is the maximum number of segments uploaded at the same
+	// time. It defaults to 1.
+	Concurrency int
+
+	// Retries is the number of times the upload of a segment is retried
+	// after a failure, including an ETag mismatch.
+	Retries int
+
+	// Resume skips the segments that are already stored under
+	// SegmentPrefix with the same size and ETag, which completes an upload
+	// that was interrupted.
+	Resume bool
+
+	// OnSegment, if set, is called every time a segment has been uploaded
+	// or skipped. Calls are serialized.
+	OnSegment func(segment Segment)
+}
+
+// Upload splits Content into segments, uploads them to the segment container
+// and writes the manifest of the large object. The manifest is only written
+// once every segment has been stored.
+func Upload(ctx context.Context, client *gophercloud.ServiceClient, containerName, objectName string, opts UploadOpts) (*UploadResult, error) {
+	if err := v1.CheckContainerName(containerName); err != nil {
+		return nil, err
+	}
+	if err := v1.CheckObjectName(objectName); err != nil {
+		return nil, err
+	}
+
+	if opts.Content == nil {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "largeobjects.UploadOpts.Content"
+		return nil, err
+	}
+
+	manifestType := cmp.Or(opts.ManifestType, StaticLargeObject)
+	if manifestType != StaticLargeObject && manifestType != DynamicLargeObject {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "largeobjects.UploadOpts.ManifestType"
+		err.Value = opts.ManifestType
+		return nil, err
+	}
+
+	segmentSize := cmp.Or(opts.SegmentSize, DefaultSegmentSize)
+	if segmentSize < 0 {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "largeobjects.UploadOpts.SegmentSize"
+		err.Value = opts.SegmentSize
+		return nil, err
+	}
+
+	maxSegments := cmp.Or(opts.MaxSegments, DefaultMaxSegments)
+	if maxSegments < 2 {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "largeobjects.UploadOpts.MaxSegments"
+		err.Value = opts.MaxSegments
+		return nil, err
+	}
+
+	readerAt, size := contentReaderAt(opts)
+	if manifestType == StaticLargeObject && readerAt != nil {
+		segmentSize = max(segmentSize, (size+int64(maxSegments)-1)/int64(maxSegments))
+	}
+
+	u := &uploader{
+		client:      client,
+		opts:        opts,
+		container:   cmp.Or(opts.SegmentContainer, containerName+"_segments"),
+		prefix:      opts.SegmentPrefix,
+		segmentSize: segmentSize,
+	}
+	if err := v1.CheckContainerName(u.container); err != nil {
+		return nil, err
+	}
+	if u.prefix == "" {
+		u.prefix = fmt.Sprintf("%s/%s/", objectName, manifestType)
+		if readerAt != nil {
+			u.prefix += fmt.Sprintf("%d/", size)
+		}
+		u.prefix += fmt.Sprintf("%d/", segmentSize)
+	}
+
+	if _, err := containers.Create(ctx, client, u.container, nil).Extract(); err != nil {
+		return nil, err
+	}
+
+	if opts.Resume {
+		existing, err := listSegments(ctx, client, u.container, u.prefix)
+		if err != nil {
+			return nil, err
+		}
+		u.existing = make(map[string]objects.Object, len(existing))
+		for _, object := range existing {
+			u.existing[object.Name] = object
+		}
+	}
+
+	segments, err := u.uploadSegments(ctx, readerAt, size)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(segments) == 0 {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "largeobjects.UploadOpts.Content"
+		err.Info = "the content is empty"
+		return nil, err
+	}
+
+	result := &UploadResult{
+		ManifestType:     manifestType,
+		SegmentContainer: u.container,
+		SegmentPrefix:    u.prefix,
+		Segments:         segments,
+	}
+
+	entries := make([]manifestSegment, len(segments))
+	for i, segment := range segments {
+		result.Size += segment.Size
+		entries[i] = manifestSegment{
+			Path:      "/" + segment.Container + "/" + segment.Name,
+			ETag:      segment.ETag,
+			SizeBytes: segment.Size,
+		}
+	}
+
+	createOpts := objects.CreateOpts{
+		ContentType: opts.ContentType,
+		Metadata:    opts.Metadata,
+	}
+	switch manifestType {
+	case StaticLargeObject:
+		entries, err = u.nestManifests(ctx, entries, maxSegments)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := json.Marshal(entries)
+		if err != nil {
+			return nil, err
+		}
+
+		// The server checks the ETag of a static manifest against the
+		// ETags of its segments, not against the manifest itself.
+		result.ETag = manifestETag(entries)
+		createOpts.Content = bytes.NewReader(b)
+		createOpts.MultipartManifest = "put"
+		createOpts.ETag = result.ETag
+	case DynamicLargeObject:
+		// Every object matching the prefix is part of a dynamic large
+		// object, including the segments left by a previous upload of more
+		// segments.
+		if err := u.deleteStaleSegments(ctx, len(segments)); err != nil {
+			return nil, err
+		}
+
+		result.ETag = manifestETag(entries)
+		createOpts.Content = bytes.NewReader(nil)
+		createOpts.ObjectManifest = url.PathEscape(u.container) + "/" + (&url.URL{Path: u.prefix}).EscapedPath()
+	}
+
+	if _, err := objects.Create(ctx, client, containerName, objectName, createOpts).Extract(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// manifestSegment is an entry of a static large object manifest.
+type manifestSegment struct {
+	Path      string `json:"path"`
+	ETag      string `json:"etag"`
+	SizeBytes int64  `json:"size_bytes"`
+}
+
+// manifestETag returns the ETag of a large object made of entries, which is
+// the MD5 checksum of the concatenated ETags of the entries.
+func manifestETag(entries []manifestSegment) string {
+	etags := md5.New()
+	for _, entry := range entries {
+		etags.Write([]byte(entry.ETag))
+	}
+	return hex.EncodeToString(etags.Sum(nil))
+}
+
+// nestManifests groups entries into intermediate static manifests of at most
+// maxSegments entries, stored in the segment container, until they fit in a
+// single manifest.
+func (u *uploader) nestManifests(ctx context.Context, entries []manifestSegment, maxSegments int) ([]manifestSegment, error) {
+	for level := 0; len(entries) > maxSegments; level++ {
+		var nested []manifestSegment
+		for start := 0; start < len(entries); start += maxSegments {
+			group := entries[start:min(start+maxSegments, len(entries))]
+
+			b, err := json.Marshal(group)
+			if err != nil {
+				return nil, err
+			}
+
+			entry := manifestSegment{
+				Path: "/" + u.container + "/" + fmt.Sprintf("%smanifest/%d/%08d", u.prefix, level, len(nested)),
+				ETag: manifestETag(group),
+			}
+			for _, segment := range group {
+				entry.SizeBytes += segment.SizeBytes
+			}
+
+			createOpts := objects.CreateOpts{
+				Content:           bytes.NewReader(b),
+				MultipartManifest: "put",
+				ETag:              entry.ETag,
+			}
+			name := strings.TrimPrefix(entry.Path, "/"+u.container+"/")
+			if _, err := objects.Create(ctx, u.client, u.container, name, createOpts).Extract(); err != nil {
+				return nil, err
+			}
+
+			nested = append(nested, entry)
+		}
+		entries = nested
+	}
+
+	return entries, nil
+}
+
+// deleteStaleSegments deletes the segments stored under the prefix of the
+// upload whose index is n or more.
+func (u *uploader) deleteStaleSegments(ctx context.Context, n int) error {
+	existing, err := listSegments(ctx, u.client, u.container, u.prefix)
+	if err != nil {
+		return err
+	}
+
+	var stale []Segment
+	for _, object := range existing {
+		suffix := strings.TrimPrefix(object.Name, u.prefix)
+		if index, err := strconv.Atoi(suffix); err == nil && len(suffix) == 8 && index >= n {
+			stale = append(stale, Segment{Index: index, Container: u.container, Name: object.Name})
+		}
+	}
+
+	return DeleteSegments(ctx, u.client, stale, u.opts.Concurrency)
+}
+
+// contentReaderAt returns Content as an io.ReaderAt along with its size, or
+// nil if Content has to be read sequentially.
+func contentReaderAt(opts UploadOpts) (io.ReaderAt, int64) {
+	readerAt, ok := opts.Content.(io.ReaderAt)
+	if !ok {
+		return nil, 0
+	}
+
+	if opts.Size > 0 {
+		return readerAt, opts.Size
+	}
+
+	if sizer, ok := opts.Content.(interface{ Size() int64 }); ok {
+		return readerAt, sizer.Size()
+	}
+
+	return nil, 0
+}
+
+// uploader holds the state shared by the goroutines of an Upload call.
+type uploader struct {
+	client      *gophercloud.ServiceClient
+	opts        UploadOpts
+	container   string
+	prefix      string
+	segmentSize int64
+	existing    map[string]objects.Object
+
+	mu  sync.Mutex
+	err error
+}
+
+// uploadSegments reads the content segment by segment and uploads the
+// segments concurrently. It stops at the first segment that fails.
+func (u *uploader) uploadSegments(ctx context.Context, readerAt io.ReaderAt, size int64) ([]Segment, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	concurrency := u.opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var segments []*Segment
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	var offset int64
+	for index := 0; ; index++ {
+		// Acquiring a slot before reading bounds the number of segments
+		// held in memory when the content is read sequentially.
+		sem <- struct{}{}
+		if ctx.Err() != nil {
+			<-sem
+			break
+		}
+
+		var content io.ReadSeeker
+		var n int64
+		if readerAt != nil {
+			n = min(u.segmentSize, size-offset)
+			content = io.NewSectionReader(readerAt, offset, n)
+		} else {
+			buf := make([]byte, u.segmentSize)
+			read, err := io.ReadFull(u.opts.Content, buf)
+			if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+				<-sem
+				u.fail(err)
+				cancel()
+				break
+			}
+			n = int64(read)
+			content = bytes.NewReader(buf[:read])
+		}
+
+		if n <= 0 {
+			<-sem
+			break
+		}
+
+		segment := &Segment{
+			Index:     index,
+			Container: u.container,
+			Name:      fmt.Sprintf("%s%08d", u.prefix, index),
+			Offset:    offset,
+			Size:      n,
+		}
+		segments = append(segments, segment)
+		offset += n
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := u.uploadSegment(ctx, segment, content); err != nil {
+				u.fail(err)
+				cancel()
+			}
+		}()
+
+		if n < u.segmentSize {
+			break
+		}
+	}
+	wg.Wait()
+
+	if u.err != nil {
+		return nil, u.err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	result := make([]Segment, len(segments))
+	for i, segment := range segments {
+		result[i] = *segment
+	}
+	return result, nil
+}
+
+// fail records the first error met by the upload.
+func (u *uploader) fail(err error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.err == nil {
+		u.err = err
+	}
+}
+
+// done reports a segment to OnSegment.
+func (u *uploader) done(segment Segment) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.opts.OnSegment != nil {
+		u.opts.OnSegment(segment)
+	}
+}
+
+// uploadSegment checksums a segment, then uploads it unless it is already
+// stored, retrying up to Retries times.
+func (u *uploader) uploadSegment(ctx context.Context, segment *Segment, content io.ReadSeeker) error {
+	hash := md5.New()
+	if _, err := io.Copy(hash, content); err != nil {
+		return err
+	}
+	segment.ETag = hex.EncodeToString(hash.Sum(nil))
+
+	if existing, ok := u.existing[segment.Name]; ok && existing.Bytes == segment.Size && existing.Hash == segment.ETag {
+		segment.Skipped = true
+		u.done(*segment)
+		return nil
+	}
+
+	var err error
+	for attempt := 0; attempt <= u.opts.Retries; attempt++ {
+		if ctx.Err() != nil {
+			break
+		}
+
+		if _, err = content.Seek(0, io.SeekStart); err != nil {
+			break
+		}
+
+		segment.Attempts++
+		if err = u.putSegment(ctx, segment, content); err == nil {
+			u.done(*segment)
+			return nil
+		}
+	}
+
+	if err == nil {
+		err = ctx.Err()
+	}
+
+	return ErrSegmentFailed{
+		Index:    segment.Index,
+		Name:     segment.Name,
+		Attempts: segment.Attempts,
+		Err:      err,
+	}
+}
+
+// putSegment sends a segment and checks the ETag returned by the server.
+func (u *uploader) putSegment(ctx context.Context, segment *Segment, content io.Reader) error {
+	createOpts := objects.CreateOpts{
+		Content: content,
+		ETag:    segment.ETag,
+	}
+
+	header, err := objects.Create(ctx, u.client, segment.Container, segment.Name, createOpts).Extract()
+	if err != nil {
+		return err
+	}
+
+	if etag := strings.Trim(header.ETag, `"`); etag != segment.ETag {
+		return ErrETagMismatch{
+			Container: segment.Container,
+			Name:      segment.Name,
+			Expected:  segment.ETag,
+			Actual:    etag,
+		}
+	}
+
+	return nil
+}
+
+// listSegments returns the objects of a container whose names start with
+// prefix.
+func listSegments(ctx context.Context, client *gophercloud.ServiceClient, container, prefix string) ([]objects.Object, error) {
+	allPages, err := objects.List(client, container, objects.ListOpts{Prefix: prefix}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return objects.ExtractInfo(allPages)
+}
+
+// DeleteOpts specifies how a large object is deleted.
+type DeleteOpts struct {
+	// Concurrency is the maximum number of segments deleted at the same
+	// time. It defaults to 1.
+	Concurrency int
+}
+
+// Delete deletes an object and, if it is a large object, its segments. The
+// segments are deleted before the manifest, so that a failed call can be
+// retried. Segments that are already gone are ignored.
+func Delete(ctx context.Context, client *gophercloud.ServiceClient, containerName, objectName string, opts DeleteOpts) (*DeleteResult, error) {
+	manifest, err := GetManifest(ctx, client, containerName, objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := DeleteSegments(ctx, client, manifest.Segments, opts.Concurrency); err != nil {
+		return nil, err
+	}
+
+	if _, err := objects.Delete(ctx, client, containerName, objectName, nil).Extract(); err != nil {
+		return nil, err
+	}
+
+	return &DeleteResult{
+		ManifestType: manifest.Type,
+		Segments:     manifest.Segments,
+	}, nil
+}
+
+// GetManifest returns the manifest of an object. The Type of the manifest is
+// empty if the object is not a large object.
+func GetManifest(ctx context.Context, client *gophercloud.ServiceClient, containerName, objectName string) (*Manifest, error) {
+	header, err := objects.Get(ctx, client, containerName, objectName, nil).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	switch {
+	case header.StaticLargeObject:
+		manifest.Type = StaticLargeObject
+		manifest.Segments, err = staticSegments(ctx, client, containerName, objectName)
+	case header.ObjectManifest != "":
+		manifest.Type = DynamicLargeObject
+		manifest.Segments, err = dynamicSegments(ctx, client, header.ObjectManifest)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// DeleteSegments deletes segments, up to concurrency at a time. Segments that
+// are already gone are ignored.
+func DeleteSegments(ctx context.Context, client *gophercloud.ServiceClient, segments []Segment, concurrency int) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	errs := make([]error, len(segments))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, segment := range segments {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			_, err := objects.Delete(ctx, client, segment.Container, segment.Name, nil).Extract()
+			if err != nil && !gophercloud.ResponseCodeIs(err, http.StatusNotFound) {
+				errs[i] = err
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// staticSegments returns the segments listed in the manifest of a static
+// large object.
+func staticSegments(ctx context.Context, client *gophercloud.ServiceClient, containerName, objectName string) ([]Segment, error) {
+	downloadOpts := objects.DownloadOpts{
+		MultipartManifest: "get",
+	}
+	r := objects.Download(ctx, client, containerName, objectName, downloadOpts)
+	body, err := r.ExtractContent()
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest []struct {
+		Name   string `json:"name"`
+		Hash   string `json:"hash"`
+		Bytes  int64  `json:"bytes"`
+		SubSLO bool   `json:"sub_slo"`
+	}
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return nil, err
+	}
+
+	var segments []Segment
+	for _, entry := range manifest {
+		container, name, _ := strings.Cut(strings.TrimPrefix(entry.Name, "/"), "/")
+
+		// The segments of an intermediate manifest are listed before the
+		// manifest itself.
+		if entry.SubSLO {
+			nested, err := staticSegments(ctx, client, container, name)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, nested...)
+		}
+
+		segments = append(segments, Segment{
+			Container: container,
+			Name:      name,
+			Size:      entry.Bytes,
+			ETag:      entry.Hash,
+		})
+	}
+
+	for i := range segments {
+		segments[i].Index = i
+	}
+
+	return segments, nil
+}
+
+// dynamicSegments returns the objects matching the X-Object-Manifest header
+// of a dynamic large object.
+func dynamicSegments(ctx context.Context, client *gophercloud.ServiceClient, objectManifest string) ([]Segment, error) {
+	unescaped, err := url.PathUnescape(objectManifest)
+	if err != nil {
+		return nil, err
+	}
+	container, prefix, _ := strings.Cut(unescaped, "/")
+
+	existing, err := listSegments(ctx, client, container, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := make([]Segment, len(existing))
+	for i, object := range existing {
+		segments[i] = Segment{
+			Index:     i,
+			Container: container,
+			Name:      object.Name,
+			Size:      object.Bytes,
+			ETag:      object.Hash,
+		}
+	}
+
+	return segments, nil
+}
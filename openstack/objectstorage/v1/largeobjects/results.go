@@ -0,0 +1,89 @@
+package largeobjects
+
+// Segment describes a segment of a large object.
+type Segment struct {
+	// Index is the position of the segment in the large object, starting
+	// at 0.
+	Index int
+
+	// Container is the container holding the segment.
+	Container string
+
+	// Name is the name of the segment object.
+	Name string
+
+	// Offset is the position of the first byte of the segment in the large
+	// object.
+	Offset int64
+
+	// Size is the size of the segment in bytes.
+	Size int64
+
+	// ETag is the MD5 checksum of the content of the segment.
+	ETag string
+
+	// Skipped is true when the segment was already stored with the same
+	// content by a previous upload and was not sent again.
+	Skipped bool
+
+	// Attempts is the number of times the segment was sent.
+	Attempts int
+}
+
+// UploadResult is the outcome of an Upload call.
+type UploadResult struct {
+	// ManifestType is the kind of manifest that was written.
+	ManifestType ManifestType
+
+	// SegmentContainer is the container holding the segments.
+	SegmentContainer string
+
+	// SegmentPrefix is the common prefix of the segment names.
+	SegmentPrefix string
+
+	// Segments holds the segments of the large object, in order.
+	Segments []Segment
+
+	// Size is the total size of the large object in bytes.
+	Size int64
+
+	// ETag is the ETag reported by the server for the large object: the MD5
+	// checksum of the concatenated ETags of its segments, or of its
+	// intermediate manifests when the segments did not fit in one manifest.
+	ETag string
+}
+
+// Uploaded returns the segments that were sent by the Upload call, as
+// opposed to the ones that were already stored.
+func (r UploadResult) Uploaded() []Segment {
+	var segments []Segment
+	for _, segment := range r.Segments {
+		if !segment.Skipped {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+// Manifest describes how a large object is made of segments.
+type Manifest struct {
+	// Type is the kind of manifest. It is empty if the object is not a
+	// large object.
+	Type ManifestType
+
+	// Segments holds the segments of the large object, in order. The
+	// intermediate manifests of a static large object are listed after
+	// their segments. Only Index, Container, Name, Size and ETag are set.
+	Segments []Segment
+}
+
+// DeleteResult is the outcome of a Delete call.
+type DeleteResult struct {
+	// ManifestType is the kind of manifest of the deleted object. It is
+	// empty if the object was not a large object.
+	ManifestType ManifestType
+
+	// Segments holds the segments that were deleted along with the
+	// manifest. Only Index, Container, Name, Size and ETag are set.
+	Segments []Segment
+}
@@ -0,0 +1,287 @@
+package testing
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+// storedObject is an object held by FakeSwift.
+type storedObject struct {
+	Content []byte
+	ETag    string
+	Header  http.Header
+
+	// Size is the size of the large object of a static manifest.
+	Size int64
+}
+
+// size returns the size of the object, or of its large object for a static
+// manifest.
+func (o *storedObject) size() int64 {
+	if o.Header.Get("X-Static-Large-Object") == "True" {
+		return o.Size
+	}
+	return int64(len(o.Content))
+}
+
+// FakeSwift is a minimal in-memory Object Storage API, supporting just what
+// the largeobjects package needs.
+type FakeSwift struct {
+	mu         sync.Mutex
+	Containers map[string]bool
+	Objects    map[string]*storedObject
+
+	// Failures is the number of PUT requests on an object, keyed by
+	// "container/object", that fail with a 503 before one succeeds.
+	Failures map[string]int
+
+	// BadETags is the number of PUT requests on an object, keyed by
+	// "container/object", that succeed but return a wrong ETag.
+	BadETags map[string]int
+
+	// Puts counts the successful PUT requests on each object.
+	Puts map[string]int
+
+	// MaxManifestSegments is the maximum number of segments in a static
+	// manifest.
+	MaxManifestSegments int
+}
+
+// HandleSwift sets up the test server to act as an Object Storage API.
+func HandleSwift(t *testing.T, fakeServer th.FakeServer) *FakeSwift {
+	swift := &FakeSwift{
+		Containers: make(map[string]bool),
+		Objects:    make(map[string]*storedObject),
+		Failures:   make(map[string]int),
+		BadETags:   make(map[string]int),
+		Puts:       make(map[string]int),
+
+		MaxManifestSegments: 1000,
+	}
+
+	fakeServer.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		swift.mu.Lock()
+		defer swift.mu.Unlock()
+
+		container, object, isObject := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+		if !isObject {
+			swift.handleContainer(t, w, r, container)
+			return
+		}
+		if !swift.Containers[container] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		swift.handleObject(t, w, r, container+"/"+object)
+	})
+
+	return swift
+}
+
+// Put stores an object, creating its container if needed.
+func (s *FakeSwift) Put(key string, content []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	container, _, _ := strings.Cut(key, "/")
+	s.Containers[container] = true
+	s.Objects[key] = &storedObject{
+		Content: content,
+		ETag:    md5sum(content),
+		Header:  make(http.Header),
+	}
+}
+
+// Keys returns the keys of the stored objects, sorted.
+func (s *FakeSwift) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.Objects))
+	for key := range s.Objects {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func (s *FakeSwift) handleContainer(t *testing.T, w http.ResponseWriter, r *http.Request, container string) {
+	switch r.Method {
+	case "PUT":
+		if s.Containers[container] {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		s.Containers[container] = true
+		w.WriteHeader(http.StatusCreated)
+	case "GET":
+		prefix := container + "/" + r.URL.Query().Get("prefix")
+		marker := container + "/" + r.URL.Query().Get("marker")
+
+		keys := make([]string, 0, len(s.Objects))
+		for key := range s.Objects {
+			if strings.HasPrefix(key, prefix) && key > marker {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+
+		listing := make([]map[string]any, len(keys))
+		for i, key := range keys {
+			listing[i] = map[string]any{
+				"name":          strings.TrimPrefix(key, container+"/"),
+				"hash":          s.Objects[key].ETag,
+				"bytes":         len(s.Objects[key].Content),
+				"content_type":  "application/octet-stream",
+				"last_modified": "2016-08-17T22:11:58.602650",
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		th.AssertNoErr(t, json.NewEncoder(w).Encode(listing))
+	default:
+		t.Errorf("unexpected %s on container %s", r.Method, container)
+	}
+}
+
+func (s *FakeSwift) handleObject(t *testing.T, w http.ResponseWriter, r *http.Request, key string) {
+	switch r.Method {
+	case "PUT":
+		content, err := io.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+
+		if s.Failures[key] > 0 {
+			s.Failures[key]--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		stored := &storedObject{
+			Content: content,
+			ETag:    md5sum(content),
+			Header:  make(http.Header),
+		}
+		for k, v := range r.Header {
+			if strings.HasPrefix(k, "X-Object-") || k == "Content-Type" {
+				stored.Header[k] = v
+			}
+		}
+
+		if r.URL.Query().Get("multipart-manifest") == "put" {
+			if !s.putStaticManifest(t, w, stored) {
+				return
+			}
+		}
+
+		if etag := r.Header.Get("ETag"); etag != "" && etag != stored.ETag {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		}
+
+		s.Objects[key] = stored
+		s.Puts[key]++
+
+		etag := stored.ETag
+		if s.BadETags[key] > 0 {
+			s.BadETags[key]--
+			etag = md5sum(nil)
+		}
+		w.Header().Set("Etag", fmt.Sprintf("%q", etag))
+		w.WriteHeader(http.StatusCreated)
+	case "HEAD":
+		stored, ok := s.Objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		for k, v := range stored.Header {
+			w.Header()[k] = v
+		}
+		w.Header().Set("Etag", fmt.Sprintf("%q", stored.ETag))
+		w.WriteHeader(http.StatusOK)
+	case "GET":
+		stored, ok := s.Objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		th.AssertEquals(t, "get", r.URL.Query().Get("multipart-manifest"))
+		th.AssertEquals(t, "True", stored.Header.Get("X-Static-Large-Object"))
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		_, _ = w.Write(stored.Content)
+	case "DELETE":
+		if _, ok := s.Objects[key]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(s.Objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		t.Errorf("unexpected %s on object %s", r.Method, key)
+	}
+}
+
+// putStaticManifest checks a static large object manifest against the
+// stored segments and turns stored into the manifest object, the way Swift
+// does. It returns false if the manifest was rejected.
+func (s *FakeSwift) putStaticManifest(t *testing.T, w http.ResponseWriter, stored *storedObject) bool {
+	var manifest []struct {
+		Path      string `json:"path"`
+		ETag      string `json:"etag"`
+		SizeBytes int64  `json:"size_bytes"`
+	}
+	th.AssertNoErr(t, json.Unmarshal(stored.Content, &manifest))
+
+	if len(manifest) > s.MaxManifestSegments {
+		w.WriteHeader(http.StatusBadRequest)
+		return false
+	}
+
+	etags := md5.New()
+	listing := make([]map[string]any, len(manifest))
+	for i, entry := range manifest {
+		segment, ok := s.Objects[strings.TrimPrefix(entry.Path, "/")]
+		if !ok || segment.ETag != entry.ETag || segment.size() != entry.SizeBytes {
+			w.WriteHeader(http.StatusBadRequest)
+			return false
+		}
+		etags.Write([]byte(entry.ETag))
+		stored.Size += entry.SizeBytes
+
+		listing[i] = map[string]any{
+			"name":  entry.Path,
+			"hash":  entry.ETag,
+			"bytes": entry.SizeBytes,
+		}
+		if segment.Header.Get("X-Static-Large-Object") == "True" {
+			listing[i]["sub_slo"] = true
+		}
+	}
+
+	content, err := json.Marshal(listing)
+	th.AssertNoErr(t, err)
+
+	stored.Content = content
+	stored.ETag = hex.EncodeToString(etags.Sum(nil))
+	stored.Header.Set("X-Static-Large-Object", "True")
+	return true
+}
+
+func md5sum(content []byte) string {
+	sum := md5.Sum(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,392 @@
+package testing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/largeobjects"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+const content = "0123456789"
+
+func TestUploadStatic(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Put("videos/placeholder", nil)
+
+	var uploaded []int
+	uploadOpts := largeobjects.UploadOpts{
+		Content:     strings.NewReader(content),
+		SegmentSize: 4,
+		ContentType: "video/mp4",
+		Metadata:    map[string]string{"Camera": "front"},
+		Concurrency: 3,
+		OnSegment: func(segment largeobjects.Segment) {
+			uploaded = append(uploaded, segment.Index)
+		},
+	}
+
+	result, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "videos", "clip.mp4", uploadOpts)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, largeobjects.StaticLargeObject, result.ManifestType)
+	th.AssertEquals(t, "videos_segments", result.SegmentContainer)
+	th.AssertEquals(t, "clip.mp4/slo/10/4/", result.SegmentPrefix)
+	th.AssertEquals(t, int64(10), result.Size)
+	th.AssertEquals(t, 3, len(result.Uploaded()))
+	th.AssertEquals(t, 3, len(uploaded))
+
+	expected := []largeobjects.Segment{
+		{Index: 0, Container: "videos_segments", Name: "clip.mp4/slo/10/4/00000000", Offset: 0, Size: 4, ETag: md5sum([]byte("0123")), Attempts: 1},
+		{Index: 1, Container: "videos_segments", Name: "clip.mp4/slo/10/4/00000001", Offset: 4, Size: 4, ETag: md5sum([]byte("4567")), Attempts: 1},
+		{Index: 2, Container: "videos_segments", Name: "clip.mp4/slo/10/4/00000002", Offset: 8, Size: 2, ETag: md5sum([]byte("89")), Attempts: 1},
+	}
+	th.CheckDeepEquals(t, expected, result.Segments)
+
+	th.CheckDeepEquals(t, []string{
+		"videos/clip.mp4",
+		"videos/placeholder",
+		"videos_segments/clip.mp4/slo/10/4/00000000",
+		"videos_segments/clip.mp4/slo/10/4/00000001",
+		"videos_segments/clip.mp4/slo/10/4/00000002",
+	}, swift.Keys())
+
+	manifest := swift.Objects["videos/clip.mp4"]
+	th.AssertEquals(t, "True", manifest.Header.Get("X-Static-Large-Object"))
+	th.AssertEquals(t, "video/mp4", manifest.Header.Get("Content-Type"))
+	th.AssertEquals(t, "front", manifest.Header.Get("X-Object-Meta-Camera"))
+	th.AssertEquals(t, result.ETag, manifest.ETag)
+	th.AssertEquals(t, md5sum([]byte(expected[0].ETag+expected[1].ETag+expected[2].ETag)), result.ETag)
+}
+
+func TestUploadDynamic(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Put("videos/placeholder", nil)
+
+	uploadOpts := largeobjects.UploadOpts{
+		// io.MultiReader hides the io.ReaderAt of the strings.Reader.
+		Content:          io.MultiReader(strings.NewReader(content)),
+		SegmentSize:      5,
+		SegmentContainer: "chunks",
+		ManifestType:     largeobjects.DynamicLargeObject,
+		Concurrency:      2,
+	}
+
+	result, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "videos", "clip.mp4", uploadOpts)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "clip.mp4/dlo/5/", result.SegmentPrefix)
+	th.AssertEquals(t, 2, len(result.Segments))
+	th.AssertEquals(t, int64(10), result.Size)
+
+	th.AssertEquals(t, "01234", string(swift.Objects["chunks/clip.mp4/dlo/5/00000000"].Content))
+	th.AssertEquals(t, "56789", string(swift.Objects["chunks/clip.mp4/dlo/5/00000001"].Content))
+
+	manifest := swift.Objects["videos/clip.mp4"]
+	th.AssertEquals(t, "chunks/clip.mp4/dlo/5/", manifest.Header.Get("X-Object-Manifest"))
+	th.AssertEquals(t, 0, len(manifest.Content))
+}
+
+func TestUploadDynamicDeletesStaleSegments(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Put("videos/placeholder", nil)
+
+	// A previous upload of longer content left 3 segments under the same
+	// prefix.
+	for _, name := range []string{"00000000", "00000001", "00000002", "notes.txt"} {
+		swift.Put("chunks/clip.mp4/dlo/5/"+name, []byte("old"))
+	}
+
+	uploadOpts := largeobjects.UploadOpts{
+		Content:          io.MultiReader(strings.NewReader("0123456")),
+		SegmentSize:      5,
+		SegmentContainer: "chunks",
+		ManifestType:     largeobjects.DynamicLargeObject,
+	}
+
+	result, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "videos", "clip.mp4", uploadOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(result.Segments))
+
+	th.CheckDeepEquals(t, []string{
+		"chunks/clip.mp4/dlo/5/00000000",
+		"chunks/clip.mp4/dlo/5/00000001",
+		"chunks/clip.mp4/dlo/5/notes.txt",
+		"videos/clip.mp4",
+		"videos/placeholder",
+	}, swift.Keys())
+	th.AssertEquals(t, "56", string(swift.Objects["chunks/clip.mp4/dlo/5/00000001"].Content))
+}
+
+func TestUploadStaticMaxSegments(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Put("videos/placeholder", nil)
+	swift.MaxManifestSegments = 2
+
+	// With a known size, the segment size is raised to fit the limit.
+	uploadOpts := largeobjects.UploadOpts{
+		Content:     strings.NewReader(content),
+		SegmentSize: 2,
+		MaxSegments: 2,
+	}
+
+	result, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "videos", "clip.mp4", uploadOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "clip.mp4/slo/10/5/", result.SegmentPrefix)
+	th.AssertEquals(t, 2, len(result.Segments))
+}
+
+func TestUploadStaticNested(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Put("videos/placeholder", nil)
+	swift.MaxManifestSegments = 2
+
+	// Without a known size, the segments are grouped into intermediate
+	// manifests.
+	uploadOpts := largeobjects.UploadOpts{
+		Content:     io.MultiReader(strings.NewReader(content)),
+		SegmentSize: 2,
+		MaxSegments: 2,
+	}
+
+	result, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "videos", "clip.mp4", uploadOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 5, len(result.Segments))
+	th.AssertEquals(t, int64(10), result.Size)
+
+	manifest := swift.Objects["videos/clip.mp4"]
+	th.AssertEquals(t, result.ETag, manifest.ETag)
+	th.AssertEquals(t, int64(10), manifest.Size)
+
+	th.CheckDeepEquals(t, []string{
+		"videos/clip.mp4",
+		"videos/placeholder",
+		"videos_segments/clip.mp4/slo/2/00000000",
+		"videos_segments/clip.mp4/slo/2/00000001",
+		"videos_segments/clip.mp4/slo/2/00000002",
+		"videos_segments/clip.mp4/slo/2/00000003",
+		"videos_segments/clip.mp4/slo/2/00000004",
+		"videos_segments/clip.mp4/slo/2/manifest/0/00000000",
+		"videos_segments/clip.mp4/slo/2/manifest/0/00000001",
+		"videos_segments/clip.mp4/slo/2/manifest/0/00000002",
+		"videos_segments/clip.mp4/slo/2/manifest/1/00000000",
+		"videos_segments/clip.mp4/slo/2/manifest/1/00000001",
+	}, swift.Keys())
+
+	deleteResult, err := largeobjects.Delete(context.TODO(), client.ServiceClient(fakeServer), "videos", "clip.mp4", largeobjects.DeleteOpts{Concurrency: 2})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 10, len(deleteResult.Segments))
+	th.AssertEquals(t, "clip.mp4/slo/2/00000000", deleteResult.Segments[0].Name)
+	th.CheckDeepEquals(t, []string{"videos/placeholder"}, swift.Keys())
+}
+
+func TestUploadRetry(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Put("videos/placeholder", nil)
+	swift.Failures["videos_segments/clip.mp4/slo/10/4/00000001"] = 1
+	swift.BadETags["videos_segments/clip.mp4/slo/10/4/00000002"] = 1
+
+	uploadOpts := largeobjects.UploadOpts{
+		Content:     bytes.NewReader([]byte(content)),
+		SegmentSize: 4,
+		Retries:     1,
+	}
+
+	result, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "videos", "clip.mp4", uploadOpts)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, result.Segments[0].Attempts)
+	th.AssertEquals(t, 2, result.Segments[1].Attempts)
+	th.AssertEquals(t, 2, result.Segments[2].Attempts)
+	th.AssertEquals(t, "True", swift.Objects["videos/clip.mp4"].Header.Get("X-Static-Large-Object"))
+}
+
+func TestUploadFailure(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Put("videos/placeholder", nil)
+	swift.Failures["videos_segments/clip.mp4/slo/10/4/00000001"] = 2
+
+	uploadOpts := largeobjects.UploadOpts{
+		Content:     bytes.NewReader([]byte(content)),
+		SegmentSize: 4,
+		Retries:     1,
+	}
+
+	_, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "videos", "clip.mp4", uploadOpts)
+
+	var segmentErr largeobjects.ErrSegmentFailed
+	th.AssertEquals(t, true, errors.As(err, &segmentErr))
+	th.AssertEquals(t, 1, segmentErr.Index)
+	th.AssertEquals(t, 2, segmentErr.Attempts)
+	th.AssertEquals(t, true, gophercloud.ResponseCodeIs(err, http.StatusServiceUnavailable))
+
+	_, ok := swift.Objects["videos/clip.mp4"]
+	th.AssertEquals(t, false, ok)
+}
+
+func TestUploadResume(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Put("videos/placeholder", nil)
+	swift.Put("videos_segments/clip.mp4/slo/10/4/00000000", []byte("0123"))
+	swift.Put("videos_segments/clip.mp4/slo/10/4/00000001", []byte("45"))
+
+	uploadOpts := largeobjects.UploadOpts{
+		Content:     strings.NewReader(content),
+		SegmentSize: 4,
+		Resume:      true,
+	}
+
+	result, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "videos", "clip.mp4", uploadOpts)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, true, result.Segments[0].Skipped)
+	th.AssertEquals(t, 0, result.Segments[0].Attempts)
+	th.AssertEquals(t, false, result.Segments[1].Skipped)
+	th.AssertEquals(t, 2, len(result.Uploaded()))
+
+	th.AssertEquals(t, 0, swift.Puts["videos_segments/clip.mp4/slo/10/4/00000000"])
+	th.AssertEquals(t, "4567", string(swift.Objects["videos_segments/clip.mp4/slo/10/4/00000001"].Content))
+}
+
+func TestUploadEmpty(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	HandleSwift(t, fakeServer)
+
+	uploadOpts := largeobjects.UploadOpts{
+		Content: strings.NewReader(""),
+	}
+
+	_, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "videos", "clip.mp4", uploadOpts)
+	th.CheckErr(t, err, &gophercloud.ErrInvalidInput{})
+}
+
+func TestDeleteStatic(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Put("videos/placeholder", nil)
+
+	uploadOpts := largeobjects.UploadOpts{
+		Content:     strings.NewReader(content),
+		SegmentSize: 4,
+	}
+	_, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "videos", "clip.mp4", uploadOpts)
+	th.AssertNoErr(t, err)
+
+	// A segment that is already gone is not an error.
+	delete(swift.Objects, "videos_segments/clip.mp4/slo/10/4/00000001")
+
+	result, err := largeobjects.Delete(context.TODO(), client.ServiceClient(fakeServer), "videos", "clip.mp4", largeobjects.DeleteOpts{Concurrency: 2})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, largeobjects.StaticLargeObject, result.ManifestType)
+	th.CheckDeepEquals(t, []largeobjects.Segment{
+		{Index: 0, Container: "videos_segments", Name: "clip.mp4/slo/10/4/00000000", Size: 4, ETag: md5sum([]byte("0123"))},
+		{Index: 1, Container: "videos_segments", Name: "clip.mp4/slo/10/4/00000001", Size: 4, ETag: md5sum([]byte("4567"))},
+		{Index: 2, Container: "videos_segments", Name: "clip.mp4/slo/10/4/00000002", Size: 2, ETag: md5sum([]byte("89"))},
+	}, result.Segments)
+	th.CheckDeepEquals(t, []string{"videos/placeholder"}, swift.Keys())
+}
+
+func TestDeleteDynamic(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Put("videos/placeholder", nil)
+	swift.Put("chunks/other/00000000", []byte("other"))
+
+	uploadOpts := largeobjects.UploadOpts{
+		Content:          strings.NewReader(content),
+		SegmentSize:      4,
+		SegmentContainer: "chunks",
+		ManifestType:     largeobjects.DynamicLargeObject,
+	}
+	_, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "videos", "clip.mp4", uploadOpts)
+	th.AssertNoErr(t, err)
+
+	result, err := largeobjects.Delete(context.TODO(), client.ServiceClient(fakeServer), "videos", "clip.mp4", largeobjects.DeleteOpts{})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, largeobjects.DynamicLargeObject, result.ManifestType)
+	th.AssertEquals(t, 3, len(result.Segments))
+	th.CheckDeepEquals(t, []string{"chunks/other/00000000", "videos/placeholder"}, swift.Keys())
+}
+
+func TestDeletePlainObject(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Put("videos/clip.mp4", []byte(content))
+
+	result, err := largeobjects.Delete(context.TODO(), client.ServiceClient(fakeServer), "videos", "clip.mp4", largeobjects.DeleteOpts{})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, largeobjects.ManifestType(""), result.ManifestType)
+	th.AssertEquals(t, 0, len(result.Segments))
+	th.AssertEquals(t, 0, len(swift.Keys()))
+}
+
+func TestGetManifest(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	swift := HandleSwift(t, fakeServer)
+	swift.Put("videos/plain.txt", []byte(content))
+
+	uploadOpts := largeobjects.UploadOpts{
+		Content:     strings.NewReader(content),
+		SegmentSize: 6,
+	}
+	_, err := largeobjects.Upload(context.TODO(), client.ServiceClient(fakeServer), "videos", "clip.mp4", uploadOpts)
+	th.AssertNoErr(t, err)
+
+	manifest, err := largeobjects.GetManifest(context.TODO(), client.ServiceClient(fakeServer), "videos", "clip.mp4")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &largeobjects.Manifest{
+		Type: largeobjects.StaticLargeObject,
+		Segments: []largeobjects.Segment{
+			{Index: 0, Container: "videos_segments", Name: "clip.mp4/slo/10/6/00000000", Size: 6, ETag: md5sum([]byte("012345"))},
+			{Index: 1, Container: "videos_segments", Name: "clip.mp4/slo/10/6/00000001", Size: 4, ETag: md5sum([]byte("6789"))},
+		},
+	}, manifest)
+
+	manifest, err = largeobjects.GetManifest(context.TODO(), client.ServiceClient(fakeServer), "videos", "plain.txt")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &largeobjects.Manifest{}, manifest)
+}
@@ -106,5 +106,44 @@ Example to Download an Object's Data
 	if err != nil {
 		panic(err)
 	}
+
+Example to Create a Temporary URL for every Object under a Prefix
+
+	containerName := "my_container"
+
+	tempURLOpts := objects.CreateTempURLOpts{
+		Method:  http.MethodGet,
+		TTL:     3600,
+		Prefix:  "reports/2024/",
+		IPRange: "203.0.113.0/24",
+	}
+
+	tempURL, err := objects.CreateTempURL(context.TODO(), objectStorageClient, containerName, "reports/2024/index.html", tempURLOpts)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Sign a Form for Browser Uploads
+
+	containerName := "my_container"
+
+	formPostOpts := objects.CreateFormPostOpts{
+		Prefix:       "uploads/",
+		RedirectURL:  "https://example.com/uploaded",
+		MaxFileSize:  10 * 1024 * 1024,
+		MaxFileCount: 3,
+		TTL:          900,
+	}
+
+	formPost, err := objects.CreateFormPost(context.TODO(), objectStorageClient, containerName, formPostOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("<form action=%q method=\"POST\" enctype=\"multipart/form-data\">\n", formPost.URL)
+	for name, value := range formPost.Fields() {
+		fmt.Printf("<input type=\"hidden\" name=%q value=%q>\n", name, value)
+	}
+	fmt.Println(`<input type="file" name="file1"></form>`)
 */
 package objects
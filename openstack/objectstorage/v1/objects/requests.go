@@ -2,6 +2,7 @@ package objects
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"crypto/hmac"
 	"crypto/md5"
@@ -586,62 +587,218 @@ type CreateTempURLOpts struct {
 	// calculate the signature. Valid values include sha1, sha256, and
 	// sha512. If not specified, the default hash function is sha1.
 	Digest string
+
+	// (Optional) Prefix makes the temp URL valid for every object of the
+	// container whose name starts with Prefix, rather than for the given
+	// object only. The object name must start with Prefix.
+	Prefix string
+
+	// (Optional) IPRange restricts the use of the temp URL to clients whose
+	// IP address is IPRange, or belongs to IPRange in CIDR notation.
+	IPRange string
 }
 
 // CreateTempURL is a function for creating a temporary URL for an object. It
 // allows users to have "GET" or "POST" access to a particular tenant's object
 // for a limited amount of time.
 func CreateTempURL(ctx context.Context, c *gophercloud.ServiceClient, containerName, objectName string, opts CreateTempURLOpts) (string, error) {
-	url, err := getURL(c, containerName, objectName)
+	objectURL, err := getURL(c, containerName, objectName)
 	if err != nil {
 		return "", err
 	}
-	urlToBeSigned := tempURL(c, containerName, objectName)
 
-	if opts.Split == "" {
-		opts.Split = "/v1/"
+	if opts.Prefix != "" && !strings.HasPrefix(objectName, opts.Prefix) {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "objects.CreateTempURLOpts.Prefix"
+		err.Value = opts.Prefix
+		err.Info = fmt.Sprintf("the object name %q does not start with the prefix", objectName)
+		return "", err
 	}
 
-	// Initialize time if it was not passed as opts
-	date := opts.Timestamp
-	if date.IsZero() {
-		date = time.Now()
+	objectPath, err := signedPath(c, containerName, objectName, opts.Split)
+	if opts.Prefix != "" {
+		objectPath, err = signedPath(c, containerName, opts.Prefix, opts.Split)
+		objectPath = "prefix:" + objectPath
+	}
+	if err != nil {
+		return "", err
 	}
-	duration := time.Duration(opts.TTL) * time.Second
-	// UNIX time is always UTC
-	expiry := date.Add(duration).Unix()
 
-	// Initialize the tempURLKey to calculate a signature
-	tempURLKey := opts.TempURLKey
-	if tempURLKey == "" {
-		// fallback to a container TempURL key
-		getHeader, err := containers.Get(ctx, c, containerName, nil).Extract()
-		if err != nil {
-			return "", err
-		}
-		tempURLKey = getHeader.TempURLKey
-		if tempURLKey == "" {
-			// fallback to an account TempURL key
-			getHeader, err := accounts.Get(ctx, c, nil).Extract()
-			if err != nil {
-				return "", err
-			}
-			tempURLKey = getHeader.TempURLKey
-		}
-		if tempURLKey == "" {
-			return "", ErrTempURLKeyNotFound{}
-		}
+	expiry := expiryTime(opts.Timestamp, opts.TTL)
+
+	tempURLKey, err := resolveTempURLKey(ctx, c, containerName, opts.TempURLKey)
+	if err != nil {
+		return "", err
+	}
+
+	body := fmt.Sprintf("%s\n%d\n%s", opts.Method, expiry, objectPath)
+	if opts.IPRange != "" {
+		body = fmt.Sprintf("ip=%s\n%s", opts.IPRange, body)
+	}
+
+	hexsum, err := sign(opts.Digest, tempURLKey, body)
+	if err != nil {
+		return "", err
+	}
+
+	signedURL := fmt.Sprintf("%s?temp_url_sig=%s&temp_url_expires=%d", objectURL, hexsum, expiry)
+	if opts.IPRange != "" {
+		signedURL += "&temp_url_ip_range=" + url.QueryEscape(opts.IPRange)
+	}
+	if opts.Prefix != "" {
+		signedURL += "&temp_url_prefix=" + url.QueryEscape(opts.Prefix)
+	}
+	return signedURL, nil
+}
+
+// CreateFormPostOpts are options for signing an HTML form that uploads files
+// to a container through the form POST middleware.
+type CreateFormPostOpts struct {
+	// (Optional) Prefix is prepended to the names of the uploaded files to
+	// form the object names.
+	Prefix string
+
+	// (Optional) RedirectURL is the URL the browser is redirected to once the
+	// upload is done, with the status and message of the upload appended to
+	// its query string.
+	RedirectURL string
+
+	// (REQUIRED) MaxFileSize is the maximum size in bytes of each file.
+	MaxFileSize int64
+
+	// (REQUIRED) MaxFileCount is the maximum number of files uploaded by the
+	// form.
+	MaxFileCount int
+
+	// (REQUIRED) TTL is the number of seconds the form should be usable.
+	TTL int
+
+	// (Optional) Split is the string on which to split the container URL.
+	// If empty, the default OpenStack URL split point will be used ("/v1/").
+	Split string
+
+	// (Optional) Timestamp is the current timestamp used to calculate the
+	// expiry of the form. If not specified, the current time is used.
+	Timestamp time.Time
+
+	// (Optional) TempURLKey overrides the Swift container or account Temp URL
+	// key. If not specified, the key is obtained from a Swift container or
+	// account.
+	TempURLKey string
+
+	// (Optional) Digest specifies the cryptographic hash function used to
+	// calculate the signature. Valid values include sha1, sha256, and
+	// sha512. If not specified, the default hash function is sha1.
+	Digest string
+}
+
+// CreateFormPost is a function for signing an HTML form that lets browsers
+// upload files directly to a container, under an optional name prefix, for a
+// limited amount of time.
+func CreateFormPost(ctx context.Context, c *gophercloud.ServiceClient, containerName string, opts CreateFormPostOpts) (*FormPost, error) {
+	if err := v1.CheckContainerName(containerName); err != nil {
+		return nil, err
+	}
+
+	if opts.MaxFileSize < 1 {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "objects.CreateFormPostOpts.MaxFileSize"
+		return nil, err
+	}
+	if opts.MaxFileCount < 1 {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "objects.CreateFormPostOpts.MaxFileCount"
+		return nil, err
+	}
+
+	formPath, err := signedPath(c, containerName, opts.Prefix, opts.Split)
+	if err != nil {
+		return nil, err
+	}
+
+	expiry := expiryTime(opts.Timestamp, opts.TTL)
+
+	tempURLKey, err := resolveTempURLKey(ctx, c, containerName, opts.TempURLKey)
+	if err != nil {
+		return nil, err
+	}
+
+	body := fmt.Sprintf("%s\n%s\n%d\n%d\n%d", formPath, opts.RedirectURL, opts.MaxFileSize, opts.MaxFileCount, expiry)
+	hexsum, err := sign(opts.Digest, tempURLKey, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FormPost{
+		URL:          c.ServiceURL(url.PathEscape(containerName), url.PathEscape(opts.Prefix)),
+		Redirect:     opts.RedirectURL,
+		MaxFileSize:  opts.MaxFileSize,
+		MaxFileCount: opts.MaxFileCount,
+		Expires:      expiry,
+		Signature:    hexsum,
+	}, nil
+}
+
+// signedPath returns the path of an object, or of a prefix of object names,
+// as it appears in the body of Temp URL and form POST signatures.
+func signedPath(c *gophercloud.ServiceClient, containerName, objectName, split string) (string, error) {
+	if split == "" {
+		split = "/v1/"
 	}
 
-	secretKey := []byte(tempURLKey)
-	_, objectPath, splitFound := strings.Cut(urlToBeSigned, opts.Split)
+	_, objectPath, splitFound := strings.Cut(tempURL(c, containerName, objectName), split)
 	if !splitFound {
-		return "", fmt.Errorf("URL prefix %q not found", opts.Split)
+		return "", fmt.Errorf("URL prefix %q not found", split)
 	}
-	objectPath = opts.Split + objectPath
-	body := fmt.Sprintf("%s\n%d\n%s", opts.Method, expiry, objectPath)
+
+	return split + objectPath, nil
+}
+
+// expiryTime returns the UNIX time ttl seconds after timestamp, or after the
+// current time if timestamp is zero.
+func expiryTime(timestamp time.Time, ttl int) int64 {
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	// UNIX time is always UTC
+	return timestamp.Add(time.Duration(ttl) * time.Second).Unix()
+}
+
+// resolveTempURLKey returns key if it is set. Otherwise, it falls back to
+// the Temp URL keys of the container, then to those of the account.
+func resolveTempURLKey(ctx context.Context, c *gophercloud.ServiceClient, containerName, key string) (string, error) {
+	if key != "" {
+		return key, nil
+	}
+
+	// fallback to a container TempURL key
+	containerHeader, err := containers.Get(ctx, c, containerName, nil).Extract()
+	if err != nil {
+		return "", err
+	}
+	if key := cmp.Or(containerHeader.TempURLKey, containerHeader.TempURLKey2); key != "" {
+		return key, nil
+	}
+
+	// fallback to an account TempURL key
+	accountHeader, err := accounts.Get(ctx, c, nil).Extract()
+	if err != nil {
+		return "", err
+	}
+	if key := cmp.Or(accountHeader.TempURLKey, accountHeader.TempURLKey2); key != "" {
+		return key, nil
+	}
+
+	return "", ErrTempURLKeyNotFound{}
+}
+
+// sign returns the hexadecimal HMAC of body with the given digest.
+func sign(digest, key, body string) (string, error) {
+	secretKey := []byte(key)
+
 	var hash hash.Hash
-	switch opts.Digest {
+	switch digest {
 	case "", "sha1":
 		hash = hmac.New(sha1.New, secretKey)
 	case "sha256":
@@ -649,11 +806,10 @@ func CreateTempURL(ctx context.Context, c *gophercloud.ServiceClient, containerN
 	case "sha512":
 		hash = hmac.New(sha512.New, secretKey)
 	default:
-		return "", ErrTempURLDigestNotValid{Digest: opts.Digest}
+		return "", ErrTempURLDigestNotValid{Digest: digest}
 	}
 	hash.Write([]byte(body))
-	hexsum := fmt.Sprintf("%x", hash.Sum(nil))
-	return fmt.Sprintf("%s?temp_url_sig=%s&temp_url_expires=%d", url, hexsum, expiry), nil
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
 }
 
 // BulkDelete is a function that bulk deletes objects.
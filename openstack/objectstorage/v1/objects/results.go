@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,9 +21,14 @@ type Object struct {
 	// ContentType is the content type of the object.
 	ContentType string `json:"content_type"`
 
-	// Hash represents the MD5 checksum value of the object's content.
+	// Hash represents the MD5 checksum value of the object's content. For
+	// a static large object, it is the checksum of its manifest.
 	Hash string `json:"hash"`
 
+	// SLOETag is the ETag of a static large object: the MD5 checksum of the
+	// concatenated ETags of its segments.
+	SLOETag string `json:"slo_etag"`
+
 	// LastModified is the time the object was last modified.
 	LastModified time.Time `json:"-"`
 
@@ -490,6 +496,41 @@ func (r BulkDeleteResult) Extract() (*BulkDeleteResponse, error) {
 	return &s, err
 }
 
+// FormPost holds what an HTML form needs to upload files to a container
+// through the form POST middleware. The form must be sent with the
+// "multipart/form-data" encoding to URL, and include the values returned by
+// Fields as hidden inputs before the file inputs.
+type FormPost struct {
+	// URL is the action of the form.
+	URL string
+
+	// Redirect is the URL the browser is redirected to after the upload.
+	Redirect string
+
+	// MaxFileSize is the maximum size in bytes of each file.
+	MaxFileSize int64
+
+	// MaxFileCount is the maximum number of files.
+	MaxFileCount int
+
+	// Expires is the UNIX time after which the form is rejected.
+	Expires int64
+
+	// Signature is the signature of the form.
+	Signature string
+}
+
+// Fields returns the hidden inputs of the form, keyed by name.
+func (r FormPost) Fields() map[string]string {
+	return map[string]string{
+		"redirect":       r.Redirect,
+		"max_file_size":  strconv.FormatInt(r.MaxFileSize, 10),
+		"max_file_count": strconv.Itoa(r.MaxFileCount),
+		"expires":        strconv.FormatInt(r.Expires, 10),
+		"signature":      r.Signature,
+	}
+}
+
 // extractLastMarker is a function that takes a page of objects and returns the
 // marker for the page. This can either be a subdir or the last object's name.
 func extractLastMarker(r pagination.Page) (string, error) {
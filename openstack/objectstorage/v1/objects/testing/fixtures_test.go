@@ -372,3 +372,14 @@ func HandleGetObjectSuccessfully(t *testing.T, fakeServer th.FakeServer, options
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
+
+// HandleGetContainerTempURLKey2 creates an HTTP handler at `/v1/testContainer` on the test handler mux that
+// responds with a container `Get` response holding only the second Temp URL key.
+func HandleGetContainerTempURLKey2(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/v1/testContainer", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Set("X-Container-Meta-Temp-URL-Key-2", "containersecret")
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gophercloud/gophercloud/v2"
 	v1 "github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1"
 	accountTesting "github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/accounts/testing"
 	containerTesting "github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers/testing"
@@ -535,3 +536,88 @@ func TestCreateTempURL(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.AssertEquals(t, expectedURL, tempURL)
 }
+
+func TestCreateTempURLPrefixAndIPRange(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	client := client.ServiceClient(fakeServer)
+	client.Endpoint = client.Endpoint + "v1/"
+
+	tempURL, err := objects.CreateTempURL(context.TODO(), client, "testContainer", "logs/2020/app.log", objects.CreateTempURLOpts{
+		Method:     http.MethodGet,
+		TTL:        60,
+		Timestamp:  time.Date(2020, 07, 01, 01, 12, 00, 00, time.UTC),
+		TempURLKey: "secret",
+		Prefix:     "logs/",
+		IPRange:    "10.0.0.0/24",
+	})
+	th.AssertNoErr(t, err)
+
+	sig := "16ccf9ee1c905c960fe099458de51be4bd748028"
+	expectedURL := client.Endpoint + "testContainer/logs%2F2020%2Fapp.log?temp_url_sig=" + sig +
+		"&temp_url_expires=1593565980&temp_url_ip_range=10.0.0.0%2F24&temp_url_prefix=logs%2F"
+	th.AssertEquals(t, expectedURL, tempURL)
+
+	_, err = objects.CreateTempURL(context.TODO(), client, "testContainer", "other/app.log", objects.CreateTempURLOpts{
+		Method:     http.MethodGet,
+		TTL:        60,
+		TempURLKey: "secret",
+		Prefix:     "logs/",
+	})
+	th.CheckErr(t, err, &gophercloud.ErrInvalidInput{})
+}
+
+func TestCreateTempURLContainerKey2(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	HandleGetContainerTempURLKey2(t, fakeServer)
+	client := client.ServiceClient(fakeServer)
+	client.Endpoint = client.Endpoint + "v1/"
+
+	tempURL, err := objects.CreateTempURL(context.TODO(), client, "testContainer", "testObject", objects.CreateTempURLOpts{
+		Method:    http.MethodPut,
+		TTL:       60,
+		Timestamp: time.Date(2020, 07, 01, 01, 12, 00, 00, time.UTC),
+	})
+	th.AssertNoErr(t, err)
+
+	sig := "54dd5c6eb9cf69391feb935732c39aabccff09bc"
+	th.AssertEquals(t, client.Endpoint+"testContainer/testObject?temp_url_sig="+sig+"&temp_url_expires=1593565980", tempURL)
+}
+
+func TestCreateFormPost(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	client := client.ServiceClient(fakeServer)
+	client.Endpoint = client.Endpoint + "v1/"
+
+	formPost, err := objects.CreateFormPost(context.TODO(), client, "testContainer", objects.CreateFormPostOpts{
+		Prefix:       "uploads/",
+		RedirectURL:  "https://example.com/done",
+		MaxFileSize:  1048576,
+		MaxFileCount: 5,
+		TTL:          600,
+		Timestamp:    time.Date(2020, 07, 01, 01, 12, 00, 00, time.UTC),
+		TempURLKey:   "secret",
+		Digest:       "sha256",
+	})
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, client.Endpoint+"testContainer/uploads%2F", formPost.URL)
+	th.CheckDeepEquals(t, map[string]string{
+		"redirect":       "https://example.com/done",
+		"max_file_size":  "1048576",
+		"max_file_count": "5",
+		"expires":        "1593566520",
+		"signature":      "0acd737b974aa9ef2936276228ba39fdabe750e4e8d71863dcfa87569c4edfd7",
+	}, formPost.Fields())
+
+	_, err = objects.CreateFormPost(context.TODO(), client, "testContainer", objects.CreateFormPostOpts{
+		MaxFileCount: 5,
+		TempURLKey:   "secret",
+	})
+	th.CheckErr(t, err, &gophercloud.ErrMissingInput{})
+}
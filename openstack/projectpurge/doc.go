@@ -0,0 +1,78 @@
+/*
+Package projectpurge finds and deletes the resources owned by a project, for
+example before or after the project is deleted in Keystone, which leaves its
+resources behind.
+
+Find lists the load balancers, servers, volume snapshots, volume backups,
+images, object storage containers, volumes, floating IPs, trunks, routers,
+ports, security groups and networks of a project. Purge deletes them in
+dependency order with bounded concurrency, recording the outcome of each
+resource, or only reports them when DryRun is set. FindOrphans lists the
+networking resources whose project no longer exists.
+
+Services whose client is not set are skipped. Admin credentials are needed
+to see the resources of other projects.
+
+Example to Report the Resources of a Project
+
+	clients := projectpurge.Clients{
+		Network:      networkClient,
+		Compute:      computeClient,
+		BlockStorage: blockStorageClient,
+		Image:        imageClient,
+		LoadBalancer: loadBalancerClient,
+	}
+
+	purgeOpts := projectpurge.PurgeOpts{
+		ProjectID: "c6ffb3a9c3a94c6a8ad2d3e8a8b8a3d4",
+		DryRun:    true,
+	}
+
+	result, err := projectpurge.Purge(context.TODO(), clients, purgeOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, resource := range result.Resources {
+		fmt.Printf("%s %s (%s)\n", resource.Type, resource.ID, resource.Name)
+	}
+
+Example to Purge a Project
+
+	purgeOpts := projectpurge.PurgeOpts{
+		ProjectID:   "c6ffb3a9c3a94c6a8ad2d3e8a8b8a3d4",
+		Concurrency: 4,
+		OnResource: func(resource projectpurge.Resource) {
+			log.Printf("%s %s: deleted=%t err=%v", resource.Type, resource.ID, resource.Deleted, resource.Err)
+		},
+	}
+
+	result, err := projectpurge.Purge(context.TODO(), clients, purgeOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, resource := range result.Failed() {
+		fmt.Printf("could not delete %s %s: %v\n", resource.Type, resource.ID, resource.Err)
+	}
+
+Example to Purge the Projects Deleted From Keystone
+
+	orphans, err := projectpurge.FindOrphans(context.TODO(), identityClient, networkClient)
+	if err != nil {
+		panic(err)
+	}
+
+	projectIDs := make(map[string]bool)
+	for _, resource := range orphans {
+		projectIDs[resource.ProjectID] = true
+	}
+
+	for projectID := range projectIDs {
+		purgeOpts := projectpurge.PurgeOpts{ProjectID: projectID}
+		if _, err := projectpurge.Purge(context.TODO(), clients, purgeOpts); err != nil {
+			panic(err)
+		}
+	}
+*/
+package projectpurge
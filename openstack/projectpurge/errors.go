@@ -0,0 +1,32 @@
+package projectpurge
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// ErrDeleteFailed is the error when a resource went into an error state
+// instead of being deleted.
+type ErrDeleteFailed struct {
+	gophercloud.BaseError
+	Type   ResourceType
+	ID     string
+	Status string
+}
+
+func (e ErrDeleteFailed) Error() string {
+	return fmt.Sprintf("%s %s went into status %s instead of being deleted", e.Type, e.ID, e.Status)
+}
+
+// ErrAccountMismatch is the error when the object storage client is not
+// scoped to the account of the purged project.
+type ErrAccountMismatch struct {
+	gophercloud.BaseError
+	Endpoint  string
+	ProjectID string
+}
+
+func (e ErrAccountMismatch) Error() string {
+	return fmt.Sprintf("object storage endpoint %s is not the account of project %s", e.Endpoint, e.ProjectID)
+}
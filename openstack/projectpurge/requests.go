@@ -0,0 +1,228 @@
+package projectpurge
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/projects"
+)
+
+// Clients are the service clients used to find and delete the resources of
+// a project. Services whose client is nil are skipped.
+//
+// Except for ObjectStorage, the clients need admin credentials to see the
+// resources of other projects. Object storage accounts cannot be listed
+// across projects: ObjectStorage must be scoped to the purged project, which
+// is checked against the account in its endpoint.
+type Clients struct {
+	Network       *gophercloud.ServiceClient
+	Compute       *gophercloud.ServiceClient
+	BlockStorage  *gophercloud.ServiceClient
+	Image         *gophercloud.ServiceClient
+	ObjectStorage *gophercloud.ServiceClient
+	LoadBalancer  *gophercloud.ServiceClient
+}
+
+// DefaultResourceTimeout is the time spent deleting each resource when
+// PurgeOpts.ResourceTimeout is not set.
+const DefaultResourceTimeout = 10 * time.Minute
+
+// PurgeOpts specifies how the resources of a project are purged.
+type PurgeOpts struct {
+	// ProjectID is the project whose resources are deleted. It is required.
+	ProjectID string
+
+	// DryRun lists the resources that would be deleted without deleting
+	// them.
+	DryRun bool
+
+	// Concurrency is the maximum number of resources deleted at the same
+	// time. It defaults to 1.
+	Concurrency int
+
+	// ResourceTimeout bounds the time spent deleting each resource,
+	// including waiting for it to be gone, so that a resource stuck in an
+	// intermediate state fails instead of blocking the purge. It defaults to
+	// DefaultResourceTimeout.
+	ResourceTimeout time.Duration
+
+	// OnResource, if set, is called once the deletion of each resource is
+	// over, whatever its outcome. Calls are serialized.
+	OnResource func(resource Resource)
+}
+
+// Find lists the resources owned by a project across the services of
+// clients, in the order Purge deletes them.
+func Find(ctx context.Context, clients Clients, projectID string) ([]Resource, error) {
+	if projectID == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "projectID"
+		return nil, err
+	}
+
+	var resources []Resource
+	for _, k := range kinds {
+		client := k.client(clients)
+		if client == nil {
+			continue
+		}
+
+		found, err := k.list(ctx, client, projectID)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, found...)
+	}
+
+	return resources, nil
+}
+
+// Purge deletes the resources owned by a project.
+//
+// Resources are deleted in dependency order: load balancers and servers
+// first, then snapshots, backups, images and containers, volumes, floating
+// IPs and trunks, routers, ports, and finally security groups and networks.
+// Each stage waits for the resources it deleted to be gone before the next
+// one starts. Within a stage, up to Concurrency resources are deleted at the
+// same time.
+//
+// Resources found missing when deleting them are considered deleted. The
+// returned error only reports failures to list the resources, in which case
+// nothing is deleted. The outcome of each resource is recorded in the
+// PurgeResult.
+func Purge(ctx context.Context, clients Clients, opts PurgeOpts) (*PurgeResult, error) {
+	if opts.ProjectID == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "projectpurge.PurgeOpts.ProjectID"
+		return nil, err
+	}
+
+	resources, err := Find(ctx, clients, opts.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PurgeResult{DryRun: opts.DryRun, Resources: resources}
+	if opts.DryRun {
+		return result, nil
+	}
+
+	// Resources are listed in stage order: each stage is the run of
+	// resources whose kinds share the same stage.
+	resourceKinds := make([]kind, len(resources))
+	for i, resource := range resources {
+		if resourceKinds[i], err = kindOf(resource.Type); err != nil {
+			return nil, err
+		}
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+
+	timeout := opts.ResourceTimeout
+	if timeout <= 0 {
+		timeout = DefaultResourceTimeout
+	}
+
+	var mu sync.Mutex
+	done := func(resource Resource) {
+		if opts.OnResource == nil {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		opts.OnResource(resource)
+	}
+
+	for start := 0; start < len(resources); {
+		stage := resourceKinds[start].stage
+		end := start
+		for end < len(resources) && resourceKinds[end].stage == stage {
+			end++
+		}
+
+		var wg sync.WaitGroup
+		for i := start; i < end; i++ {
+			sem <- struct{}{}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+
+				resource := &result.Resources[i]
+				k := resourceKinds[i]
+				if err := ctx.Err(); err != nil {
+					resource.Err = err
+				} else if err := deleteResource(ctx, k, k.client(clients), *resource, timeout); err != nil && !gophercloud.ResponseCodeIs(err, 404) {
+					resource.Err = err
+				} else {
+					resource.Deleted = true
+				}
+				done(*resource)
+			}()
+		}
+		wg.Wait()
+
+		start = end
+	}
+
+	return result, nil
+}
+
+// deleteResource deletes a resource within timeout.
+func deleteResource(ctx context.Context, k kind, client *gophercloud.ServiceClient, resource Resource, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return k.delete(ctx, client, resource)
+}
+
+// FindOrphans lists the networking resources whose project no longer exists
+// in Keystone, which happens when projects are deleted without being purged
+// first. It requires admin credentials on both clients.
+//
+// The projects of the returned resources can be passed to Purge.
+func FindOrphans(ctx context.Context, identityClient, networkClient *gophercloud.ServiceClient) ([]Resource, error) {
+	allPages, err := projects.List(identityClient, projects.ListOpts{}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allProjects, err := projects.ExtractProjects(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(allProjects))
+	for _, project := range allProjects {
+		known[project.ID] = true
+	}
+
+	var orphans []Resource
+	for _, k := range kinds {
+		if k.client(Clients{Network: networkClient}) == nil {
+			continue
+		}
+
+		// An empty project ID lists the resources of every project.
+		found, err := k.list(ctx, networkClient, "")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range found {
+			// Resources without a project, such as the gateway ports of
+			// routers, belong to the cloud rather than to a project.
+			if resource.ProjectID != "" && !known[resource.ProjectID] {
+				orphans = append(orphans, resource)
+			}
+		}
+	}
+
+	return orphans, nil
+}
@@ -0,0 +1,486 @@
+package projectpurge
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/snapshots"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/trunks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/objects"
+)
+
+// kind describes how the resources of a type are listed and deleted.
+type kind struct {
+	resourceType ResourceType
+
+	// stage orders deletions: every resource of a stage is deleted before
+	// the resources of the next stage.
+	stage int
+
+	client func(c Clients) *gophercloud.ServiceClient
+	list   func(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error)
+	delete func(ctx context.Context, client *gophercloud.ServiceClient, resource Resource) error
+}
+
+// kinds are sorted by stage.
+var kinds = []kind{
+	{ResourceLoadBalancer, 0, loadBalancerClient, listLoadBalancers, deleteLoadBalancer},
+	{ResourceServer, 0, computeClient, listServers, deleteServer},
+	{ResourceVolumeSnapshot, 1, blockStorageClient, listVolumeSnapshots, deleteVolumeSnapshot},
+	{ResourceVolumeBackup, 1, blockStorageClient, listVolumeBackups, deleteVolumeBackup},
+	{ResourceImage, 1, imageClient, listImages, deleteImage},
+	{ResourceContainer, 1, objectStorageClient, listContainers, deleteContainer},
+	{ResourceVolume, 2, blockStorageClient, listVolumes, deleteVolume},
+	{ResourceFloatingIP, 2, networkClient, listFloatingIPs, deleteFloatingIP},
+	{ResourceTrunk, 2, networkClient, listTrunks, deleteTrunk},
+	{ResourceRouter, 3, networkClient, listRouters, deleteRouter},
+	{ResourcePort, 4, networkClient, listPorts, deletePort},
+	{ResourceSecurityGroup, 5, networkClient, listSecurityGroups, deleteSecurityGroup},
+	{ResourceNetwork, 5, networkClient, listNetworks, deleteNetwork},
+}
+
+// kindOf returns the kind of a resource type.
+func kindOf(resourceType ResourceType) (kind, error) {
+	for _, k := range kinds {
+		if k.resourceType == resourceType {
+			return k, nil
+		}
+	}
+
+	err := gophercloud.ErrInvalidInput{}
+	err.Argument = "projectpurge.Resource.Type"
+	err.Value = resourceType
+	return kind{}, err
+}
+
+func networkClient(c Clients) *gophercloud.ServiceClient       { return c.Network }
+func computeClient(c Clients) *gophercloud.ServiceClient       { return c.Compute }
+func blockStorageClient(c Clients) *gophercloud.ServiceClient  { return c.BlockStorage }
+func imageClient(c Clients) *gophercloud.ServiceClient         { return c.Image }
+func objectStorageClient(c Clients) *gophercloud.ServiceClient { return c.ObjectStorage }
+func loadBalancerClient(c Clients) *gophercloud.ServiceClient  { return c.LoadBalancer }
+
+// waitForDeletion polls a resource with get until it is not found. get
+// returns an ErrDeleteFailed when the resource is in a state it will not be
+// deleted from.
+func waitForDeletion(ctx context.Context, get func(ctx context.Context) error) error {
+	return gophercloud.WaitFor(ctx, func(ctx context.Context) (bool, error) {
+		err := get(ctx)
+		if gophercloud.ResponseCodeIs(err, 404) {
+			return true, nil
+		}
+		return false, err
+	})
+}
+
+func listLoadBalancers(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := loadbalancers.List(client, loadbalancers.ListOpts{ProjectID: projectID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allLoadBalancers, err := loadbalancers.ExtractLoadBalancers(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	for _, lb := range allLoadBalancers {
+		resources = append(resources, Resource{Type: ResourceLoadBalancer, ID: lb.ID, Name: lb.Name, ProjectID: lb.ProjectID})
+	}
+	return resources, nil
+}
+
+// deleteLoadBalancer deletes a load balancer along with its listeners,
+// pools and monitors.
+func deleteLoadBalancer(ctx context.Context, client *gophercloud.ServiceClient, resource Resource) error {
+	err := loadbalancers.Delete(ctx, client, resource.ID, loadbalancers.DeleteOpts{Cascade: true}).ExtractErr()
+	if err != nil {
+		return err
+	}
+	return waitForDeletion(ctx, func(ctx context.Context) error {
+		lb, err := loadbalancers.Get(ctx, client, resource.ID).Extract()
+		if err != nil {
+			return err
+		}
+		if lb.ProvisioningStatus == "ERROR" {
+			return ErrDeleteFailed{Type: resource.Type, ID: resource.ID, Status: lb.ProvisioningStatus}
+		}
+		return nil
+	})
+}
+
+func listServers(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := servers.List(client, servers.ListOpts{AllTenants: true, TenantID: projectID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allServers, err := servers.ExtractServers(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	for _, server := range allServers {
+		resources = append(resources, Resource{Type: ResourceServer, ID: server.ID, Name: server.Name, ProjectID: server.TenantID})
+	}
+	return resources, nil
+}
+
+// deleteServer deletes a server and waits for it to be gone, so that its
+// ports and volumes are released.
+func deleteServer(ctx context.Context, client *gophercloud.ServiceClient, resource Resource) error {
+	if err := servers.Delete(ctx, client, resource.ID).ExtractErr(); err != nil {
+		return err
+	}
+	return waitForDeletion(ctx, func(ctx context.Context) error {
+		server, err := servers.Get(ctx, client, resource.ID).Extract()
+		if err != nil {
+			return err
+		}
+		// Servers in ERROR keep their status while they are deleted, and
+		// soft-deleted servers are only deleted once reclaimed.
+		if (server.Status == "ERROR" && server.TaskState == "") || server.Status == "SOFT_DELETED" {
+			return ErrDeleteFailed{Type: resource.Type, ID: resource.ID, Status: server.Status}
+		}
+		return nil
+	})
+}
+
+func listVolumeSnapshots(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := snapshots.List(client, snapshots.ListOpts{AllTenants: true, TenantID: projectID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allSnapshots, err := snapshots.ExtractSnapshots(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	for _, snapshot := range allSnapshots {
+		resources = append(resources, Resource{Type: ResourceVolumeSnapshot, ID: snapshot.ID, Name: snapshot.Name, ProjectID: snapshot.ProjectID})
+	}
+	return resources, nil
+}
+
+// deleteVolumeSnapshot deletes a snapshot and waits for it to be gone, as
+// volumes with snapshots cannot be deleted.
+func deleteVolumeSnapshot(ctx context.Context, client *gophercloud.ServiceClient, resource Resource) error {
+	if err := snapshots.Delete(ctx, client, resource.ID).ExtractErr(); err != nil {
+		return err
+	}
+	return waitForDeletion(ctx, func(ctx context.Context) error {
+		snapshot, err := snapshots.Get(ctx, client, resource.ID).Extract()
+		if err != nil {
+			return err
+		}
+		if snapshot.Status == "error" || snapshot.Status == "error_deleting" {
+			return ErrDeleteFailed{Type: resource.Type, ID: resource.ID, Status: snapshot.Status}
+		}
+		return nil
+	})
+}
+
+func listVolumeBackups(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := backups.List(client, backups.ListOpts{AllTenants: true, TenantID: projectID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allBackups, err := backups.ExtractBackups(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	// Backup summaries do not include their project.
+	var resources []Resource
+	for _, backup := range allBackups {
+		resources = append(resources, Resource{Type: ResourceVolumeBackup, ID: backup.ID, Name: backup.Name, ProjectID: projectID})
+	}
+	return resources, nil
+}
+
+func deleteVolumeBackup(ctx context.Context, client *gophercloud.ServiceClient, resource Resource) error {
+	return backups.Delete(ctx, client, resource.ID).ExtractErr()
+}
+
+func listImages(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := images.List(client, images.ListOpts{Owner: projectID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allImages, err := images.ExtractImages(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	for _, image := range allImages {
+		resources = append(resources, Resource{Type: ResourceImage, ID: image.ID, Name: image.Name, ProjectID: image.Owner})
+	}
+	return resources, nil
+}
+
+func deleteImage(ctx context.Context, client *gophercloud.ServiceClient, resource Resource) error {
+	return images.Delete(ctx, client, resource.ID).ExtractErr()
+}
+
+// listContainers lists the containers of the account of client, after
+// checking that it is the account of projectID.
+func listContainers(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	if err := checkAccount(client, projectID); err != nil {
+		return nil, err
+	}
+
+	allPages, err := containers.List(client, containers.ListOpts{}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allContainers, err := containers.ExtractNames(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	for _, name := range allContainers {
+		resources = append(resources, Resource{Type: ResourceContainer, ID: name, Name: name, ProjectID: projectID})
+	}
+	return resources, nil
+}
+
+// checkAccount checks that the endpoint of client is the account of
+// projectID, whose last path segment is the project ID with a reseller
+// prefix, for example AUTH_<project ID>.
+func checkAccount(client *gophercloud.ServiceClient, projectID string) error {
+	endpoint := client.ResourceBaseURL()
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return err
+	}
+
+	account := path.Base(u.Path)
+	if projectID == "" || !strings.HasSuffix(account, "_"+projectID) {
+		return ErrAccountMismatch{Endpoint: endpoint, ProjectID: projectID}
+	}
+	return nil
+}
+
+// deleteContainer deletes the objects of a container, then the container.
+func deleteContainer(ctx context.Context, client *gophercloud.ServiceClient, resource Resource) error {
+	allPages, err := objects.List(client, resource.ID, objects.ListOpts{}).AllPages(ctx)
+	if err != nil {
+		return err
+	}
+	allObjects, err := objects.ExtractNames(allPages)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range allObjects {
+		_, err := objects.Delete(ctx, client, resource.ID, name, nil).Extract()
+		if err != nil && !gophercloud.ResponseCodeIs(err, 404) {
+			return err
+		}
+	}
+
+	_, err = containers.Delete(ctx, client, resource.ID).Extract()
+	return err
+}
+
+func listVolumes(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := volumes.List(client, volumes.ListOpts{AllTenants: true, TenantID: projectID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allVolumes, err := volumes.ExtractVolumes(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	for _, volume := range allVolumes {
+		resources = append(resources, Resource{Type: ResourceVolume, ID: volume.ID, Name: volume.Name, ProjectID: volume.TenantID})
+	}
+	return resources, nil
+}
+
+// deleteVolume deletes a volume along with the snapshots created since they
+// were listed.
+func deleteVolume(ctx context.Context, client *gophercloud.ServiceClient, resource Resource) error {
+	return volumes.Delete(ctx, client, resource.ID, volumes.DeleteOpts{Cascade: true}).ExtractErr()
+}
+
+func listFloatingIPs(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := floatingips.List(client, floatingips.ListOpts{ProjectID: projectID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allFloatingIPs, err := floatingips.ExtractFloatingIPs(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	for _, fip := range allFloatingIPs {
+		resources = append(resources, Resource{Type: ResourceFloatingIP, ID: fip.ID, Name: fip.FloatingIP, ProjectID: fip.ProjectID})
+	}
+	return resources, nil
+}
+
+func deleteFloatingIP(ctx context.Context, client *gophercloud.ServiceClient, resource Resource) error {
+	return floatingips.Delete(ctx, client, resource.ID).ExtractErr()
+}
+
+func listTrunks(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := trunks.List(client, trunks.ListOpts{ProjectID: projectID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allTrunks, err := trunks.ExtractTrunks(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	for _, trunk := range allTrunks {
+		resources = append(resources, Resource{Type: ResourceTrunk, ID: trunk.ID, Name: trunk.Name, ProjectID: trunk.ProjectID})
+	}
+	return resources, nil
+}
+
+func deleteTrunk(ctx context.Context, client *gophercloud.ServiceClient, resource Resource) error {
+	return trunks.Delete(ctx, client, resource.ID).ExtractErr()
+}
+
+func listRouters(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := routers.List(client, routers.ListOpts{ProjectID: projectID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allRouters, err := routers.ExtractRouters(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	for _, router := range allRouters {
+		resources = append(resources, Resource{Type: ResourceRouter, ID: router.ID, Name: router.Name, ProjectID: router.ProjectID})
+	}
+	return resources, nil
+}
+
+// deleteRouter removes the interfaces of a router, then deletes it. Its
+// gateway is removed along with it.
+func deleteRouter(ctx context.Context, client *gophercloud.ServiceClient, resource Resource) error {
+	allPages, err := ports.List(client, ports.ListOpts{DeviceID: resource.ID}).AllPages(ctx)
+	if err != nil {
+		return err
+	}
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return err
+	}
+
+	for _, port := range allPorts {
+		if !isRouterInterface(port.DeviceOwner) {
+			continue
+		}
+		_, err := routers.RemoveInterface(ctx, client, resource.ID, routers.RemoveInterfaceOpts{PortID: port.ID}).Extract()
+		if err != nil && !gophercloud.ResponseCodeIs(err, 404) {
+			return err
+		}
+	}
+
+	return routers.Delete(ctx, client, resource.ID).ExtractErr()
+}
+
+func isRouterInterface(deviceOwner string) bool {
+	switch deviceOwner {
+	case "network:router_interface", "network:router_interface_distributed", "network:ha_router_replicated_interface":
+		return true
+	}
+	return false
+}
+
+// listPorts lists the ports of a project, except those owned by Neutron
+// itself, such as DHCP and router ports, which are deleted along with their
+// network or router.
+func listPorts(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := ports.List(client, ports.ListOpts{ProjectID: projectID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allPorts, err := ports.ExtractPorts(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	for _, port := range allPorts {
+		if strings.HasPrefix(port.DeviceOwner, "network:") {
+			continue
+		}
+		resources = append(resources, Resource{Type: ResourcePort, ID: port.ID, Name: port.Name, ProjectID: port.ProjectID})
+	}
+	return resources, nil
+}
+
+func deletePort(ctx context.Context, client *gophercloud.ServiceClient, resource Resource) error {
+	return ports.Delete(ctx, client, resource.ID).ExtractErr()
+}
+
+func listSecurityGroups(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := groups.List(client, groups.ListOpts{ProjectID: projectID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allGroups, err := groups.ExtractGroups(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	for _, group := range allGroups {
+		resources = append(resources, Resource{Type: ResourceSecurityGroup, ID: group.ID, Name: group.Name, ProjectID: group.ProjectID})
+	}
+	return resources, nil
+}
+
+func deleteSecurityGroup(ctx context.Context, client *gophercloud.ServiceClient, resource Resource) error {
+	return groups.Delete(ctx, client, resource.ID).ExtractErr()
+}
+
+func listNetworks(ctx context.Context, client *gophercloud.ServiceClient, projectID string) ([]Resource, error) {
+	allPages, err := networks.List(client, networks.ListOpts{ProjectID: projectID}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	allNetworks, err := networks.ExtractNetworks(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	for _, network := range allNetworks {
+		resources = append(resources, Resource{Type: ResourceNetwork, ID: network.ID, Name: network.Name, ProjectID: network.ProjectID})
+	}
+	return resources, nil
+}
+
+// deleteNetwork deletes a network along with its subnets.
+func deleteNetwork(ctx context.Context, client *gophercloud.ServiceClient, resource Resource) error {
+	return networks.Delete(ctx, client, resource.ID).ExtractErr()
+}
@@ -0,0 +1,73 @@
+package projectpurge
+
+// ResourceType is the type of a resource owned by a project.
+type ResourceType string
+
+const (
+	ResourceLoadBalancer   ResourceType = "loadbalancer"
+	ResourceServer         ResourceType = "server"
+	ResourceVolumeSnapshot ResourceType = "volume-snapshot"
+	ResourceVolumeBackup   ResourceType = "volume-backup"
+	ResourceImage          ResourceType = "image"
+	ResourceContainer      ResourceType = "container"
+	ResourceVolume         ResourceType = "volume"
+	ResourceFloatingIP     ResourceType = "floatingip"
+	ResourceTrunk          ResourceType = "trunk"
+	ResourceRouter         ResourceType = "router"
+	ResourcePort           ResourceType = "port"
+	ResourceSecurityGroup  ResourceType = "security-group"
+	ResourceNetwork        ResourceType = "network"
+)
+
+// Resource is a resource owned by a project.
+type Resource struct {
+	// Type is the type of the resource.
+	Type ResourceType
+
+	// ID is the ID of the resource. Object storage containers are
+	// identified by their name.
+	ID string
+
+	// Name is the name of the resource, if it has one.
+	Name string
+
+	// ProjectID is the project owning the resource.
+	ProjectID string
+
+	// Deleted reports whether Purge deleted the resource.
+	Deleted bool
+
+	// Err is the reason Purge could not delete the resource.
+	Err error
+}
+
+// PurgeResult is the outcome of a Purge call.
+type PurgeResult struct {
+	// DryRun reports whether the resources were only listed.
+	DryRun bool
+
+	// Resources holds every resource found, in deletion order.
+	Resources []Resource
+}
+
+// Deleted returns the resources that were deleted.
+func (r PurgeResult) Deleted() []Resource {
+	var resources []Resource
+	for _, resource := range r.Resources {
+		if resource.Deleted {
+			resources = append(resources, resource)
+		}
+	}
+	return resources
+}
+
+// Failed returns the resources that could not be deleted.
+func (r PurgeResult) Failed() []Resource {
+	var resources []Resource
+	for _, resource := range r.Resources {
+		if resource.Err != nil {
+			resources = append(resources, resource)
+		}
+	}
+	return resources
+}
@@ -0,0 +1,2 @@
+// projectpurge unit tests
+package testing
@@ -0,0 +1,305 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+const ProjectID = "c6ffb3a9c3a94c6a8ad2d3e8a8b8a3d4"
+
+const ServerListResponse = `
+{
+    "servers": [
+        {
+            "id": "server-1",
+            "name": "web",
+            "tenant_id": "c6ffb3a9c3a94c6a8ad2d3e8a8b8a3d4"
+        }
+    ]
+}
+`
+
+const FloatingIPListResponse = `
+{
+    "floatingips": [
+        {
+            "id": "fip-1",
+            "floating_ip_address": "172.24.4.10",
+            "project_id": "c6ffb3a9c3a94c6a8ad2d3e8a8b8a3d4"
+        }
+    ]
+}
+`
+
+const RouterListResponse = `
+{
+    "routers": [
+        {
+            "id": "router-1",
+            "name": "web",
+            "project_id": "c6ffb3a9c3a94c6a8ad2d3e8a8b8a3d4"
+        }
+    ]
+}
+`
+
+const PortListResponse = `
+{
+    "ports": [
+        {
+            "id": "port-1",
+            "name": "web",
+            "device_owner": "compute:nova",
+            "project_id": "c6ffb3a9c3a94c6a8ad2d3e8a8b8a3d4"
+        },
+        {
+            "id": "port-2",
+            "name": "",
+            "device_owner": "network:dhcp",
+            "project_id": "c6ffb3a9c3a94c6a8ad2d3e8a8b8a3d4"
+        }
+    ]
+}
+`
+
+const RouterPortListResponse = `
+{
+    "ports": [
+        {
+            "id": "port-3",
+            "device_id": "router-1",
+            "device_owner": "network:router_interface",
+            "project_id": "c6ffb3a9c3a94c6a8ad2d3e8a8b8a3d4"
+        },
+        {
+            "id": "port-4",
+            "device_id": "router-1",
+            "device_owner": "network:router_gateway",
+            "project_id": ""
+        }
+    ]
+}
+`
+
+const SecurityGroupListResponse = `
+{
+    "security_groups": [
+        {
+            "id": "sg-1",
+            "name": "default",
+            "project_id": "c6ffb3a9c3a94c6a8ad2d3e8a8b8a3d4"
+        }
+    ]
+}
+`
+
+const NetworkListResponse = `
+{
+    "networks": [
+        {
+            "id": "net-1",
+            "name": "web",
+            "project_id": "c6ffb3a9c3a94c6a8ad2d3e8a8b8a3d4"
+        }
+    ]
+}
+`
+
+// AllNetworkListResponses are the listings of the networking resources of
+// every project, as seen by FindOrphans.
+var AllNetworkListResponses = map[string]string{
+	"floatingips": `{"floatingips": [{"id": "fip-1", "project_id": "deleted-project"}, {"id": "fip-2", "project_id": "live-project"}]}`,
+	"trunks":      `{"trunks": []}`,
+	"routers":     `{"routers": [{"id": "router-1", "project_id": "live-project"}]}`,
+	"ports": `{"ports": [
+		{"id": "port-1", "device_owner": "compute:nova", "project_id": "deleted-project"},
+		{"id": "port-2", "device_owner": "network:router_gateway", "project_id": ""}
+	]}`,
+	"security-groups": `{"security_groups": [{"id": "sg-1", "project_id": "deleted-project"}]}`,
+	"networks":        `{"networks": [{"id": "net-1", "project_id": "live-project"}]}`,
+}
+
+const ProjectListResponse = `
+{
+    "projects": [
+        {
+            "id": "live-project",
+            "name": "live"
+        }
+    ],
+    "links": {
+        "next": null
+    }
+}
+`
+
+// Cloud records the deletions made against the fake server.
+type Cloud struct {
+	mu        sync.Mutex
+	deletions []string
+}
+
+func (c *Cloud) record(deletion string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.deletions = append(c.deletions, deletion)
+}
+
+// Deletions returns the deletions made so far, in order.
+func (c *Cloud) Deletions() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.deletions...)
+}
+
+func writeJSON(w http.ResponseWriter, body string) {
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, body)
+}
+
+// HandlePurge serves a project with a server, a floating IP, a router, a
+// port, a security group and a network. The security group cannot be
+// deleted, and the port is already gone when it is deleted.
+func HandlePurge(t *testing.T, fakeServer th.FakeServer) *Cloud {
+	cloud := &Cloud{}
+
+	fakeServer.Mux.HandleFunc("/servers/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"all_tenants": "true", "tenant_id": ProjectID})
+		writeJSON(w, ServerListResponse)
+	})
+
+	fakeServer.Mux.HandleFunc("/servers/server-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		switch r.Method {
+		case "DELETE":
+			cloud.record("server server-1")
+			w.WriteHeader(http.StatusNoContent)
+		case "GET":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			t.Errorf("Unexpected %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	lists := map[string]string{
+		"floatingips":     FloatingIPListResponse,
+		"trunks":          `{"trunks": []}`,
+		"routers":         RouterListResponse,
+		"security-groups": SecurityGroupListResponse,
+		"networks":        NetworkListResponse,
+	}
+	for resource, response := range lists {
+		fakeServer.Mux.HandleFunc("/v2.0/"+resource, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestFormValues(t, r, map[string]string{"project_id": ProjectID})
+			writeJSON(w, response)
+		})
+	}
+
+	fakeServer.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		if r.URL.Query().Get("device_id") == "router-1" {
+			writeJSON(w, RouterPortListResponse)
+			return
+		}
+		th.TestFormValues(t, r, map[string]string{"project_id": ProjectID})
+		writeJSON(w, PortListResponse)
+	})
+
+	fakeServer.Mux.HandleFunc("/v2.0/routers/router-1/remove_router_interface", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"port_id": "port-3"}`)
+		cloud.record("router interface port-3")
+		writeJSON(w, `{"id": "router-1", "port_id": "port-3", "subnet_id": "subnet-1"}`)
+	})
+
+	fakeServer.Mux.HandleFunc("/v2.0/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		path := strings.TrimPrefix(r.URL.Path, "/v2.0/")
+		cloud.record(strings.Replace(path, "/", " ", 1))
+		switch path {
+		case "ports/port-1":
+			w.WriteHeader(http.StatusNotFound)
+		case "security-groups/sg-1":
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	return cloud
+}
+
+// HandleFindOrphans serves the projects of Keystone and the networking
+// resources of every project.
+func HandleFindOrphans(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/projects", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		writeJSON(w, ProjectListResponse)
+	})
+
+	for resource, response := range AllNetworkListResponses {
+		fakeServer.Mux.HandleFunc("/v2.0/"+resource, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			th.TestFormValues(t, r, map[string]string{})
+			writeJSON(w, response)
+		})
+	}
+}
+
+// HandleStuckServers serves a project with a server that is soft-deleted
+// instead of being deleted and a server that stays in the deleting task
+// state.
+func HandleStuckServers(t *testing.T, fakeServer th.FakeServer) {
+	fakeServer.Mux.HandleFunc("/servers/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		writeJSON(w, `{"servers": [
+			{"id": "server-1", "name": "soft", "tenant_id": "`+ProjectID+`"},
+			{"id": "server-2", "name": "slow", "tenant_id": "`+ProjectID+`"}
+		]}`)
+	})
+
+	states := map[string]string{
+		"server-1": `{"server": {"id": "server-1", "status": "SOFT_DELETED", "OS-EXT-STS:task_state": null}}`,
+		"server-2": `{"server": {"id": "server-2", "status": "ACTIVE", "OS-EXT-STS:task_state": "deleting"}}`,
+	}
+	for id, state := range states {
+		fakeServer.Mux.HandleFunc("/servers/"+id, func(w http.ResponseWriter, r *http.Request) {
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+			switch r.Method {
+			case "DELETE":
+				w.WriteHeader(http.StatusNoContent)
+			case "GET":
+				writeJSON(w, state)
+			default:
+				t.Errorf("Unexpected %s %s", r.Method, r.URL.Path)
+			}
+		})
+	}
+}
+
+// HandleAccount serves an empty object storage account.
+func HandleAccount(t *testing.T, fakeServer th.FakeServer, account string) {
+	fakeServer.Mux.HandleFunc("/v1/"+account+"/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		writeJSON(w, `[]`)
+	})
+}
@@ -0,0 +1,167 @@
+package testing
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	fake "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/common"
+	"github.com/gophercloud/gophercloud/v2/openstack/projectpurge"
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/client"
+)
+
+func TestPurgeDryRun(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	cloud := HandlePurge(t, fakeServer)
+
+	clients := projectpurge.Clients{
+		Network: fake.ServiceClient(fakeServer),
+		Compute: client.ServiceClient(fakeServer),
+	}
+	purgeOpts := projectpurge.PurgeOpts{
+		ProjectID: ProjectID,
+		DryRun:    true,
+	}
+
+	result, err := projectpurge.Purge(context.TODO(), clients, purgeOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, result.DryRun)
+
+	expected := []projectpurge.Resource{
+		{Type: projectpurge.ResourceServer, ID: "server-1", Name: "web", ProjectID: ProjectID},
+		{Type: projectpurge.ResourceFloatingIP, ID: "fip-1", Name: "172.24.4.10", ProjectID: ProjectID},
+		{Type: projectpurge.ResourceRouter, ID: "router-1", Name: "web", ProjectID: ProjectID},
+		{Type: projectpurge.ResourcePort, ID: "port-1", Name: "web", ProjectID: ProjectID},
+		{Type: projectpurge.ResourceSecurityGroup, ID: "sg-1", Name: "default", ProjectID: ProjectID},
+		{Type: projectpurge.ResourceNetwork, ID: "net-1", Name: "web", ProjectID: ProjectID},
+	}
+	th.CheckDeepEquals(t, expected, result.Resources)
+	th.CheckEquals(t, 0, len(cloud.Deletions()))
+}
+
+func TestPurge(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	cloud := HandlePurge(t, fakeServer)
+
+	clients := projectpurge.Clients{
+		Network: fake.ServiceClient(fakeServer),
+		Compute: client.ServiceClient(fakeServer),
+	}
+
+	var mu sync.Mutex
+	var reported []string
+	purgeOpts := projectpurge.PurgeOpts{
+		ProjectID:   ProjectID,
+		Concurrency: 2,
+		OnResource: func(resource projectpurge.Resource) {
+			mu.Lock()
+			defer mu.Unlock()
+			reported = append(reported, resource.ID)
+		},
+	}
+
+	result, err := projectpurge.Purge(context.TODO(), clients, purgeOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 6, len(result.Resources))
+	th.AssertEquals(t, 6, len(reported))
+
+	failed := result.Failed()
+	th.AssertEquals(t, 1, len(failed))
+	th.CheckEquals(t, "sg-1", failed[0].ID)
+	th.CheckEquals(t, true, failed[0].Err != nil)
+	th.CheckEquals(t, 5, len(result.Deleted()))
+
+	// Deletions are made in dependency order.
+	deletions := cloud.Deletions()
+	th.AssertEquals(t, 7, len(deletions))
+	order := []string{
+		"server server-1",
+		"floatingips fip-1",
+		"router interface port-3",
+		"routers router-1",
+		"ports port-1",
+	}
+	for i := 1; i < len(order); i++ {
+		th.CheckEquals(t, true, slices.Index(deletions, order[i-1]) < slices.Index(deletions, order[i]))
+	}
+	th.CheckEquals(t, true, slices.Index(deletions, "ports port-1") < slices.Index(deletions, "security-groups sg-1"))
+	th.CheckEquals(t, true, slices.Index(deletions, "ports port-1") < slices.Index(deletions, "networks net-1"))
+}
+
+func TestPurgeStuckResources(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	HandleStuckServers(t, fakeServer)
+
+	clients := projectpurge.Clients{
+		Compute: client.ServiceClient(fakeServer),
+	}
+	purgeOpts := projectpurge.PurgeOpts{
+		ProjectID:       ProjectID,
+		Concurrency:     2,
+		ResourceTimeout: 100 * time.Millisecond,
+	}
+
+	result, err := projectpurge.Purge(context.TODO(), clients, purgeOpts)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(result.Failed()))
+
+	var deleteErr projectpurge.ErrDeleteFailed
+	th.AssertEquals(t, true, errors.As(result.Resources[0].Err, &deleteErr))
+	th.CheckEquals(t, "SOFT_DELETED", deleteErr.Status)
+
+	th.CheckEquals(t, true, errors.Is(result.Resources[1].Err, context.DeadlineExceeded))
+}
+
+func TestPurgeObjectStorageAccount(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	HandleAccount(t, fakeServer, "AUTH_"+ProjectID)
+
+	objectStorageClient := client.ServiceClient(fakeServer)
+	objectStorageClient.Endpoint += "v1/AUTH_" + ProjectID + "/"
+
+	resources, err := projectpurge.Find(context.TODO(), projectpurge.Clients{ObjectStorage: objectStorageClient}, ProjectID)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 0, len(resources))
+
+	// A client scoped to another project is refused before listing.
+	objectStorageClient.Endpoint = fakeServer.Endpoint() + "v1/AUTH_admin/"
+
+	_, err = projectpurge.Find(context.TODO(), projectpurge.Clients{ObjectStorage: objectStorageClient}, ProjectID)
+	var mismatch projectpurge.ErrAccountMismatch
+	th.AssertEquals(t, true, errors.As(err, &mismatch))
+	th.CheckEquals(t, ProjectID, mismatch.ProjectID)
+}
+
+func TestPurgeMissingProjectID(t *testing.T) {
+	_, err := projectpurge.Purge(context.TODO(), projectpurge.Clients{}, projectpurge.PurgeOpts{})
+	th.AssertErr(t, err)
+}
+
+func TestFindOrphans(t *testing.T) {
+	fakeServer := th.SetupHTTP()
+	defer fakeServer.Teardown()
+
+	HandleFindOrphans(t, fakeServer)
+
+	orphans, err := projectpurge.FindOrphans(context.TODO(), client.ServiceClient(fakeServer), fake.ServiceClient(fakeServer))
+	th.AssertNoErr(t, err)
+
+	expected := []projectpurge.Resource{
+		{Type: projectpurge.ResourceFloatingIP, ID: "fip-1", ProjectID: "deleted-project"},
+		{Type: projectpurge.ResourcePort, ID: "port-1", ProjectID: "deleted-project"},
+		{Type: projectpurge.ResourceSecurityGroup, ID: "sg-1", ProjectID: "deleted-project"},
+	}
+	th.CheckDeepEquals(t, expected, orphans)
+}
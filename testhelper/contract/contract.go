@@ -0,0 +1,177 @@
+package contract
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// RequestCase is a request body that must validate against a vendored
+// schema.
+type RequestCase struct {
+	// Name identifies the case in test output.
+	Name string
+
+	// Schema is the name of the vendored schema, for example
+	// "compute/v2/servers/create".
+	Schema string
+
+	// Build returns the request body, usually by calling one of the
+	// package's ToXxxMap functions.
+	Build func() (map[string]any, error)
+}
+
+// ResponseCase is a sample response body that must decode into a result
+// struct without leaving any fields behind.
+type ResponseCase struct {
+	// Name identifies the case in test output.
+	Name string
+
+	// Body is the sample response body.
+	Body string
+
+	// Key, if set, selects the top-level element of Body to decode, for
+	// example "server" or "ports".
+	Key string
+
+	// Target is a pointer to the value the body is decoded into, for example
+	// &servers.Server{} or &[]ports.Port{}.
+	Target any
+
+	// KnownGaps lists fields that are known not to be mapped to a struct
+	// field, either because they are decoded by a custom UnmarshalJSON or
+	// because they are not supported yet. They are reported as coverage gaps
+	// but do not fail the test.
+	KnownGaps []string
+}
+
+// Suite groups the contract cases of a single package.
+type Suite struct {
+	// Package is the package under test, used in the coverage report.
+	Package string
+
+	Requests  []RequestCase
+	Responses []ResponseCase
+}
+
+// Report summarizes the result of running a Suite.
+type Report struct {
+	Package string
+
+	// Violations maps request case names to their schema violations.
+	Violations map[string][]string
+
+	// Unknown maps response case names to the fields that are not mapped to
+	// a struct field and are not listed as known gaps.
+	Unknown map[string][]string
+
+	// Gaps maps response case names to the known gaps that were present in
+	// the sample body.
+	Gaps map[string][]string
+}
+
+// String renders the report as a human-readable coverage summary.
+func (r Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "contract coverage for %s:", r.Package)
+
+	write := func(title string, m map[string][]string) {
+		names := make([]string, 0, len(m))
+		for name := range m {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			fmt.Fprintf(&b, "\n  %s %s:", title, name)
+			for _, v := range m[name] {
+				fmt.Fprintf(&b, "\n    %s", v)
+			}
+		}
+	}
+
+	write("schema violations in request", r.Violations)
+	write("unknown fields in response", r.Unknown)
+	write("known gaps in response", r.Gaps)
+
+	if len(r.Violations)+len(r.Unknown)+len(r.Gaps) == 0 {
+		b.WriteString(" complete")
+	}
+
+	return b.String()
+}
+
+// Run executes every case of the suite as a subtest and logs the coverage
+// report. Schema violations and unknown response fields fail the test.
+func (s Suite) Run(t *testing.T) Report {
+	t.Helper()
+
+	report := Report{
+		Package:    s.Package,
+		Violations: make(map[string][]string),
+		Unknown:    make(map[string][]string),
+		Gaps:       make(map[string][]string),
+	}
+
+	for _, c := range s.Requests {
+		t.Run("request/"+c.Name, func(t *testing.T) {
+			schema, err := LoadSchema(c.Schema)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			body, err := c.Build()
+			if err != nil {
+				t.Fatalf("unable to build request: %v", err)
+			}
+
+			violations, err := schema.Validate(body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, v := range violations {
+				t.Errorf("schema %s: %s", c.Schema, v)
+			}
+			if len(violations) > 0 {
+				report.Violations[c.Name] = violations
+			}
+		})
+	}
+
+	for _, c := range s.Responses {
+		t.Run("response/"+c.Name, func(t *testing.T) {
+			body := []byte(c.Body)
+			if c.Key != "" {
+				var envelope map[string]json.RawMessage
+				if err := json.Unmarshal(body, &envelope); err != nil {
+					t.Fatalf("unable to parse response: %v", err)
+				}
+				var ok bool
+				if body, ok = envelope[c.Key]; !ok {
+					t.Fatalf("response has no %q element", c.Key)
+				}
+			}
+
+			unknown, err := UnknownFields(body, c.Target)
+			if err != nil {
+				t.Fatalf("unable to decode response: %v", err)
+			}
+
+			for _, field := range unknown {
+				if slices.Contains(c.KnownGaps, field) {
+					report.Gaps[c.Name] = append(report.Gaps[c.Name], field)
+					continue
+				}
+				report.Unknown[c.Name] = append(report.Unknown[c.Name], field)
+				t.Errorf("field %q is not mapped to %T", field, c.Target)
+			}
+		})
+	}
+
+	t.Log(report)
+	return report
+}
@@ -0,0 +1,122 @@
+package contract
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// contractTest is the file that holds a package's contract test, relative
+// to the package directory.
+const contractTest = "testing/contract_test.go"
+
+var buildsRequest = regexp.MustCompile(`(?m)^func \(\w+ \*?\w+\) To\w+Map\(`)
+
+// Coverage lists the packages of a source tree that build request bodies,
+// split by whether they have a contract test.
+type Coverage struct {
+	// Covered lists the packages with a testing/contract_test.go file.
+	Covered []string
+
+	// Missing lists the packages without a contract test.
+	Missing []string
+}
+
+// FindCoverage walks the source tree rooted at root, usually the openstack
+// directory, and returns the coverage of the packages that define at least
+// one ToXxxMap method in their requests.go file. Package names are slash
+// separated and relative to root, for example "compute/v2/servers".
+func FindCoverage(root string) (Coverage, error) {
+	var c Coverage
+
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || d.Name() != "requests.go" {
+			return nil
+		}
+
+		b, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		if !buildsRequest.Match(b) {
+			return nil
+		}
+
+		dir := filepath.Dir(p)
+		name, err := filepath.Rel(root, dir)
+		if err != nil {
+			return err
+		}
+		name = filepath.ToSlash(name)
+
+		_, err = os.Stat(filepath.Join(dir, filepath.FromSlash(contractTest)))
+		switch {
+		case err == nil:
+			c.Covered = append(c.Covered, name)
+		case os.IsNotExist(err):
+			c.Missing = append(c.Missing, name)
+		default:
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return c, err
+	}
+
+	sort.Strings(c.Covered)
+	sort.Strings(c.Missing)
+
+	return c, nil
+}
+
+// String renders the coverage as a per-service summary followed by the
+// packages that have no contract test.
+func (c Coverage) String() string {
+	type count struct{ covered, total int }
+	services := make(map[string]*count)
+	service := func(pkg string) *count {
+		name, _, _ := strings.Cut(pkg, "/")
+		if services[name] == nil {
+			services[name] = new(count)
+		}
+		return services[name]
+	}
+	for _, pkg := range c.Covered {
+		s := service(pkg)
+		s.covered++
+		s.total++
+	}
+	for _, pkg := range c.Missing {
+		service(pkg).total++
+	}
+
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "contract tests cover %d of %d packages:", len(c.Covered), len(c.Covered)+len(c.Missing))
+	for _, name := range names {
+		fmt.Fprintf(&b, "\n  %s: %d/%d", name, services[name].covered, services[name].total)
+	}
+
+	if len(c.Missing) > 0 {
+		b.WriteString("\npackages without a contract test:")
+		for _, pkg := range c.Missing {
+			fmt.Fprintf(&b, "\n  %s", pkg)
+		}
+	}
+
+	return b.String()
+}
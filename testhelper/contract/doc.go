@@ -0,0 +1,48 @@
+/*
+Package contract provides a harness for checking request and response
+structures against the OpenStack API reference.
+
+Request bodies produced by a package's ToXxxMap functions are validated
+against JSON schemas vendored under the schemas directory. The schemas are
+derived from the api-ref (or, for Nova, from its jsonschema validators) and
+only cover the subset of JSON Schema that those sources use.
+
+Sample response bodies are decoded into the package's result structs and
+every field present in the body that has no corresponding struct field is
+reported, which surfaces fields that services have added since the struct
+was last updated.
+
+FindCoverage walks the source tree and reports, per service, which packages
+that build request bodies have a contract test and which ones do not. The
+report is logged by the harness's own tests, so running
+
+	go test -v ./testhelper/contract/testing -run TestCoverage
+
+lists the packages that still need one.
+
+Example of a contract test in a package's testing directory:
+
+	func TestContract(t *testing.T) {
+		contract.Suite{
+			Package: "compute/v2/servers",
+			Requests: []contract.RequestCase{
+				{
+					Name:   "create",
+					Schema: "compute/v2/servers/create",
+					Build: func() (map[string]any, error) {
+						return servers.CreateOpts{Name: "derp", FlavorRef: "1"}.ToServerCreateMap()
+					},
+				},
+			},
+			Responses: []contract.ResponseCase{
+				{
+					Name:   "get",
+					Body:   SingleServerBody,
+					Key:    "server",
+					Target: &servers.Server{},
+				},
+			},
+		}.Run(t)
+	}
+*/
+package contract
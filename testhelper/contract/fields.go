@@ -0,0 +1,129 @@
+package contract
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// UnknownFields decodes body into target and returns the paths of every
+// field present in body that has no corresponding field in target's type.
+//
+// Nested objects are reported with dotted paths and list elements with "[]",
+// for example "addresses" or "fixed_ips[].subnet_id". Fields decoded into
+// maps or interface values are considered known.
+func UnknownFields(body []byte, target any) ([]string, error) {
+	if err := json.Unmarshal(body, target); err != nil {
+		return nil, err
+	}
+
+	var doc any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, err
+	}
+
+	unknown := make(map[string]struct{})
+	walk("", doc, reflect.TypeOf(target), unknown)
+
+	paths := make([]string, 0, len(unknown))
+	for p := range unknown {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func walk(p string, v any, t reflect.Type, unknown map[string]struct{}) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch v := v.(type) {
+	case map[string]any:
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := jsonFields(t)
+			for k, child := range v {
+				f, ok := lookupField(fields, k)
+				if !ok {
+					unknown[joinPath(p, k)] = struct{}{}
+					continue
+				}
+				walk(joinPath(p, k), child, f, unknown)
+			}
+		case reflect.Map:
+			for k, child := range v {
+				walk(joinPath(p, k), child, t.Elem(), unknown)
+			}
+		}
+	case []any:
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return
+		}
+		// Elements of a top-level list are reported without a prefix so
+		// that list and single-object responses share the same paths.
+		if p != "" {
+			p += "[]"
+		}
+		for _, child := range v {
+			walk(p, child, t.Elem(), unknown)
+		}
+	}
+}
+
+// jsonFields returns the JSON names of the fields of a struct type, following
+// the same rules as encoding/json for embedded structs.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for k, v := range jsonFields(ft) {
+					if _, ok := fields[k]; !ok {
+						fields[k] = v
+					}
+				}
+				continue
+			}
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+
+	return fields
+}
+
+// lookupField matches a JSON key against struct fields, preferring an exact
+// match but falling back to the case-insensitive match encoding/json uses.
+func lookupField(fields map[string]reflect.Type, key string) (reflect.Type, bool) {
+	if t, ok := fields[key]; ok {
+		return t, true
+	}
+	for name, t := range fields {
+		if strings.EqualFold(name, key) {
+			return t, true
+		}
+	}
+	return nil, false
+}
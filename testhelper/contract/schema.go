@@ -0,0 +1,308 @@
+package contract
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+//go:embed schemas
+var schemas embed.FS
+
+// Schema is the subset of JSON Schema (draft 4) used by the vendored
+// api-ref schemas.
+type Schema struct {
+	Type              []string           `json:"-"`
+	Properties        map[string]*Schema `json:"properties"`
+	PatternProperties map[string]*Schema `json:"patternProperties"`
+	Required          []string           `json:"required"`
+	Items             *Schema            `json:"items"`
+	Enum              []any              `json:"enum"`
+	Pattern           string             `json:"pattern"`
+	MinLength         *int               `json:"minLength"`
+	MaxLength         *int               `json:"maxLength"`
+	MinItems          *int               `json:"minItems"`
+	MaxItems          *int               `json:"maxItems"`
+	Minimum           *float64           `json:"minimum"`
+	Maximum           *float64           `json:"maximum"`
+	AnyOf             []*Schema          `json:"anyOf"`
+	OneOf             []*Schema          `json:"oneOf"`
+
+	// AdditionalProperties is the schema applied to properties that are not
+	// matched by Properties or PatternProperties. It is nil when any
+	// additional property is allowed.
+	AdditionalProperties *Schema `json:"-"`
+
+	// NoAdditionalProperties is set when the schema has
+	// "additionalProperties": false.
+	NoAdditionalProperties bool `json:"-"`
+}
+
+// UnmarshalJSON handles the fields that may be either a single value or a
+// list ("type") or either a boolean or a schema ("additionalProperties").
+func (s *Schema) UnmarshalJSON(b []byte) error {
+	type tmp Schema
+	var r struct {
+		tmp
+		Type                 json.RawMessage `json:"type"`
+		AdditionalProperties json.RawMessage `json:"additionalProperties"`
+	}
+	if err := json.Unmarshal(b, &r); err != nil {
+		return err
+	}
+	*s = Schema(r.tmp)
+
+	if len(r.Type) > 0 {
+		var single string
+		if err := json.Unmarshal(r.Type, &single); err == nil {
+			s.Type = []string{single}
+		} else if err := json.Unmarshal(r.Type, &s.Type); err != nil {
+			return err
+		}
+	}
+
+	if len(r.AdditionalProperties) > 0 {
+		var allowed bool
+		if err := json.Unmarshal(r.AdditionalProperties, &allowed); err == nil {
+			s.NoAdditionalProperties = !allowed
+		} else {
+			s.AdditionalProperties = new(Schema)
+			if err := json.Unmarshal(r.AdditionalProperties, s.AdditionalProperties); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSchema loads a vendored schema by name, for example
+// "compute/v2/servers/create".
+func LoadSchema(name string) (*Schema, error) {
+	b, err := schemas.ReadFile(path.Join("schemas", name+".json"))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load schema %q: %w", name, err)
+	}
+
+	var s Schema
+	if err := json.Unmarshal(b, &s); err != nil {
+		return nil, fmt.Errorf("unable to parse schema %q: %w", name, err)
+	}
+	return &s, nil
+}
+
+// Validate checks a value against the schema. The value is first serialized
+// to JSON so that it is validated exactly as it would be sent over the wire.
+// Every violation found is returned, prefixed with its path in the document.
+func (s *Schema) Validate(v any) ([]string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc any
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+
+	var violations []string
+	s.validate("", doc, &violations)
+	return violations, nil
+}
+
+func (s *Schema) validate(p string, v any, violations *[]string) {
+	report := func(format string, args ...any) {
+		*violations = append(*violations, displayPath(p)+": "+fmt.Sprintf(format, args...))
+	}
+
+	if len(s.Type) > 0 && !matchesType(s.Type, v) {
+		report("expected type %s, got %s", strings.Join(s.Type, " or "), typeOf(v))
+		return
+	}
+
+	if len(s.Enum) > 0 {
+		found := false
+		for _, e := range s.Enum {
+			if reflect.DeepEqual(e, v) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			report("value %v is not one of %v", v, s.Enum)
+		}
+	}
+
+	if len(s.AnyOf) > 0 && s.countMatching(s.AnyOf, p, v) == 0 {
+		report("value does not match any of the allowed schemas")
+	}
+
+	if len(s.OneOf) > 0 {
+		if n := s.countMatching(s.OneOf, p, v); n != 1 {
+			report("value matches %d of the allowed schemas, expected exactly one", n)
+		}
+	}
+
+	switch t := v.(type) {
+	case string:
+		if s.MinLength != nil && len([]rune(t)) < *s.MinLength {
+			report("length %d is shorter than %d", len([]rune(t)), *s.MinLength)
+		}
+		if s.MaxLength != nil && len([]rune(t)) > *s.MaxLength {
+			report("length %d is longer than %d", len([]rune(t)), *s.MaxLength)
+		}
+		if s.Pattern != "" {
+			re, err := regexp.Compile(s.Pattern)
+			if err != nil {
+				report("invalid pattern %q in schema: %v", s.Pattern, err)
+			} else if !re.MatchString(t) {
+				report("value %q does not match pattern %q", t, s.Pattern)
+			}
+		}
+	case float64:
+		if s.Minimum != nil && t < *s.Minimum {
+			report("value %v is less than %v", t, *s.Minimum)
+		}
+		if s.Maximum != nil && t > *s.Maximum {
+			report("value %v is greater than %v", t, *s.Maximum)
+		}
+	case []any:
+		if s.MinItems != nil && len(t) < *s.MinItems {
+			report("%d items is fewer than %d", len(t), *s.MinItems)
+		}
+		if s.MaxItems != nil && len(t) > *s.MaxItems {
+			report("%d items is more than %d", len(t), *s.MaxItems)
+		}
+		if s.Items != nil {
+			for i, item := range t {
+				s.Items.validate(fmt.Sprintf("%s[%d]", p, i), item, violations)
+			}
+		}
+	case map[string]any:
+		for _, r := range s.Required {
+			if _, ok := t[r]; !ok {
+				report("missing required property %q", r)
+			}
+		}
+
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			child := joinPath(p, k)
+			matched := false
+
+			if prop, ok := s.Properties[k]; ok {
+				prop.validate(child, t[k], violations)
+				matched = true
+			}
+
+			for pattern, prop := range s.PatternProperties {
+				if re, err := regexp.Compile(pattern); err == nil && re.MatchString(k) {
+					prop.validate(child, t[k], violations)
+					matched = true
+				}
+			}
+
+			if matched {
+				continue
+			}
+
+			if s.NoAdditionalProperties {
+				report("additional property %q is not allowed", k)
+			} else if s.AdditionalProperties != nil {
+				s.AdditionalProperties.validate(child, t[k], violations)
+			}
+		}
+	}
+}
+
+func (s *Schema) countMatching(candidates []*Schema, p string, v any) int {
+	n := 0
+	for _, c := range candidates {
+		var violations []string
+		c.validate(p, v, &violations)
+		if len(violations) == 0 {
+			n++
+		}
+	}
+	return n
+}
+
+func matchesType(types []string, v any) bool {
+	for _, t := range types {
+		switch t {
+		case "null":
+			if v == nil {
+				return true
+			}
+		case "boolean":
+			if _, ok := v.(bool); ok {
+				return true
+			}
+		case "string":
+			if _, ok := v.(string); ok {
+				return true
+			}
+		case "number":
+			if _, ok := v.(float64); ok {
+				return true
+			}
+		case "integer":
+			if f, ok := v.(float64); ok && f == math.Trunc(f) {
+				return true
+			}
+		case "array":
+			if _, ok := v.([]any); ok {
+				return true
+			}
+		case "object":
+			if _, ok := v.(map[string]any); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func typeOf(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+func joinPath(p, k string) string {
+	if p == "" {
+		return k
+	}
+	return p + "." + k
+}
+
+func displayPath(p string) string {
+	if p == "" {
+		return "(root)"
+	}
+	return p
+}
@@ -0,0 +1,93 @@
+package testing
+
+import (
+	"slices"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/v2/testhelper"
+	"github.com/gophercloud/gophercloud/v2/testhelper/contract"
+)
+
+func TestValidate(t *testing.T) {
+	schema, err := contract.LoadSchema("networking/v2/ports/create")
+	th.AssertNoErr(t, err)
+
+	violations, err := schema.Validate(map[string]any{
+		"port": map[string]any{
+			"network_id":     "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+			"admin_state_up": "yes",
+			"fixed_ips": []map[string]any{
+				{"subnet": "a0304c3a-4f08-4c43-88af-d796509c97d2"},
+			},
+			"foo": "bar",
+		},
+	})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{
+		`port.admin_state_up: expected type boolean, got string`,
+		`port.fixed_ips[0]: additional property "subnet" is not allowed`,
+		`port: additional property "foo" is not allowed`,
+	}, violations)
+
+	violations, err = schema.Validate(map[string]any{"port": map[string]any{}})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{`port: missing required property "network_id"`}, violations)
+}
+
+func TestLoadSchemaMissing(t *testing.T) {
+	_, err := contract.LoadSchema("compute/v2/servers/nonexistent")
+	th.AssertErr(t, err)
+}
+
+type nested struct {
+	Name string `json:"name"`
+}
+
+type embedded struct {
+	Extra string `json:"extra"`
+}
+
+type target struct {
+	embedded
+	ID       string            `json:"id"`
+	Children []nested          `json:"children"`
+	Metadata map[string]string `json:"metadata"`
+	Raw      any               `json:"raw"`
+	Skipped  string            `json:"-"`
+}
+
+func TestUnknownFields(t *testing.T) {
+	body := `{
+		"id": "1",
+		"extra": "x",
+		"skipped": "y",
+		"missing": true,
+		"children": [{"name": "a", "size": 1}, {"name": "b", "size": 2}],
+		"metadata": {"foo": "bar"},
+		"raw": {"anything": "goes"}
+	}`
+
+	unknown, err := contract.UnknownFields([]byte(body), &target{})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"children[].size", "missing", "skipped"}, unknown)
+
+	unknown, err = contract.UnknownFields([]byte(`[{"id": "1", "missing": true}]`), &[]target{})
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"missing"}, unknown)
+}
+
+func TestCoverage(t *testing.T) {
+	coverage, err := contract.FindCoverage("../../../openstack")
+	th.AssertNoErr(t, err)
+
+	for _, pkg := range []string{"compute/v2/servers", "networking/v2/ports"} {
+		if !slices.Contains(coverage.Covered, pkg) {
+			t.Errorf("package %s is not reported as covered", pkg)
+		}
+	}
+	if !slices.Contains(coverage.Missing, "compute/v2/flavors") {
+		t.Errorf("package compute/v2/flavors is not reported as missing")
+	}
+
+	t.Log(coverage)
+}
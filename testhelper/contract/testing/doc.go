@@ -0,0 +1,2 @@
+// contract unit tests
+package testing